@@ -114,6 +114,12 @@ func (m *NotificationSpamManager) initNotificationDB() error {
 		day_bucket DATE NOT NULL
 	);
 
+	CREATE TABLE IF NOT EXISTS snoozed_issues (
+		issue_url TEXT PRIMARY KEY,
+		snoozed_until TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
 	CREATE INDEX IF NOT EXISTS idx_notification_log_project ON notification_log(project_name);
 	CREATE INDEX IF NOT EXISTS idx_notification_log_notified_at ON notification_log(notified_at);
 	CREATE INDEX IF NOT EXISTS idx_notification_log_issue_url ON notification_log(issue_url);
@@ -200,9 +206,36 @@ func (m *NotificationSpamManager) CanNotify(projectName, issueURL string) (bool,
 		}
 	}
 
+	if until, snoozed := m.snoozedUntil(issueURL); snoozed {
+		return false, fmt.Sprintf("snoozed until %s", until.Format("2006-01-02 15:04"))
+	}
+
 	return true, ""
 }
 
+func (m *NotificationSpamManager) SnoozeIssue(issueURL string, duration time.Duration) (time.Time, error) {
+	until := time.Now().Add(duration)
+	query := `
+	INSERT INTO snoozed_issues (issue_url, snoozed_until)
+	VALUES ($1, $2)
+	ON CONFLICT (issue_url) DO UPDATE SET snoozed_until = EXCLUDED.snoozed_until
+	`
+	_, err := m.db.Exec(query, issueURL, until)
+	return until, err
+}
+
+func (m *NotificationSpamManager) snoozedUntil(issueURL string) (time.Time, bool) {
+	var until time.Time
+	err := m.db.QueryRow(`SELECT snoozed_until FROM snoozed_issues WHERE issue_url = $1 AND snoozed_until > $2`, issueURL, time.Now()).Scan(&until)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Printf("Warning: failed to check snooze for %s: %v", issueURL, err)
+		}
+		return time.Time{}, false
+	}
+	return until, true
+}
+
 func (m *NotificationSpamManager) RecordNotification(projectName, issueURL string, issueNumber int) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -263,7 +296,11 @@ func (m *NotificationSpamManager) FilterNotifications(issues []Issue) []Issue {
 func (m *NotificationSpamManager) CleanupOldRecords() error {
 	cutoff := time.Now().AddDate(0, 0, -30)
 	query := `DELETE FROM notification_log WHERE notified_at < $1`
-	_, err := m.db.Exec(query, cutoff)
+	if _, err := m.db.Exec(query, cutoff); err != nil {
+		return err
+	}
+
+	_, err := m.db.Exec(`DELETE FROM snoozed_issues WHERE snoozed_until < $1`, time.Now())
 	return err
 }
 
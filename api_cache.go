@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// APICacheConfig controls the Postgres-backed GitHub response cache. It is
+// shared by every process pointed at the same database, so back-to-back
+// commands and restarts reuse each other's fetches.
+type APICacheConfig struct {
+	Enabled      bool
+	TTL          time.Duration
+	MaxAge       time.Duration // entries older than this are pruned by cleanup
+	MaxBodyBytes int64         // larger responses are passed through uncached
+}
+
+const defaultAPICacheMaxBodyBytes = 1 << 20
+
+type apiCacheBypassKey struct{}
+
+// withoutAPICache marks a context so requests made with it skip the cache in
+// both directions. Commands that compare stored state against GitHub use it,
+// since a cached answer could hide the drift they look for.
+func withoutAPICache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiCacheBypassKey{}, true)
+}
+
+func bypassesAPICache(ctx context.Context) bool {
+	bypass, _ := ctx.Value(apiCacheBypassKey{}).(bool)
+	return bypass
+}
+
+// cachedHeaders are the response headers kept with a cached body. Link is
+// needed for pagination; rate-limit headers are deliberately left out so a
+// cache hit never overwrites the live rate-limit status.
+var cachedHeaders = []string{"Content-Type", "Link", "ETag"}
+
+type apiCacheEntry struct {
+	URL        string         `db:"url"`
+	ETag       sql.NullString `db:"etag"`
+	Body       []byte         `db:"body"`
+	Headers    string         `db:"headers"`
+	FetchedAt  time.Time      `db:"fetched_at"`
+	TTLSeconds int            `db:"ttl_seconds"`
+}
+
+type cachingTransport struct {
+	base   http.RoundTripper
+	db     *sqlx.DB
+	config *APICacheConfig
+	scope  string // fingerprint of the token the requests are sent with
+}
+
+// apiCacheScope fingerprints a token for cache keys. The cache sits above the
+// authenticating transport and answers can depend on the token (the /user
+// endpoints, private repositories), so each token only reads its own entries.
+func apiCacheScope(token string) string {
+	if token == "" {
+		return "anonymous"
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+func loadAPICacheConfigFromEnv() *APICacheConfig {
+	return &APICacheConfig{
+		Enabled:      getEnvBool("API_CACHE_ENABLED", true),
+		TTL:          time.Duration(getEnvInt("API_CACHE_TTL_MINUTES", 10)) * time.Minute,
+		MaxAge:       time.Duration(getEnvInt("API_CACHE_MAX_AGE_DAYS", 7)) * 24 * time.Hour,
+		MaxBodyBytes: int64(getEnvInt("API_CACHE_MAX_BODY_KB", defaultAPICacheMaxBodyBytes>>10)) << 10,
+	}
+}
+
+func initAPICacheTable(db *sqlx.DB) error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS api_cache (
+		url TEXT PRIMARY KEY,
+		etag TEXT,
+		body BYTEA NOT NULL,
+		headers TEXT NOT NULL DEFAULT '{}',
+		fetched_at TIMESTAMP NOT NULL,
+		ttl_seconds INT NOT NULL
+	);
+	`
+	_, err := db.Exec(schema)
+	return err
+}
+
+// NewCachingTransport wraps base with the API cache, keyed for the given
+// token. It returns base unchanged when caching is disabled or there is no
+// database.
+func NewCachingTransport(base http.RoundTripper, db *sqlx.DB, config *APICacheConfig, token string) http.RoundTripper {
+	if config == nil {
+		config = loadAPICacheConfigFromEnv()
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !config.Enabled || db == nil {
+		return base
+	}
+	if err := initAPICacheTable(db); err != nil {
+		log.Printf("Warning: API cache disabled, failed to create table: %v", err)
+		return base
+	}
+	return &cachingTransport{base: base, db: db, config: config, scope: apiCacheScope(token)}
+}
+
+// cacheable skips anything whose answer must be live: writes, the
+// rate-limit endpoint, requests that already carry their own validators, and
+// requests made with withoutAPICache.
+func (t *cachingTransport) cacheable(req *http.Request) bool {
+	if req.Method != http.MethodGet || bypassesAPICache(req.Context()) {
+		return false
+	}
+	if strings.HasSuffix(req.URL.Path, "/rate_limit") {
+		return false
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return false
+	}
+	return !strings.Contains(req.Header.Get("Cache-Control"), "no-cache")
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.cacheable(req) {
+		resp, err := t.base.RoundTrip(req)
+		if err == nil && req.Method != http.MethodGet && resp.StatusCode < 300 {
+			t.invalidateRepo(req.URL)
+		}
+		return resp, err
+	}
+
+	// The scope goes after the URL so invalidateRepo's prefix match still
+	// covers every token's entries.
+	key := req.URL.String() + "#" + t.scope
+	entry, found := t.load(key)
+	if found && time.Since(entry.FetchedAt) < time.Duration(entry.TTLSeconds)*time.Second {
+		return entry.response(req), nil
+	}
+
+	outgoing := req
+	if found && entry.ETag.Valid {
+		outgoing = req.Clone(req.Context())
+		outgoing.Header.Set("If-None-Match", entry.ETag.String)
+	}
+
+	resp, err := t.base.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && found:
+		// A 304 does not count against the rate limit; serve the stored
+		// body and keep the fresh rate-limit headers.
+		resp.Body.Close()
+		t.touch(key)
+		cached := entry.response(req)
+		for name, values := range resp.Header {
+			if strings.HasPrefix(name, "X-Ratelimit") {
+				cached.Header[name] = values
+			}
+		}
+		return cached, nil
+	case resp.StatusCode == http.StatusOK:
+		limit := t.maxBodyBytes()
+		body, readErr := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+		if readErr != nil {
+			resp.Body.Close()
+			return nil, readErr
+		}
+		if int64(len(body)) > limit {
+			// Too large to keep; hand back what was read plus the rest.
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+			return resp, nil
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		t.store(key, resp.Header, body)
+	}
+
+	return resp, nil
+}
+
+func (t *cachingTransport) maxBodyBytes() int64 {
+	if t.config.MaxBodyBytes > 0 {
+		return t.config.MaxBodyBytes
+	}
+	return defaultAPICacheMaxBodyBytes
+}
+
+func (t *cachingTransport) load(key string) (apiCacheEntry, bool) {
+	var entry apiCacheEntry
+	err := t.db.Get(&entry, `SELECT url, etag, body, headers, fetched_at, ttl_seconds FROM api_cache WHERE url = $1`, key)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("[APICache] Lookup failed for %s: %v", key, err)
+		}
+		return apiCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (t *cachingTransport) store(key string, header http.Header, body []byte) {
+	kept := make(map[string]string)
+	for _, name := range cachedHeaders {
+		if v := header.Get(name); v != "" {
+			kept[name] = v
+		}
+	}
+	headers, _ := json.Marshal(kept)
+
+	_, err := t.db.Exec(`
+		INSERT INTO api_cache (url, etag, body, headers, fetched_at, ttl_seconds)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+		ON CONFLICT (url) DO UPDATE SET etag = NULLIF($2, ''), body = $3, headers = $4, fetched_at = $5, ttl_seconds = $6
+	`, key, header.Get("ETag"), body, string(headers), time.Now(), int(t.config.TTL.Seconds()))
+	if err != nil {
+		log.Printf("[APICache] Failed to store %s: %v", key, err)
+	}
+}
+
+func (t *cachingTransport) touch(key string) {
+	if _, err := t.db.Exec(`UPDATE api_cache SET fetched_at = $2 WHERE url = $1`, key, time.Now()); err != nil {
+		log.Printf("[APICache] Failed to refresh %s: %v", key, err)
+	}
+}
+
+// invalidateRepo drops cached reads for a repository after a write to it, so
+// a comment or label change is visible to the next fetch.
+func (t *cachingTransport) invalidateRepo(u *url.URL) {
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 4)
+	for i, part := range parts {
+		if part == "repos" && i+2 < len(parts) {
+			prefix := u.Scheme + "://" + u.Host + "/" + strings.Join(parts[:i+3], "/") + "/"
+			if _, err := t.db.Exec(`DELETE FROM api_cache WHERE url LIKE $1 || '%'`, prefix); err != nil {
+				log.Printf("[APICache] Failed to invalidate %s: %v", prefix, err)
+			}
+			return
+		}
+	}
+}
+
+func (e apiCacheEntry) response(req *http.Request) *http.Response {
+	header := make(http.Header)
+	var kept map[string]string
+	if err := json.Unmarshal([]byte(e.Headers), &kept); err == nil {
+		for name, value := range kept {
+			header.Set(name, value)
+		}
+	}
+	header.Set("X-From-Cache", "1")
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// pruneAPICache removes entries too old to be worth revalidating.
+func pruneAPICache(db *sqlx.DB, maxAge time.Duration) (int64, error) {
+	result, err := db.Exec(`DELETE FROM api_cache WHERE fetched_at < $1`, time.Now().Add(-maxAge))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
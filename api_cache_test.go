@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCachingTransportCacheable(t *testing.T) {
+	transport := &cachingTransport{config: &APICacheConfig{Enabled: true, TTL: time.Minute}}
+
+	tests := []struct {
+		name      string
+		method    string
+		url       string
+		header    string
+		cacheable bool
+	}{
+		{"issue list", http.MethodGet, "https://api.github.com/repos/o/r/issues?state=open", "", true},
+		{"comment post", http.MethodPost, "https://api.github.com/repos/o/r/issues/1/comments", "", false},
+		{"rate limit", http.MethodGet, "https://api.github.com/rate_limit", "", false},
+		{"caller validator", http.MethodGet, "https://api.github.com/repos/o/r", "If-None-Match", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.url, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, `"etag"`)
+			}
+			if got := transport.cacheable(req); got != tt.cacheable {
+				t.Errorf("cacheable() = %v, want %v", got, tt.cacheable)
+			}
+		})
+	}
+
+	bypass := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/o/r/issues/1", nil)
+	bypass = bypass.WithContext(withoutAPICache(context.Background()))
+	if transport.cacheable(bypass) {
+		t.Error("requests made with withoutAPICache should not be cacheable")
+	}
+}
+
+func TestAPICacheEntryResponse(t *testing.T) {
+	entry := apiCacheEntry{
+		Body:    []byte(`[{"number":1}]`),
+		Headers: `{"Link":"<https://api.github.com/repos/o/r/issues?page=2>; rel=\"next\"","Content-Type":"application/json"}`,
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/o/r/issues", nil)
+
+	resp := entry.response(req)
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != `[{"number":1}]` {
+		t.Errorf("response = %d %q", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Link") == "" || resp.Header.Get("X-From-Cache") != "1" {
+		t.Errorf("headers = %v", resp.Header)
+	}
+	if resp.Request != req {
+		t.Error("cached response should carry the request it answers")
+	}
+}
+
+func TestNewCachingTransportWithoutDB(t *testing.T) {
+	base := http.DefaultTransport
+	if got := NewCachingTransport(base, nil, &APICacheConfig{Enabled: true}, "token"); got != base {
+		t.Error("without a database the base transport should be used directly")
+	}
+}
+
+type staticTransport struct{ body string }
+
+func (s staticTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": {`"v1"`}},
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestCachingTransportMaxBodyBytes(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	config := &APICacheConfig{Enabled: true, TTL: time.Minute, MaxBodyBytes: 16}
+	url := "https://api.github.com/repos/o/r/issues"
+	key := url + "#" + apiCacheScope("token")
+
+	for _, body := range []string{`[{"number":1}]`, strings.Repeat("x", 64)} {
+		transport := &cachingTransport{base: staticTransport{body: body}, db: db, config: config, scope: apiCacheScope("token")}
+		fake.expect("SELECT url, etag, body", key).returns([]string{"url"})
+		if len(body) <= 16 {
+			fake.expect("INSERT INTO api_cache", key, `"v1"`, []byte(body), fakeSQLAnyArg{}, fakeSQLAnyArg{}, 60)
+		}
+
+		resp, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, url, nil))
+		if err != nil {
+			t.Fatalf("RoundTrip() error: %v", err)
+		}
+		got, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(got) != body {
+			t.Errorf("body = %q, want %q", got, body)
+		}
+	}
+}
+
+func TestCachingTransportKeysByToken(t *testing.T) {
+	if apiCacheScope("a") == apiCacheScope("b") || apiCacheScope("") != "anonymous" {
+		t.Fatal("each token needs its own cache scope")
+	}
+	if strings.Contains(apiCacheScope("secret-token"), "secret") {
+		t.Fatal("the scope must not contain the token")
+	}
+
+	fake, db := newFakeSQL(t)
+	config := &APICacheConfig{Enabled: true, TTL: time.Minute}
+	url := "https://api.github.com/user"
+	for _, token := range []string{"token-a", "token-b"} {
+		transport := &cachingTransport{base: staticTransport{body: `{"login":"` + token + `"}`}, db: db, config: config, scope: apiCacheScope(token)}
+		key := url + "#" + apiCacheScope(token)
+		fake.expect("SELECT url, etag, body", key).returns([]string{"url"})
+		fake.expect("INSERT INTO api_cache", key, `"v1"`, []byte(`{"login":"`+token+`"}`), fakeSQLAnyArg{}, fakeSQLAnyArg{}, 60)
+
+		resp, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, url, nil))
+		if err != nil {
+			t.Fatalf("RoundTrip() error: %v", err)
+		}
+		resp.Body.Close()
+	}
+}
@@ -38,7 +38,7 @@ type AssignmentRequest struct {
 }
 
 type AssignmentManager struct {
-	client        *github.Client
+	client        GitHubAPI
 	db            *sql.DB
 	username      string
 	spamManager   *AssignmentSpamManager
@@ -196,7 +196,7 @@ func (m *AssignmentSpamManager) GetDailyStats() map[string]interface{} {
 	}
 }
 
-func NewAssignmentManager(client *github.Client, db *sql.DB, username string, enabled bool, autoMode bool) (*AssignmentManager, error) {
+func NewAssignmentManager(client GitHubAPI, db *sql.DB, username string, enabled bool, autoMode bool) (*AssignmentManager, error) {
 	spamManager, err := NewAssignmentSpamManager(db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create spam manager: %w", err)
@@ -264,7 +264,7 @@ func (m *AssignmentManager) IsAssignmentCandidate(issue *github.Issue, org, repo
 
 func (m *AssignmentManager) CheckForLinkedPR(ctx context.Context, org, repo string, issueNumber int) (bool, error) {
 	query := fmt.Sprintf("repo:%s/%s is:pr %d in:body", org, repo, issueNumber)
-	result, _, err := m.client.Search.Issues(ctx, query, nil)
+	result, _, err := m.client.SearchIssues(ctx, query, nil)
 	if err != nil {
 		return false, err
 	}
@@ -332,7 +332,7 @@ func (m *AssignmentManager) AskForAssignment(ctx context.Context, candidate *Ass
 
 	commentBody := fmt.Sprintf("Hi, I'd like to work on this issue. Could a maintainer please assign it to me? Thank you!")
 
-	comment, _, err := m.client.Issues.CreateComment(ctx, candidate.ProjectOrg, candidate.ProjectName, candidate.Issue.GetNumber(), &github.IssueComment{
+	comment, _, err := m.client.CreateIssueComment(ctx, candidate.ProjectOrg, candidate.ProjectName, candidate.Issue.GetNumber(), &github.IssueComment{
 		Body: github.String(commentBody),
 	})
 	if err != nil {
@@ -356,16 +356,16 @@ func (m *AssignmentManager) AskForAssignment(ctx context.Context, candidate *Ass
 }
 
 func (m *AssignmentManager) promptUser(candidate *AssignmentCandidate) bool {
-	fmt.Printf("\n%s\n", strings.Repeat("=", 60))
-	fmt.Printf("NEW ASSIGNMENT OPPORTUNITY\n")
-	fmt.Printf("%s\n", strings.Repeat("-", 60))
-	fmt.Printf("Issue: %s\n", candidate.Issue.GetTitle())
-	fmt.Printf("Project: %s/%s\n", candidate.ProjectOrg, candidate.ProjectName)
-	fmt.Printf("URL: %s\n", candidate.Issue.GetHTMLURL())
-	fmt.Printf("Labels: %s\n", strings.Join(candidate.Labels, ", "))
-	fmt.Printf("Comments: %d | Created: %s\n", candidate.Issue.GetComments(), candidate.Issue.GetCreatedAt().Format("2006-01-02"))
-	fmt.Printf("%s\n", strings.Repeat("-", 60))
-	fmt.Printf("Would you like to request assignment? [y/N]: ")
+	fmt.Fprintf(stdout, "\n%s\n", strings.Repeat("=", 60))
+	fmt.Fprintf(stdout, "NEW ASSIGNMENT OPPORTUNITY\n")
+	fmt.Fprintf(stdout, "%s\n", strings.Repeat("-", 60))
+	fmt.Fprintf(stdout, "Issue: %s\n", candidate.Issue.GetTitle())
+	fmt.Fprintf(stdout, "Project: %s/%s\n", candidate.ProjectOrg, candidate.ProjectName)
+	fmt.Fprintf(stdout, "URL: %s\n", candidate.Issue.GetHTMLURL())
+	fmt.Fprintf(stdout, "Labels: %s\n", strings.Join(candidate.Labels, ", "))
+	fmt.Fprintf(stdout, "Comments: %d | Created: %s\n", candidate.Issue.GetComments(), candidate.Issue.GetCreatedAt().Format("2006-01-02"))
+	fmt.Fprintf(stdout, "%s\n", strings.Repeat("-", 60))
+	fmt.Fprintf(stdout, "Would you like to request assignment? [y/N]: ")
 
 	reader := bufio.NewReader(os.Stdin)
 	response, _ := reader.ReadString('\n')
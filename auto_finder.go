@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -20,18 +19,20 @@ import (
 // AutoFinder searches for GitHub issues and automatically generates smart comments
 // on high-quality issues while respecting rate limits and spam prevention rules.
 type AutoFinder struct {
-	config       *AutoFinderConfig        // Configuration for auto-finder behavior
-	db           *sqlx.DB                 // Database connection (optional)
-	githubClient *github.Client           // GitHub API client
-	antiSpam     *NotificationSpamManager // Spam detection and prevention
-	repoManager  *RepoManager             // Repository configuration management
-	scorer       *EnhancedScorer          // Issue scoring system
-	commentQueue []CommentRequest         // Queue of pending comments
-	fileStorage  *FileStorage             // File-based storage when DB unavailable
-	useDB        bool                     // Whether to use database for persistence
-	mu           sync.Mutex               // Mutex for thread-safe operations
-	smartLimiter *SmartLimiter            // Smart rate limiting
-	strategy     *CommentStrategy         // Comment selection strategy
+	config       *AutoFinderConfig         // Configuration for auto-finder behavior
+	db           *sqlx.DB                  // Database connection (optional)
+	githubClient GitHubAPI                 // GitHub API client
+	antiSpam     *NotificationSpamManager  // Spam detection and prevention
+	repoManager  *RepoManager              // Repository configuration management
+	scorer       *EnhancedScorer           // Issue scoring system
+	commentQueue []CommentRequest          // Queue of pending comments
+	fileStorage  *FileStorage              // File-based storage when DB unavailable
+	useDB        bool                      // Whether to use database for persistence
+	mu           sync.Mutex                // Mutex for thread-safe operations
+	smartLimiter *SmartLimiter             // Smart rate limiting
+	strategy     *CommentStrategy          // Comment selection strategy
+	timing       *MaintainerHoursEstimator // Maintainer-active hours for comment timing
+	titleFilter  *TitleFilterConfig        // Excluded issue title patterns
 }
 
 // AutoFinderConfig controls the behavior of the auto-finder including
@@ -104,7 +105,7 @@ func DefaultAutoFinderConfig() *AutoFinderConfig {
 // Sets up database schema if using database, initializes file storage as fallback,
 // and creates smart limiter and strategy components.
 // Returns an error if initialization fails (invalid config, database connection, etc.).
-func NewAutoFinder(config *AutoFinderConfig, db *sqlx.DB, githubClient *github.Client, antiSpam *NotificationSpamManager) (*AutoFinder, error) {
+func NewAutoFinder(config *AutoFinderConfig, db *sqlx.DB, githubClient GitHubAPI, antiSpam *NotificationSpamManager) (*AutoFinder, error) {
 	// Validate inputs
 	if githubClient == nil {
 		return nil, fmt.Errorf("githubClient is required and cannot be nil")
@@ -140,12 +141,22 @@ func NewAutoFinder(config *AutoFinderConfig, db *sqlx.DB, githubClient *github.C
 		useDB:        useDB,
 		smartLimiter: smartLimiter,
 		strategy:     strategy,
+		timing:       NewMaintainerHoursEstimator(githubClient, db, loadMaintainerHoursConfigFromEnv()),
+		titleFilter:  loadTitleFilterConfigFromEnv(),
 	}
 
 	if useDB {
 		if err := af.initSchema(); err != nil {
 			return nil, fmt.Errorf("failed to initialize auto finder schema: %w", err)
 		}
+		if err := initRepoMovesTable(db); err != nil {
+			return nil, fmt.Errorf("failed to initialize repository moves table: %w", err)
+		}
+		if moves, err := loadRepoMoves(db); err != nil {
+			log.Printf("[AutoFinder] Failed to load repository moves: %v", err)
+		} else {
+			af.repoManager.ApplyRepoMoves(moves)
+		}
 	}
 
 	return af, nil
@@ -198,7 +209,10 @@ func (af *AutoFinder) initSchema() error {
 	CREATE INDEX IF NOT EXISTS idx_found_issues_status ON found_issues(status);
 	`
 
-	_, err := af.db.Exec(schema)
+	if _, err := af.db.Exec(schema); err != nil {
+		return err
+	}
+	_, err := af.db.Exec(deferredCommentsSchema)
 	return err
 }
 
@@ -211,10 +225,15 @@ func (af *AutoFinder) Run(ctx context.Context) error {
 		return nil
 	}
 
+	if posted := af.PostDueComments(ctx); posted > 0 {
+		log.Printf("[AutoFinder] Posted %d queued comments", posted)
+	}
+
 	if !af.canCommentToday() {
 		log.Println("[AutoFinder] Daily comment limit reached")
 	}
 
+	pipeline := startPipelineRun()
 	issues, err := af.searchIssues(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to search issues: %w", err)
@@ -222,14 +241,23 @@ func (af *AutoFinder) Run(ctx context.Context) error {
 
 	scored := af.scoreIssues(issues)
 	valid := af.filterValidIssues(scored)
+	// AutoFinder only logs what it finds, so its runs record nothing notified.
+	defer pipeline.Finish(af.db, scoredIssueData(scored), 0)
 
-	sort.Slice(valid, func(i, j int) bool {
-		return valid[i].Score.Total > valid[j].Score.Total
-	})
+	SortScoredIssues(valid)
 
 	if af.config.AutoComment && len(valid) > 0 && af.canCommentToday() {
 		for _, issue := range valid {
 			if issue.Score.Total >= af.config.MinScoreToComment {
+				if postAt, window, deferred := af.deferUntil(ctx, issue.Project.Org, issue.Project.Name); deferred {
+					if err := af.queueComment(issue, postAt); err != nil {
+						log.Printf("[AutoFinder] Failed to queue comment: %v", err)
+						continue
+					}
+					log.Printf("[AutoFinder] Queued comment on %s until %s, maintainers are most active %s",
+						issue.IssueData.URL, postAt.Format("2006-01-02 15:04 MST"), window)
+					break
+				}
 				if err := af.commentOnBestIssue(ctx, issue); err != nil {
 					log.Printf("[AutoFinder] Failed to comment on issue: %v", err)
 				} else {
@@ -257,9 +285,7 @@ func (af *AutoFinder) Search(ctx context.Context) ([]ScoredIssue, error) {
 	scored := af.scoreIssues(issues)
 	valid := af.filterValidIssues(scored)
 
-	sort.Slice(valid, func(i, j int) bool {
-		return valid[i].Score.Total > valid[j].Score.Total
-	})
+	SortScoredIssues(valid)
 
 	return valid, nil
 }
@@ -282,7 +308,22 @@ func (af *AutoFinder) searchIssues(ctx context.Context) ([]*github.Issue, error)
 			},
 		}
 
-		issues, _, err := af.githubClient.Issues.ListByRepo(ctx, repo.Owner, repo.Name, opts)
+		issues, resp, err := af.githubClient.ListRepoIssues(ctx, repo.Owner, repo.Name, opts)
+		if repoMaybeMoved(resp, err) {
+			if move, moved := resolveRepoMove(ctx, af.githubClient, repo.Owner, repo.Name); moved {
+				log.Printf("[AutoFinder] %s moved to %s, following", move.OldFullName(), move.NewFullName())
+				if af.useDB {
+					if recordErr := recordRepoMove(af.db, move); recordErr != nil {
+						log.Printf("[AutoFinder] Failed to record move of %s: %v", move.OldFullName(), recordErr)
+					}
+				}
+				af.repoManager.RenameRepo(move)
+				repo.Owner, repo.Name = move.NewOwner, move.NewName
+				if err != nil {
+					issues, _, err = af.githubClient.ListRepoIssues(ctx, repo.Owner, repo.Name, opts)
+				}
+			}
+		}
 		if err != nil {
 			log.Printf("[AutoFinder] Error fetching issues for %s/%s: %v", repo.Owner, repo.Name, err)
 			continue
@@ -292,12 +333,17 @@ func (af *AutoFinder) searchIssues(ctx context.Context) ([]*github.Issue, error)
 			if issue.IsPullRequest() {
 				continue
 			}
+			pipelineFetched.Add(1)
 			if issue.GetState() != "open" {
 				continue
 			}
 			if len(issue.Assignees) > 0 {
 				continue
 			}
+			if ok, reason := af.titleFilter.Check(issue.GetTitle()); !ok {
+				log.Printf("[AutoFinder] Skipping %s/%s#%d - %s", repo.Owner, repo.Name, issue.GetNumber(), reason)
+				continue
+			}
 			// Skip issues with linked PRs
 			if issue.PullRequestLinks != nil {
 				log.Printf("[AutoFinder] Skipping %s/%s#%d - has linked PR", repo.Owner, repo.Name, issue.GetNumber())
@@ -313,6 +359,7 @@ func (af *AutoFinder) searchIssues(ctx context.Context) ([]*github.Issue, error)
 					continue
 				}
 			}
+			pipelinePassed.Add(1)
 			allIssues = append(allIssues, issue)
 		}
 	}
@@ -379,6 +426,7 @@ func (af *AutoFinder) scoreIssues(issues []*github.Issue) []ScoredIssue {
 				Comments:    issue.GetComments(),
 				Labels:      labels,
 				IsGoodFirst: hasGoodFirstIssueLabel(issue.Labels),
+				ReadingTime: EstimateReadingTime(issue),
 			},
 		}
 
@@ -486,7 +534,29 @@ func (af *AutoFinder) commentOnBestIssue(ctx context.Context, issue ScoredIssue)
 		return fmt.Errorf("already commented on repo %s today", issue.Project.Name)
 	}
 
-	// Generate comment using smart generator to validate issue state
+	comment, err := af.smartComment(issue)
+	if err != nil || comment == "" {
+		return err
+	}
+
+	_, _, err = af.githubClient.CreateIssueComment(ctx, issue.Project.Org, issue.Project.Name, issue.Issue.GetNumber(), &github.IssueComment{
+		Body: github.String(comment),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+
+	if err := af.recordComment(issue, comment); err != nil {
+		log.Printf("[AutoFinder] Failed to record comment: %v", err)
+	}
+
+	log.Printf("[AutoFinder] Successfully commented on %s/%s#%d", issue.Project.Org, issue.Project.Name, issue.Issue.GetNumber())
+	return nil
+}
+
+// smartComment generates the comment for an issue, validating its state on
+// the way. It returns "" for issues that should be skipped.
+func (af *AutoFinder) smartComment(issue ScoredIssue) (string, error) {
 	scg := NewSmartCommentGenerator()
 	issueDetails := IssueDetails{
 		Title:        issue.Issue.GetTitle(),
@@ -502,27 +572,31 @@ func (af *AutoFinder) commentOnBestIssue(ctx context.Context, issue ScoredIssue)
 	if err != nil {
 		if strings.Contains(err.Error(), "solution") || strings.Contains(err.Error(), "already has") {
 			log.Printf("[AutoFinder] Skipping %s/%s#%d - %v", issue.Project.Org, issue.Project.Name, issue.Issue.GetNumber(), err)
-			return nil
+			return "", nil
 		}
-		return err
+		return "", err
 	}
 
-	// Use smartComment.Body instead of af.generateComment()
-	comment := smartComment.Body
-
-	_, _, err = af.githubClient.Issues.CreateComment(ctx, issue.Project.Org, issue.Project.Name, issue.Issue.GetNumber(), &github.IssueComment{
-		Body: github.String(comment),
-	})
-	if err != nil {
-		return fmt.Errorf("failed to post comment: %w", err)
-	}
+	return smartComment.Body, nil
+}
 
-	if err := af.recordComment(issue, comment); err != nil {
-		log.Printf("[AutoFinder] Failed to record comment: %v", err)
+// queueComment generates the comment for an issue now and queues it until
+// postAt, when PostDueComments posts it.
+func (af *AutoFinder) queueComment(issue ScoredIssue, postAt time.Time) error {
+	comment, err := af.smartComment(issue)
+	if err != nil || comment == "" {
+		return err
 	}
 
-	log.Printf("[AutoFinder] Successfully commented on %s/%s#%d", issue.Project.Org, issue.Project.Name, issue.Issue.GetNumber())
-	return nil
+	return af.deferComment(DeferredComment{
+		IssueURL:    issue.IssueData.URL,
+		Owner:       issue.Project.Org,
+		Repo:        issue.Project.Name,
+		IssueNumber: issue.Issue.GetNumber(),
+		Score:       issue.Score.Total,
+		Body:        comment,
+		PostAt:      postAt,
+	})
 }
 
 // generateComment creates a generic comment for posting on an issue.
@@ -649,6 +723,15 @@ func (af *AutoFinder) sendNotifications(issues []ScoredIssue) {
 	}
 }
 
+// scoredIssueData is the Issue view of scored issues, for pipeline runs.
+func scoredIssueData(scored []ScoredIssue) []Issue {
+	issues := make([]Issue, 0, len(scored))
+	for _, issue := range scored {
+		issues = append(issues, issue.IssueData)
+	}
+	return issues
+}
+
 // GetStatus returns the current status of the auto-finder including enabled state,
 // comment count for today, and number of issues found.
 // Returns nil and an error if status retrieval fails.
@@ -856,10 +939,11 @@ func getEnvStr(key, defaultVal string) string {
 
 // AutoCommentResult represents the outcome of attempting to post a comment on an issue.
 type AutoCommentResult struct {
-	Repo        string // Repository name
-	IssueNumber int    // Issue number
-	Success     bool   // Whether comment was successfully posted
-	Error       string // Error message if posting failed
+	Repo        string    // Repository name
+	IssueNumber int       // Issue number
+	Success     bool      // Whether comment was successfully posted
+	Error       string    // Error message if posting failed
+	QueuedUntil time.Time // When a comment held for maintainer hours will be posted
 }
 
 // Preview generates a preview of comments that would be posted without actually posting them.
@@ -888,6 +972,11 @@ func (af *AutoFinder) Preview(ctx context.Context) ([]CommentPreview, error) {
 			Reason:      reason,
 		}
 
+		if postAt, window, ok := af.timing.NextCommentTime(ctx, issue.Project.Org, issue.Project.Name, time.Now()); ok {
+			preview.PostAt = postAt
+			preview.ActiveWindow = window.String()
+		}
+
 		if !canComment {
 			preview.Reason = reason
 		}
@@ -931,7 +1020,26 @@ func (af *AutoFinder) CommitComments(ctx context.Context) ([]AutoCommentResult,
 			}
 		}
 
-		_, _, err := af.githubClient.Issues.CreateComment(ctx, org, preview.Repo, preview.IssueNumber, &github.IssueComment{
+		if postAt, _, deferred := af.deferUntil(ctx, org, preview.Repo); deferred {
+			err := af.deferComment(DeferredComment{
+				IssueURL:    preview.URL,
+				Owner:       org,
+				Repo:        preview.Repo,
+				IssueNumber: preview.IssueNumber,
+				Score:       preview.Score,
+				Body:        preview.Comment,
+				PostAt:      postAt,
+			})
+			if err != nil {
+				result.Error = fmt.Sprintf("failed to queue comment: %v", err)
+			} else {
+				result.QueuedUntil = postAt
+			}
+			results = append(results, result)
+			continue
+		}
+
+		_, _, err := af.githubClient.CreateIssueComment(ctx, org, preview.Repo, preview.IssueNumber, &github.IssueComment{
 			Body: github.String(preview.Comment),
 		})
 
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Which structural elements let a short issue body pass the filter.
+const (
+	BodyRequireNone     = "none"
+	BodyRequireAny      = "any"      // a heading or a code/log block
+	BodyRequireHeadings = "headings" // at least one heading
+	BodyRequireCode     = "code"     // at least one code/log block
+	BodyRequireBoth     = "both"     // a heading and a code/log block
+)
+
+type BodyFilterConfig struct {
+	Enabled  bool
+	MinWords int
+	Require  string
+}
+
+type BodyQuality struct {
+	Words      int
+	Headings   int
+	CodeBlocks int
+}
+
+var (
+	markdownHeadingPattern = regexp.MustCompile(`(?m)^\s{0,3}#{1,6}\s+\S`)
+	boldHeadingPattern     = regexp.MustCompile(`(?m)^\s*\*\*[^*\n]{2,60}\*\*:?\s*$`)
+	codeFencePattern       = regexp.MustCompile("(?m)^\\s*(```|~~~)")
+	indentedCodePattern    = regexp.MustCompile(`(?m)(^(?: {4}|\t)\S.*\n){2,}`)
+	logLinePattern         = regexp.MustCompile(`(?m)(^\s*(panic:|goroutine \d+|Traceback|Exception|E\d{4} |\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}))|(\.go:\d+)`)
+)
+
+func loadBodyFilterConfigFromEnv() *BodyFilterConfig {
+	config := &BodyFilterConfig{
+		Enabled:  false,
+		MinWords: 25,
+		Require:  BodyRequireAny,
+	}
+
+	if v := os.Getenv("BODY_FILTER_ENABLED"); v != "" {
+		config.Enabled = v == "true"
+	}
+
+	if v := os.Getenv("BODY_FILTER_MIN_WORDS"); v != "" {
+		if val, err := strconv.Atoi(v); err == nil && val >= 0 {
+			config.MinWords = val
+		}
+	}
+
+	switch v := strings.ToLower(os.Getenv("BODY_FILTER_REQUIRE")); v {
+	case BodyRequireNone, BodyRequireAny, BodyRequireHeadings, BodyRequireCode, BodyRequireBoth:
+		config.Require = v
+	}
+
+	return config
+}
+
+// AnalyzeIssueBody counts words outside code fences, headings, and code or
+// log blocks. An unclosed fence still counts as a block.
+func AnalyzeIssueBody(body string) BodyQuality {
+	quality := BodyQuality{
+		Headings: len(markdownHeadingPattern.FindAllString(body, -1)) + len(boldHeadingPattern.FindAllString(body, -1)),
+	}
+
+	fences := len(codeFencePattern.FindAllString(body, -1))
+	quality.CodeBlocks = (fences + 1) / 2
+	if quality.CodeBlocks == 0 && (indentedCodePattern.MatchString(body) || logLinePattern.MatchString(body)) {
+		quality.CodeBlocks = 1
+	}
+
+	inFence := false
+	for _, line := range strings.Split(body, "\n") {
+		if codeFencePattern.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if !inFence {
+			quality.Words += len(strings.Fields(line))
+		}
+	}
+
+	return quality
+}
+
+// Check reports whether an issue body is worth scoring, and if not, why. A
+// body is rejected only when it is short and also lacks the required
+// structure; a terse report with a stack trace is still actionable.
+func (c *BodyFilterConfig) Check(body string) (bool, string) {
+	if c == nil || !c.Enabled {
+		return true, ""
+	}
+
+	quality := AnalyzeIssueBody(body)
+	if quality.Words >= c.MinWords {
+		return true, ""
+	}
+
+	hasHeadings := quality.Headings > 0
+	hasCode := quality.CodeBlocks > 0
+	problems := []string{fmt.Sprintf("only %d words (minimum %d)", quality.Words, c.MinWords)}
+	switch c.Require {
+	case BodyRequireAny:
+		if hasHeadings || hasCode {
+			return true, ""
+		}
+		problems = append(problems, "no headings and no code/log blocks")
+	case BodyRequireHeadings:
+		if hasHeadings {
+			return true, ""
+		}
+		problems = append(problems, "no headings")
+	case BodyRequireCode:
+		if hasCode {
+			return true, ""
+		}
+		problems = append(problems, "no code/log blocks")
+	case BodyRequireBoth:
+		if hasHeadings && hasCode {
+			return true, ""
+		}
+		if !hasHeadings {
+			problems = append(problems, "no headings")
+		}
+		if !hasCode {
+			problems = append(problems, "no code/log blocks")
+		}
+	}
+
+	return false, "issue body too thin: " + strings.Join(problems, ", ")
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeIssueBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		headings int
+		code     int
+		words    int
+	}{
+		{"empty", "", 0, 0, 0},
+		{"plain", "the controller crashes sometimes", 0, 0, 4},
+		{"markdown heading", "## Steps to reproduce\nrun it", 1, 0, 6},
+		{"bold heading", "**What happened**:\nit broke", 1, 0, 4},
+		{"fenced code", "see below\n```go\nfmt.Println(x)\n```\n", 0, 1, 2},
+		{"unclosed fence", "see below\n```\npanic here", 0, 1, 2},
+		{"log line", "panic: runtime error: index out of range", 0, 1, 7},
+		{"go stack frame", "fails in server.go:142 on startup", 0, 1, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := AnalyzeIssueBody(tt.body)
+			if q.Headings != tt.headings || q.CodeBlocks != tt.code || q.Words != tt.words {
+				t.Errorf("AnalyzeIssueBody() = %+v, want headings=%d code=%d words=%d", q, tt.headings, tt.code, tt.words)
+			}
+		})
+	}
+}
+
+func TestBodyFilterCheck(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+	short := "Crashes on startup."
+	shortWithHeading := "## Description\n" + short
+	shortWithCode := short + "\n```\npanic: runtime error: invalid memory address\n```"
+
+	tests := []struct {
+		name    string
+		config  *BodyFilterConfig
+		body    string
+		allowed bool
+	}{
+		{"nil config", nil, "", true},
+		{"disabled", &BodyFilterConfig{Enabled: false, MinWords: 25, Require: BodyRequireAny}, "", true},
+		{"too short", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireNone}, "tiny", false},
+		{"long without structure", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireAny}, long, true},
+		{"short without structure", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireAny}, short, false},
+		{"short with heading", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireAny}, shortWithHeading, true},
+		{"short with code block", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireAny}, shortWithCode, true},
+		{"code required, short with heading only", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireCode}, shortWithHeading, false},
+		{"code required, short with code", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireCode}, shortWithCode, true},
+		{"both, short with code only", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireBoth}, shortWithCode, false},
+		{"both, short with both", &BodyFilterConfig{Enabled: true, MinWords: 25, Require: BodyRequireBoth}, shortWithHeading + "\n```\nx\n```", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, reason := tt.config.Check(tt.body)
+			if allowed != tt.allowed {
+				t.Errorf("Check() = %v (%q), want %v", allowed, reason, tt.allowed)
+			}
+			if !allowed && !strings.HasPrefix(reason, "issue body too thin: ") {
+				t.Errorf("unexpected reason %q", reason)
+			}
+		})
+	}
+}
+
+func TestLoadBodyFilterConfigFromEnv(t *testing.T) {
+	t.Setenv("BODY_FILTER_ENABLED", "")
+	if loadBodyFilterConfigFromEnv().Enabled {
+		t.Error("body filter should be off unless BODY_FILTER_ENABLED=true")
+	}
+
+	t.Setenv("BODY_FILTER_ENABLED", "false")
+	t.Setenv("BODY_FILTER_MIN_WORDS", "40")
+	t.Setenv("BODY_FILTER_REQUIRE", "Code")
+
+	config := loadBodyFilterConfigFromEnv()
+	if config.Enabled || config.MinWords != 40 || config.Require != BodyRequireCode {
+		t.Errorf("config = %+v", config)
+	}
+
+	t.Setenv("BODY_FILTER_REQUIRE", "everything")
+	if got := loadBodyFilterConfigFromEnv().Require; got != BodyRequireAny {
+		t.Errorf("unknown requirement should fall back to %q, got %q", BodyRequireAny, got)
+	}
+}
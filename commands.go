@@ -4,12 +4,14 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
 )
 
 type CLICommand string
@@ -47,15 +49,27 @@ const (
 	CmdMCPHTTP      CLICommand = "mcp-http"
 	CmdMCPListTools CLICommand = "mcp-list-tools"
 	CmdMCPTest      CLICommand = "mcp-test"
+	CmdOpenLink     CLICommand = "open-link"
+	CmdExtensionAPI CLICommand = "extension-api"
+	CmdExplore      CLICommand = "explore"
+	CmdMoreLike     CLICommand = "more-like"
+	CmdWhyNot       CLICommand = "why-not"
+	CmdMigrateSeen  CLICommand = "migrate-seen"
+	CmdDrift        CLICommand = "drift"
+	CmdSelfTest     CLICommand = "selftest"
+	CmdStatsExport  CLICommand = "stats-export"
+	CmdInit         CLICommand = "init"
+	CmdRegressions  CLICommand = "regressions"
 )
 
 func ParseCLIArgs() (CLICommand, []string) {
-	if len(os.Args) < 2 {
+	cliArgs := ConfigureLite(ConfigureRun(ConfigureLocale(ConfigureOutput(os.Args[1:]))))
+	if len(cliArgs) < 1 {
 		return CmdFind, nil
 	}
 
-	cmd := CLICommand(os.Args[1])
-	args := os.Args[2:]
+	cmd := CLICommand(cliArgs[0])
+	args := cliArgs[1:]
 
 	return cmd, args
 }
@@ -73,7 +87,7 @@ func RunCLICommand(ctx context.Context, finder *IssueFinder, tracker *IssueTrack
 	case CmdList:
 		return runListCommand(tracker, args)
 	case CmdStats:
-		return runStatsCommand(finder, tracker, spamManager, notifier)
+		return runStatsCommand(finder, tracker, spamManager, notifier, args)
 	case CmdDigest:
 		return runDigestCommand(spamManager, notifier, args)
 	case CmdCleanup:
@@ -124,30 +138,54 @@ func RunCLICommand(ctx context.Context, finder *IssueFinder, tracker *IssueTrack
 		return runMCPListToolsCommand(args)
 	case CmdMCPTest:
 		return runMCPTestCommand(args)
+	case CmdOpenLink:
+		return runOpenLinkCommand(args)
+	case CmdExtensionAPI:
+		return runExtensionAPICommand(args)
+	case CmdExplore:
+		return runExploreCommand(args)
+	case CmdMoreLike:
+		return runMoreLikeCommand(args)
+	case CmdWhyNot:
+		return runWhyNotCommand(args)
+	case CmdMigrateSeen:
+		return runMigrateSeenCommand(args)
+	case CmdDrift:
+		return runDriftCommand(args)
+	case CmdSelfTest:
+		return runSelfTestCommand(args)
+	case CmdStatsExport:
+		return runStatsExportCommand(args)
+	case CmdInit:
+		return runInitCommand(args)
+	case CmdRegressions:
+		return runRegressionsCommand(args)
 	default:
 		return fmt.Errorf("unknown command: %s", cmd)
 	}
 }
 
 func runFindCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding issues...")
+	fmt.Fprintln(stdout, T("find.searching"))
+	pipeline := startPipelineRun()
 	issues, err := finder.FindIssues(ctx)
 	if err != nil {
 		return err
 	}
 
 	filtered := spamManager.FilterNotifications(issues)
+	defer func() { pipeline.Finish(finder.db, issues, len(filtered)) }()
 
 	if len(filtered) == 0 {
-		fmt.Println("No new issues found after filtering.")
+		fmt.Fprintln(stdout, T("find.none_after_filter"))
 		return nil
 	}
 
-	PrintGoodFirstIssues(filtered, "NEW ISSUES FOUND")
+	PrintGoodFirstIssues(filtered, T("find.title_new"))
 
 	for _, issue := range filtered {
 		if err := spamManager.RecordNotification(issue.Project.Name, issue.URL, issue.Number); err != nil {
-			fmt.Printf("Warning: failed to record notification for %s: %v\n", issue.URL, err)
+			fmt.Fprintf(stdout, "Warning: failed to record notification for %s: %v\n", issue.URL, err)
 		}
 	}
 
@@ -190,8 +228,8 @@ func runTrackCommand(tracker *IssueTracker, args []string) error {
 		return err
 	}
 
-	fmt.Printf("✅ Tracking issue: %s\n", *url)
-	fmt.Printf("   Status: %s\n", *status)
+	fmt.Fprintln(stdout, T("track.tracking", *url))
+	fmt.Fprintln(stdout, T("track.status", *status))
 	return nil
 }
 
@@ -212,21 +250,21 @@ func runStatusCommand(tracker *IssueTracker, args []string) error {
 		return fmt.Errorf("issue not found: %w", err)
 	}
 
-	fmt.Printf("Issue: %s\n", issue.IssueURL)
-	fmt.Printf("Title: %s\n", issue.IssueTitle)
-	fmt.Printf("Project: %s/%s\n", issue.ProjectOrg, issue.ProjectName)
-	fmt.Printf("Status: %s\n", issue.Status)
-	fmt.Printf("Score: %.2f\n", issue.Score)
+	fmt.Fprintf(stdout, "Issue: %s\n", issue.IssueURL)
+	fmt.Fprintf(stdout, "Title: %s\n", issue.IssueTitle)
+	fmt.Fprintf(stdout, "Project: %s/%s\n", issue.ProjectOrg, issue.ProjectName)
+	fmt.Fprintf(stdout, "Status: %s\n", issue.Status)
+	fmt.Fprintf(stdout, "Score: %.2f\n", issue.Score)
 	if issue.Notes != "" {
-		fmt.Printf("Notes: %s\n", issue.Notes)
+		fmt.Fprintf(stdout, "Notes: %s\n", issue.Notes)
 	}
-	fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04"))
-	fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04"))
+	fmt.Fprintf(stdout, "Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04"))
+	fmt.Fprintf(stdout, "Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04"))
 	if issue.StartedAt != nil {
-		fmt.Printf("Started: %s\n", issue.StartedAt.Format("2006-01-02 15:04"))
+		fmt.Fprintf(stdout, "Started: %s\n", issue.StartedAt.Format("2006-01-02 15:04"))
 	}
 	if issue.CompletedAt != nil {
-		fmt.Printf("Completed: %s\n", issue.CompletedAt.Format("2006-01-02 15:04"))
+		fmt.Fprintf(stdout, "Completed: %s\n", issue.CompletedAt.Format("2006-01-02 15:04"))
 	}
 
 	return nil
@@ -237,27 +275,103 @@ func runUpdateCommand(tracker *IssueTracker, args []string) error {
 	url := fs.String("url", "", "Issue URL to update")
 	status := fs.String("status", "", "New status (interested, assigned, in_progress, completed, abandoned)")
 	notes := fs.String("notes", "", "Update notes")
+	allMatching := fs.String("all-matching", "", `Update every tracked issue matching a filter, e.g. "status=interested older-than=30d"`)
+	dryRun := fs.Bool("dry-run", false, "Show which issues a bulk update would change without changing them")
 
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
 	if *url == "" {
-		return fmt.Errorf("--url is required")
+		var urls []string
+		switch {
+		case *allMatching != "":
+			filter, err := ParseTrackerFilter(*allMatching)
+			if err != nil {
+				return err
+			}
+			if filter.IsEmpty() {
+				return fmt.Errorf("--all-matching needs at least one filter")
+			}
+			issues, err := tracker.GetAll()
+			if err != nil {
+				return err
+			}
+			for _, issue := range FilterTracked(issues, filter, time.Now()) {
+				urls = append(urls, issue.IssueURL)
+			}
+		case !isTerminal(os.Stdin):
+			var err error
+			if urls, err = readIssueURLs(os.Stdin); err != nil {
+				return fmt.Errorf("failed to read issue URLs: %w", err)
+			}
+		default:
+			return fmt.Errorf("--url, --all-matching or issue URLs on stdin are required")
+		}
+		return runBulkUpdate(tracker, urls, *status, *notes, *dryRun)
 	}
 
 	if *status != "" {
 		if err := tracker.UpdateStatus(*url, WorkStatus(*status)); err != nil {
 			return err
 		}
-		fmt.Printf("✅ Updated status to: %s\n", *status)
+		fmt.Fprintf(stdout, "✅ Updated status to: %s\n", *status)
 	}
 
 	if *notes != "" {
 		if err := tracker.UpdateNotes(*url, *notes); err != nil {
 			return err
 		}
-		fmt.Printf("✅ Updated notes\n")
+		fmt.Fprintf(stdout, "✅ Updated notes\n")
+	}
+
+	return nil
+}
+
+func runBulkUpdate(tracker *IssueTracker, urls []string, status, notes string, dryRun bool) error {
+	if status == "" && notes == "" {
+		return fmt.Errorf("--status or --notes is required")
+	}
+	var workStatus WorkStatus
+	if status != "" {
+		var err error
+		if workStatus, err = parseWorkStatus(status); err != nil {
+			return err
+		}
+	}
+
+	if len(urls) == 0 {
+		fmt.Fprintln(stdout, "No matching issues to update.")
+		return nil
+	}
+
+	if dryRun {
+		for _, url := range urls {
+			fmt.Fprintf(stdout, "Would update %s\n", url)
+		}
+		fmt.Fprintf(stdout, "%d issue(s) would be updated (dry run)\n", len(urls))
+		return nil
+	}
+
+	if status != "" {
+		updated, missing, err := tracker.UpdateStatusMany(urls, workStatus)
+		if err != nil {
+			return err
+		}
+		for _, url := range missing {
+			fmt.Fprintf(stdout, "⚠️ Not tracked, skipped: %s\n", url)
+		}
+		fmt.Fprintf(stdout, "✅ Updated status to %s for %d issue(s)\n", workStatus, updated)
+	}
+
+	if notes != "" {
+		updated := 0
+		for _, url := range urls {
+			if err := tracker.UpdateNotes(url, notes); err == nil {
+				updated++
+			}
+		}
+		fmt.Fprintf(stdout, "✅ Updated notes for %d issue(s)\n", updated)
 	}
 
 	return nil
@@ -265,47 +379,86 @@ func runUpdateCommand(tracker *IssueTracker, args []string) error {
 
 func runListCommand(tracker *IssueTracker, args []string) error {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
-	status := fs.String("status", "", "Filter by status (optional)")
+	status := fs.String("status", "", "Filter by status, several separated by | (optional)")
 	all := fs.Bool("all", false, "List all tracked issues")
+	olderThan := fs.String("older-than", "", "Only issues not updated for this long, e.g. 30d or 2w")
+	project := fs.String("project", "", "Only issues in this org or org/name")
+	label := fs.String("label", "", "Only issues with this label")
+	urlsOnly := fs.Bool("urls", false, "Print only issue URLs, one per line, for piping into update")
 
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
-	var issues []TrackedIssue
-	var err error
+	var filter TrackerFilter
+	for key, value := range map[string]string{"status": *status, "older-than": *olderThan, "project": *project, "label": *label} {
+		if value == "" {
+			continue
+		}
+		if err := filter.Set(key, value); err != nil {
+			return err
+		}
+	}
 
-	if *status != "" {
-		issues, err = tracker.GetByStatus(WorkStatus(*status))
-	} else if *all {
-		issues, err = tracker.GetAll()
-	} else {
-		fmt.Println("Use --status <status> or --all to list issues")
+	if filter.IsEmpty() && !*all {
+		fmt.Fprintln(stdout, T("list.usage_hint"))
 		return nil
 	}
 
+	issues, err := tracker.GetAll()
 	if err != nil {
 		return err
 	}
+	issues = FilterTracked(issues, filter, time.Now())
+
+	if *urlsOnly {
+		for _, issue := range issues {
+			fmt.Fprintln(stdout, issue.IssueURL)
+		}
+		return nil
+	}
 
 	if len(issues) == 0 {
-		fmt.Println("No tracked issues found.")
+		fmt.Fprintln(stdout, T("list.none"))
 		return nil
 	}
 
-	fmt.Printf("\nTracked Issues (%d total)\n", len(issues))
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n"+T("list.title", len(issues)))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
+	hasNotes := false
 	for _, issue := range issues {
-		statusEmoji := getStatusEmoji(issue.Status)
-		fmt.Printf("\n%s [%s] %s\n", statusEmoji, issue.Status, issue.IssueTitle)
-		fmt.Printf("   Project: %s/%s | Score: %.2f\n", issue.ProjectOrg, issue.ProjectName, issue.Score)
-		fmt.Printf("   URL: %s\n", issue.IssueURL)
 		if issue.Notes != "" {
-			fmt.Printf("   Notes: %s\n", issue.Notes)
+			hasNotes = true
+			break
 		}
 	}
 
+	columns := []TableColumn{
+		{Header: T("col.status")},
+		{Header: T("col.score"), Align: AlignRight},
+		{Header: T("col.project")},
+		{Header: T("col.title"), Flex: true},
+		{Header: T("col.url")},
+	}
+	if hasNotes {
+		columns = append(columns, TableColumn{Header: T("col.notes"), Flex: true})
+	}
+
+	table := NewTable(columns...)
+	for _, issue := range issues {
+		table.AddCells(
+			TableCell{Text: fmt.Sprintf("%s %s", getStatusEmoji(issue.Status), issue.Status)},
+			TableCell{Text: fmt.Sprintf("%.2f", issue.Score), Color: scoreColor(issue.Score)},
+			TableCell{Text: fmt.Sprintf("%s/%s", issue.ProjectOrg, issue.ProjectName)},
+			TableCell{Text: issue.IssueTitle},
+			TableCell{Text: issue.IssueURL},
+			TableCell{Text: issue.Notes},
+		)
+	}
+	fmt.Fprintln(stdout)
+	table.Render(stdout)
+
 	return nil
 }
 
@@ -326,39 +479,84 @@ func getStatusEmoji(status WorkStatus) string {
 	}
 }
 
-func runStatsCommand(finder *IssueFinder, tracker *IssueTracker, spamManager *NotificationSpamManager, notifier *LocalNotifier) error {
-	fmt.Println("\n📊 GitHub Issue Finder Statistics")
-	fmt.Println(strings.Repeat("=", 80))
+func runStatsCommand(finder *IssueFinder, tracker *IssueTracker, spamManager *NotificationSpamManager, notifier *LocalNotifier, args []string) error {
+	runs, weeks := 10, 8
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--runs" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --runs value %q", args[i+1])
+			}
+			runs = n
+			i++
+		case args[i] == "--weeks" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --weeks value %q", args[i+1])
+			}
+			weeks = n
+			i++
+		}
+	}
+
+	fmt.Fprintln(stdout, "\n"+T("stats.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	activeCount, err := tracker.GetActiveCount()
 	if err == nil {
-		fmt.Printf("Active tracked issues: %d\n", activeCount)
+		fmt.Fprintln(stdout, T("stats.active", activeCount))
 	}
 
 	if spamManager != nil {
 		stats := spamManager.GetStats()
-		fmt.Printf("\nNotification Stats:\n")
-		fmt.Printf("  Hourly: %v/%v\n", stats["hourly_notifications"], stats["hourly_limit"])
-		fmt.Printf("  Daily: %v/%v\n", stats["daily_notifications"], stats["daily_limit"])
-		fmt.Printf("  Recent notifications: %v\n", stats["recent_notifications"])
-		fmt.Printf("  Projects notified: %v\n", stats["projects_notified"])
-		fmt.Printf("  Daily comments: %v/%v\n", stats["daily_comments"], stats["max_comments_per_day"])
-		fmt.Printf("  GitHub calls: %v/%v\n", stats["github_calls"], stats["github_calls_limit"])
+		fmt.Fprintln(stdout, "\n"+T("stats.notifications"))
+		fmt.Fprintf(stdout, "  Hourly: %v/%v\n", stats["hourly_notifications"], stats["hourly_limit"])
+		fmt.Fprintf(stdout, "  Daily: %v/%v\n", stats["daily_notifications"], stats["daily_limit"])
+		fmt.Fprintf(stdout, "  Recent notifications: %v\n", stats["recent_notifications"])
+		fmt.Fprintf(stdout, "  Projects notified: %v\n", stats["projects_notified"])
+		fmt.Fprintf(stdout, "  Daily comments: %v/%v\n", stats["daily_comments"], stats["max_comments_per_day"])
+		fmt.Fprintf(stdout, "  GitHub calls: %v/%v\n", stats["github_calls"], stats["github_calls_limit"])
 	}
 
 	if notifier != nil {
 		emailStats := notifier.GetEmailStats()
-		fmt.Printf("\nEmail Stats:\n")
-		fmt.Printf("  Enabled: %v\n", emailStats["enabled"])
+		fmt.Fprintln(stdout, "\n"+T("stats.email"))
+		fmt.Fprintf(stdout, "  Enabled: %v\n", emailStats["enabled"])
 		if emailStats["enabled"] == true {
-			fmt.Printf("  Hourly sent: %v/%v\n", emailStats["hourly_sent"], emailStats["hourly_limit"])
-			fmt.Printf("  Daily sent: %v/%v\n", emailStats["daily_sent"], emailStats["daily_limit"])
+			fmt.Fprintf(stdout, "  Hourly sent: %v/%v\n", emailStats["hourly_sent"], emailStats["hourly_limit"])
+			fmt.Fprintf(stdout, "  Daily sent: %v/%v\n", emailStats["daily_sent"], emailStats["daily_limit"])
+		}
+	}
+
+	if finder != nil && finder.db != nil {
+		since := weekStart(time.Now()).AddDate(0, 0, -7*(weeks-1))
+		pipelineRuns, err := loadPipelineRuns(finder.db, since)
+		if err != nil {
+			return fmt.Errorf("failed to load pipeline runs: %w", err)
 		}
+		printPipelineFunnel(stdout, pipelineRuns, runs)
 	}
 
 	return nil
 }
 
+// runStatsCLI opens the finder, tracker and anti-spam manager that stats
+// reports on.
+func runStatsCLI(args []string) error {
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	finder, ok := server.finder.(*IssueFinder)
+	if !ok {
+		return fmt.Errorf("stats needs the database-backed issue finder")
+	}
+	return runStatsCommand(finder, server.tracker, finder.antiSpam, finder.notifier, args)
+}
+
 func runDigestCommand(spamManager *NotificationSpamManager, notifier *LocalNotifier, args []string) error {
 	sendEmail := false
 	for _, arg := range args {
@@ -373,25 +571,25 @@ func runDigestCommand(spamManager *NotificationSpamManager, notifier *LocalNotif
 	}
 
 	if len(issues) == 0 {
-		fmt.Println("No issues in today's digest.")
+		fmt.Fprintln(stdout, T("digest.none"))
 		return nil
 	}
 
 	DisplayIssueDigest(issues, time.Now().Format("2006-01-02"))
 
 	if sendEmail && notifier != nil {
-		fmt.Println("\nSending email digest...")
+		fmt.Fprintln(stdout, "\n"+T("digest.sending"))
 		if err := notifier.SendDigestEmail(issues); err != nil {
 			return fmt.Errorf("failed to send email digest: %w", err)
 		}
-		fmt.Println("Email digest sent successfully!")
+		fmt.Fprintln(stdout, T("digest.sent"))
 	}
 
 	return nil
 }
 
 func runConfirmedCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding confirmed good first issues...")
+	fmt.Fprintln(stdout, "Finding confirmed good first issues...")
 	issues, err := finder.FindConfirmedGoodFirstIssues(ctx, "")
 	if err != nil {
 		return err
@@ -417,42 +615,80 @@ func runEmailTestCommand(notifier *LocalNotifier) error {
 		return fmt.Errorf("notifier not initialized")
 	}
 
-	fmt.Println("Testing email configuration...")
+	fmt.Fprintln(stdout, "Testing email configuration...")
 
 	if err := notifier.TestEmail(); err != nil {
 		return fmt.Errorf("email test failed: %w", err)
 	}
 
-	fmt.Println("✅ Test email sent successfully!")
+	fmt.Fprintln(stdout, "✅ Test email sent successfully!")
 	return nil
 }
 
 func runCleanupCommand(finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Running cleanup...")
+	fmt.Fprintln(stdout, "Running cleanup...")
 
 	if err := spamManager.CleanupOldRecords(); err != nil {
-		fmt.Printf("Warning: cleanup failed: %v\n", err)
+		fmt.Fprintf(stdout, "Warning: cleanup failed: %v\n", err)
+	}
+
+	if cacheConfig := loadAPICacheConfigFromEnv(); cacheConfig.Enabled {
+		if pruned, err := pruneAPICache(finder.db, cacheConfig.MaxAge); err != nil {
+			fmt.Fprintf(stdout, "Warning: API cache cleanup failed: %v\n", err)
+		} else {
+			fmt.Fprintf(stdout, "Pruned %d cached API responses\n", pruned)
+		}
+	}
+
+	if retention := loadRetentionConfigFromEnv(); retention.Enabled {
+		if result, err := ApplyRetention(finder.db, retention, time.Now()); err != nil {
+			fmt.Fprintf(stdout, "Warning: history retention failed: %v\n", err)
+		} else {
+			fmt.Fprintf(stdout, "History retention (%s): %s\n", retention.describe(), result)
+		}
+	} else {
+		fmt.Fprintln(stdout, "History retention is off (set RETENTION_ENABLED=true to roll up old rows)")
 	}
 
-	fmt.Println("✅ Cleanup complete")
+	fmt.Fprintln(stdout, "✅ Cleanup complete")
 	return nil
 }
 
+// runWithTracker opens only the database and tracker, which is all the
+// tracker commands need; no GitHub token is required.
+func runWithTracker(run func(*IssueTracker, []string) error, args []string) error {
+	config, err := LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	db, err := sqlx.Connect("postgres", config.DBConnectionString)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	tracker, err := NewIssueTracker(db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to initialize tracker: %w", err)
+	}
+	return run(tracker, args)
+}
+
 func runGoodFirstCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding good first issues...")
+	fmt.Fprintln(stdout, T("find.searching_good_first"))
 	issues, err := finder.FindGoodFirstIssues(ctx, []string{"Kubernetes", "Monitoring", "CI/CD", "ML/AI"})
 	if err != nil {
 		return err
 	}
 
 	filtered := spamManager.FilterNotifications(issues)
-	PrintGoodFirstIssues(filtered, "GOOD FIRST ISSUES")
+	PrintGoodFirstIssues(filtered, T("find.title_good_first"))
 
 	return nil
 }
 
 func runActionableCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding actionable issues...")
+	fmt.Fprintln(stdout, "Finding actionable issues...")
 	issues, err := finder.FindActionableIssues(ctx)
 	if err != nil {
 		return err
@@ -465,7 +701,7 @@ func runActionableCommand(ctx context.Context, finder *IssueFinder, spamManager
 }
 
 func runBugsCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding qualified bug issues...")
+	fmt.Fprintln(stdout, "Finding qualified bug issues...")
 
 	qualifiedFinder := NewQualifiedIssueFinder(finder.client, finder.rateLimiter, finder.projects)
 	issues, err := qualifiedFinder.FindBugs(ctx, 0.6)
@@ -480,7 +716,7 @@ func runBugsCommand(ctx context.Context, finder *IssueFinder, spamManager *Notif
 }
 
 func runFeaturesCommand(ctx context.Context, finder *IssueFinder, spamManager *NotificationSpamManager) error {
-	fmt.Println("Finding qualified feature issues...")
+	fmt.Fprintln(stdout, "Finding qualified feature issues...")
 
 	qualifiedFinder := NewQualifiedIssueFinder(finder.client, finder.rateLimiter, finder.projects)
 	issues, err := qualifiedFinder.FindFeatures(ctx, 0.6)
@@ -517,7 +753,7 @@ func runNotifyCommand(ctx context.Context, finder *IssueFinder, spamManager *Not
 		}
 	}
 
-	fmt.Printf("Finding qualified issues (min score: %.2f)...\n", minScore)
+	fmt.Fprintf(stdout, "Finding qualified issues (min score: %.2f)...\n", minScore)
 
 	qualifiedFinder := NewQualifiedIssueFinder(finder.client, finder.rateLimiter, finder.projects)
 	issues, err := qualifiedFinder.FindQualifiedIssues(ctx, minScore)
@@ -532,7 +768,7 @@ func runNotifyCommand(ctx context.Context, finder *IssueFinder, spamManager *Not
 		for _, issue := range filtered {
 			if issue.QualifiedScore.TotalScore >= 0.7 {
 				if err := notifier.SendQualifiedIssueEmail(issue); err != nil {
-					fmt.Printf("Warning: failed to send email for %s: %v\n", issue.URL, err)
+					fmt.Fprintf(stdout, "Warning: failed to send email for %s: %v\n", issue.URL, err)
 				} else {
 					emailCount++
 				}
@@ -551,8 +787,8 @@ func runNotifyCommand(ctx context.Context, finder *IssueFinder, spamManager *Not
 }
 
 func runMineCommand(tracker *IssueTracker) error {
-	fmt.Println("\n📋 YOUR ASSIGNED ISSUES")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n📋 YOUR ASSIGNED ISSUES")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	issues, err := tracker.GetByStatus(StatusInProgress)
 	if err != nil {
@@ -565,22 +801,22 @@ func runMineCommand(tracker *IssueTracker) error {
 	}
 
 	if len(issues) == 0 {
-		fmt.Println("No issues assigned to you.")
+		fmt.Fprintln(stdout, "No issues assigned to you.")
 		return nil
 	}
 
 	for i, issue := range issues {
 		emoji := getStatusEmoji(issue.Status)
-		fmt.Printf("\n%s [%d] %s\n", emoji, i+1, issue.IssueTitle)
-		fmt.Printf("   Project: %s/%s\n", issue.ProjectOrg, issue.ProjectName)
-		fmt.Printf("   Status: %s | Score: %.2f\n", issue.Status, issue.Score)
-		fmt.Printf("   URL: %s\n", issue.IssueURL)
+		fmt.Fprintf(stdout, "\n%s [%d] %s\n", emoji, i+1, issue.IssueTitle)
+		fmt.Fprintf(stdout, "   Project: %s/%s\n", issue.ProjectOrg, issue.ProjectName)
+		fmt.Fprintf(stdout, "   Status: %s | Score: %.2f\n", issue.Status, issue.Score)
+		fmt.Fprintf(stdout, "   URL: %s\n", issue.IssueURL)
 		if issue.Notes != "" {
-			fmt.Printf("   Notes: %s\n", issue.Notes)
+			fmt.Fprintf(stdout, "   Notes: %s\n", issue.Notes)
 		}
 	}
 
-	fmt.Printf("\nTotal: %d issues\n", len(issues))
+	fmt.Fprintf(stdout, "\nTotal: %d issues\n", len(issues))
 	return nil
 }
 
@@ -595,78 +831,111 @@ func filterQualifiedBySpam(issues []QualifiedIssue, spamManager *NotificationSpa
 	return filtered
 }
 
+type usageEntry struct {
+	usage string
+	key   string
+}
+
+func printUsageSection(titleKey string, entries []usageEntry) {
+	fmt.Fprintln(stdout, T(titleKey))
+	for _, entry := range entries {
+		fmt.Fprintf(stdout, "  %-18s %s\n", entry.usage, T(entry.key))
+	}
+	fmt.Fprintln(stdout)
+}
+
 func PrintUsage() {
-	fmt.Println("GitHub Issue Finder - Find Qualified Issues")
-	fmt.Println()
-	fmt.Println("Usage: github-issue-finder <command> [options]")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  start              Start automated daily search")
-	fmt.Println("  search             One-time search")
-	fmt.Println("  preview            Preview what would be commented (dry-run)")
-	fmt.Println("  commit             Actually post comments")
-	fmt.Println("  limits             Show current smart limits status")
-	fmt.Println("  comment <issue>    Comment on specific issue")
-	fmt.Println("  status             Show today's status")
-	fmt.Println("  config             Configure settings")
-	fmt.Println("  enable             Enable auto mode")
-	fmt.Println("  disable            Disable auto mode")
-	fmt.Println("  repos              List managed repos")
-	fmt.Println("  repos add <owner/repo>     Add repo")
-	fmt.Println("  repos remove <owner/repo>  Remove repo")
-	fmt.Println("  history            Show comment history")
-	fmt.Println("  find               Find qualified issues (default)")
-	fmt.Println("  bugs               Find qualified bug issues")
-	fmt.Println("  features           Find qualified feature issues")
-	fmt.Println("  notify             Find and send notifications for qualified issues")
-	fmt.Println("  mine               Check your assigned issues")
-	fmt.Println("  stats              Show statistics")
-	fmt.Println("  digest             Show daily digest of issues")
-	fmt.Println("  track              Track an issue you're working on")
-	fmt.Println("  update             Update a tracked issue's status or notes")
-	fmt.Println("  list               List tracked issues")
-	fmt.Println("  email-test         Test email configuration")
-	fmt.Println("  cleanup            Clean up old notification records")
-	fmt.Println()
-	fmt.Println("Monitor Commands:")
-	fmt.Println("  monitor start      Start continuous monitoring daemon")
-	fmt.Println("  monitor stop       Stop monitoring daemon")
-	fmt.Println("  monitor status     Show monitor status and configuration")
-	fmt.Println("  monitor check      Run a single monitoring check now")
-	fmt.Println("  monitor notify     Test notification system")
-	fmt.Println()
-	fmt.Println("MCP Server Commands:")
-	fmt.Println("  mcp                Run as MCP server (stdio mode for Claude Desktop, etc.)")
-	fmt.Println("  mcp-http           Run as MCP HTTP server (for web integrations)")
-	fmt.Println("  mcp-list-tools     List all available MCP tools")
-	fmt.Println("  mcp-test           Test MCP server functionality")
-	fmt.Println()
-	fmt.Println("Notify Options:")
-	fmt.Println("  --email          Send email for high-scoring issues (>0.7)")
-	fmt.Println("  --local          Send local/desktop notifications (default)")
-	fmt.Println("  --no-local       Disable local notifications")
-	fmt.Println("  --score-min N    Minimum score threshold (default: 0.6)")
-	fmt.Println()
-	fmt.Println("Smart Limits Configuration:")
-	fmt.Println("  Base daily limit: 3 comments")
-	fmt.Println("  Max daily limit: 7 comments (with high-quality issues)")
-	fmt.Println("  Weekly cap: 15 comments")
-	fmt.Println("  Max per repo per day: 1 comment")
-	fmt.Println()
-	fmt.Println("Examples:")
-	fmt.Println("  github-issue-finder preview     # See what would be commented")
-	fmt.Println("  github-issue-finder commit      # Post the comments")
-	fmt.Println("  github-issue-finder limits      # Check current limits")
-	fmt.Println("  github-issue-finder start")
-	fmt.Println("  github-issue-finder search")
-	fmt.Println("  github-issue-finder comment https://github.com/owner/repo/issues/123")
-	fmt.Println("  github-issue-finder repos add kubernetes/kubernetes")
-	fmt.Println("  github-issue-finder find")
-	fmt.Println("  github-issue-finder bugs")
-	fmt.Println("  github-issue-finder notify --email --score-min 0.7")
-	fmt.Println("  github-issue-finder digest --send-email")
-	fmt.Println("  github-issue-finder mine")
-	fmt.Println("  github-issue-finder stats")
+	fmt.Fprintln(stdout, T("usage.title"))
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, T("usage.line"))
+	fmt.Fprintln(stdout)
+	printUsageSection("usage.commands", []usageEntry{
+		{"start", "cmd.start"},
+		{"search", "cmd.search"},
+		{"preview", "cmd.preview"},
+		{"commit", "cmd.commit"},
+		{"limits", "cmd.limits"},
+		{"comment <issue>", "cmd.comment"},
+		{"status", "cmd.status"},
+		{"config", "cmd.config"},
+		{"enable", "cmd.enable"},
+		{"disable", "cmd.disable"},
+		{"repos", "cmd.repos"},
+		{"repos add <owner/repo>", "cmd.repos_add"},
+		{"repos remove <owner/repo>", "cmd.repos_remove"},
+		{"history", "cmd.history"},
+		{"find", "cmd.find"},
+		{"bugs", "cmd.bugs"},
+		{"features", "cmd.features"},
+		{"explore --sample N --days N", "cmd.explore"},
+		{"more-like <issue>", "cmd.more_like"},
+		{"why-not <issue>", "cmd.why_not"},
+		{"regressions [--window <days>]", "cmd.regressions"},
+		{"notify", "cmd.notify"},
+		{"mine", "cmd.mine"},
+		{"stats [--runs <n>] [--weeks <n>]", "cmd.stats"},
+		{"stats-export [--public]", "cmd.stats_export"},
+		{"digest", "cmd.digest"},
+		{"track", "cmd.track"},
+		{"update", "cmd.update"},
+		{"list", "cmd.list"},
+		{"email-test", "cmd.email_test"},
+		{"cleanup", "cmd.cleanup"},
+		{"migrate-seen", "cmd.migrate_seen"},
+		{"drift [--fix]", "cmd.drift"},
+		{"selftest --repo <owner/repo> [--comment]", "cmd.selftest"},
+		{"init --from-stars|--from-notifications", "cmd.init"},
+		{"open-link <link>", "cmd.open_link"},
+	})
+	printUsageSection("usage.monitor_commands", []usageEntry{
+		{"monitor start", "cmd.monitor_start"},
+		{"monitor stop", "cmd.monitor_stop"},
+		{"monitor status", "cmd.monitor_status"},
+		{"monitor check", "cmd.monitor_check"},
+		{"monitor notify", "cmd.monitor_notify"},
+	})
+	printUsageSection("usage.mcp_commands", []usageEntry{
+		{"mcp", "cmd.mcp"},
+		{"mcp-http", "cmd.mcp_http"},
+		{"mcp-list-tools", "cmd.mcp_list_tools"},
+		{"mcp-test", "cmd.mcp_test"},
+		{"extension-api", "cmd.extension_api"},
+	})
+	printUsageSection("usage.output_options", []usageEntry{
+		{"--plain", "opt.plain"},
+		{"--no-emoji", "opt.no_emoji"},
+		{"--no-color", "opt.no_color"},
+		{"--lang CODE", "opt.lang"},
+		{"--seed N", "opt.seed"},
+		{"--deterministic", "opt.deterministic"},
+		{"--lite", "opt.lite"},
+	})
+	printUsageSection("usage.notify_options", []usageEntry{
+		{"--email", "opt.email"},
+		{"--local", "opt.local"},
+		{"--no-local", "opt.no_local"},
+		{"--score-min N", "opt.score_min"},
+	})
+	fmt.Fprintln(stdout, T("usage.smart_limits"))
+	fmt.Fprintln(stdout, "  "+T("usage.limit_base", 3))
+	fmt.Fprintln(stdout, "  "+T("usage.limit_max", 7))
+	fmt.Fprintln(stdout, "  "+T("usage.limit_weekly", 15))
+	fmt.Fprintln(stdout, "  "+T("usage.limit_per_repo", 1))
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, T("usage.examples"))
+	fmt.Fprintln(stdout, "  github-issue-finder preview     # See what would be commented")
+	fmt.Fprintln(stdout, "  github-issue-finder commit      # Post the comments")
+	fmt.Fprintln(stdout, "  github-issue-finder limits      # Check current limits")
+	fmt.Fprintln(stdout, "  github-issue-finder start")
+	fmt.Fprintln(stdout, "  github-issue-finder search")
+	fmt.Fprintln(stdout, "  github-issue-finder comment https://github.com/owner/repo/issues/123")
+	fmt.Fprintln(stdout, "  github-issue-finder repos add kubernetes/kubernetes")
+	fmt.Fprintln(stdout, "  github-issue-finder find")
+	fmt.Fprintln(stdout, "  github-issue-finder bugs")
+	fmt.Fprintln(stdout, "  github-issue-finder notify --email --score-min 0.7")
+	fmt.Fprintln(stdout, "  github-issue-finder digest --send-email")
+	fmt.Fprintln(stdout, "  github-issue-finder mine")
+	fmt.Fprintln(stdout, "  github-issue-finder stats")
 }
 
 func runStartCommand(ctx context.Context, finder *IssueFinder) error {
@@ -674,7 +943,7 @@ func runStartCommand(ctx context.Context, finder *IssueFinder) error {
 		return fmt.Errorf("auto finder not initialized")
 	}
 
-	fmt.Println("Starting automated daily search...")
+	fmt.Fprintln(stdout, "Starting automated daily search...")
 	if err := finder.autoFinder.Enable(); err != nil {
 		return err
 	}
@@ -687,19 +956,19 @@ func runSearchCommand(ctx context.Context, finder *IssueFinder) error {
 		return runFindCommand(ctx, finder, finder.antiSpam)
 	}
 
-	fmt.Println("Searching for issues...")
+	fmt.Fprintln(stdout, "Searching for issues...")
 	issues, err := finder.autoFinder.Search(ctx)
 	if err != nil {
 		return err
 	}
 
 	if len(issues) == 0 {
-		fmt.Println("No qualifying issues found.")
+		fmt.Fprintln(stdout, "No qualifying issues found.")
 		return nil
 	}
 
-	fmt.Printf("\n🔍 TOP ISSUES FOUND (%d total)\n", len(issues))
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n🔍 TOP ISSUES FOUND (%d total)\n", len(issues))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	for i, issue := range issues {
 		if i >= 20 {
@@ -714,14 +983,14 @@ func runSearchCommand(ctx context.Context, finder *IssueFinder) error {
 			emoji = "✨"
 		}
 
-		fmt.Printf("\n%s [%s] %s\n", emoji, grade, issue.IssueData.Title)
-		fmt.Printf("   Score: %.2f | %s/%s\n", issue.Score.Total, issue.Project.Org, issue.Project.Name)
-		fmt.Printf("   Category: %s | Comments: %d\n", issue.Project.Category, issue.IssueData.Comments)
-		fmt.Printf("   URL: %s\n", issue.IssueData.URL)
+		fmt.Fprintf(stdout, "\n%s [%s] %s\n", emoji, grade, issue.IssueData.Title)
+		fmt.Fprintf(stdout, "   Score: %.2f | %s/%s\n", issue.Score.Total, issue.Project.Org, issue.Project.Name)
+		fmt.Fprintf(stdout, "   Category: %s | Comments: %d\n", issue.Project.Category, issue.IssueData.Comments)
+		fmt.Fprintf(stdout, "   URL: %s\n", issue.IssueData.URL)
 		if len(issue.IssueData.Labels) > 0 {
-			fmt.Printf("   Labels: %s\n", strings.Join(issue.IssueData.Labels, ", "))
+			fmt.Fprintf(stdout, "   Labels: %s\n", strings.Join(issue.IssueData.Labels, ", "))
 		}
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 	}
 
 	return nil
@@ -738,21 +1007,21 @@ func runCommentCommand(ctx context.Context, finder *IssueFinder, args []string)
 		return fmt.Errorf("invalid issue URL: %w", err)
 	}
 
-	fmt.Printf("Posting comment on %s/%s#%d...\n", org, repo, number)
+	fmt.Fprintf(stdout, "Posting comment on %s/%s#%d...\n", org, repo, number)
 
 	commentBody := "Hi! I'd like to help with this issue. I'll start working on it and provide updates."
 	if len(args) > 1 {
 		commentBody = strings.Join(args[1:], " ")
 	}
 
-	_, _, err = finder.client.Issues.CreateComment(ctx, org, repo, number, &github.IssueComment{
+	_, _, err = finder.client.CreateIssueComment(ctx, org, repo, number, &github.IssueComment{
 		Body: &commentBody,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to post comment: %w", err)
 	}
 
-	fmt.Println("✅ Comment posted successfully")
+	fmt.Fprintln(stdout, "✅ Comment posted successfully")
 	return nil
 }
 
@@ -766,13 +1035,13 @@ func runConfigCommand(finder *IssueFinder, args []string) error {
 		return err
 	}
 
-	fmt.Println("\n⚙️  AUTO FINDER CONFIGURATION")
-	fmt.Println(strings.Repeat("=", 50))
-	fmt.Printf("Enabled: %v\n", status.Enabled)
-	fmt.Printf("Auto Comment: %v\n", status.AutoComment)
-	fmt.Printf("Comments Today: %d/%d\n", status.CommentsToday, status.MaxCommentsPerDay)
-	fmt.Printf("Min Score to Comment: %.2f\n", status.MinScoreToComment)
-	fmt.Printf("Found Issues Today: %d\n", status.FoundIssuesCount)
+	fmt.Fprintln(stdout, "\n⚙️  AUTO FINDER CONFIGURATION")
+	fmt.Fprintln(stdout, strings.Repeat("=", 50))
+	fmt.Fprintf(stdout, "Enabled: %v\n", status.Enabled)
+	fmt.Fprintf(stdout, "Auto Comment: %v\n", status.AutoComment)
+	fmt.Fprintf(stdout, "Comments Today: %d/%d\n", status.CommentsToday, status.MaxCommentsPerDay)
+	fmt.Fprintf(stdout, "Min Score to Comment: %.2f\n", status.MinScoreToComment)
+	fmt.Fprintf(stdout, "Found Issues Today: %d\n", status.FoundIssuesCount)
 
 	return nil
 }
@@ -786,7 +1055,7 @@ func runEnableCommand(finder *IssueFinder) error {
 		return err
 	}
 
-	fmt.Println("✅ Auto finder enabled")
+	fmt.Fprintln(stdout, "✅ Auto finder enabled")
 	return nil
 }
 
@@ -799,7 +1068,7 @@ func runDisableCommand(finder *IssueFinder) error {
 		return err
 	}
 
-	fmt.Println("❌ Auto finder disabled")
+	fmt.Fprintln(stdout, "❌ Auto finder disabled")
 	return nil
 }
 
@@ -825,13 +1094,13 @@ func runReposCommand(finder *IssueFinder, args []string) error {
 				Enabled: true,
 			}
 			finder.repoManager.AddRepo(repo)
-			fmt.Printf("✅ Added %s to managed repos\n", repoPath)
+			fmt.Fprintf(stdout, "✅ Added %s to managed repos\n", repoPath)
 			return nil
 		case "remove":
 			if finder.repoManager.RemoveRepo(parts[0], parts[1]) {
-				fmt.Printf("✅ Removed %s from managed repos\n", repoPath)
+				fmt.Fprintf(stdout, "✅ Removed %s from managed repos\n", repoPath)
 			} else {
-				fmt.Printf("⚠️  %s not found in managed repos\n", repoPath)
+				fmt.Fprintf(stdout, "⚠️  %s not found in managed repos\n", repoPath)
 			}
 			return nil
 		}
@@ -840,8 +1109,8 @@ func runReposCommand(finder *IssueFinder, args []string) error {
 	repos := finder.repoManager.ListRepos()
 	categories := finder.repoManager.GetCategories()
 
-	fmt.Println("\n📁 MANAGED REPOSITORIES")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n📁 MANAGED REPOSITORIES")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	for _, cat := range categories {
 		catRepos := finder.repoManager.ListByCategory(cat)
@@ -849,20 +1118,20 @@ func runReposCommand(finder *IssueFinder, args []string) error {
 			continue
 		}
 
-		fmt.Printf("\n%s (%d repos)\n", strings.ToTitle(cat), len(catRepos))
-		fmt.Println(strings.Repeat("-", 40))
+		fmt.Fprintf(stdout, "\n%s (%d repos)\n", strings.ToTitle(cat), len(catRepos))
+		fmt.Fprintln(stdout, strings.Repeat("-", 40))
 
 		for _, repo := range catRepos {
 			enabled := "✓"
 			if !repo.Enabled {
 				enabled = "✗"
 			}
-			fmt.Printf("  [%s] %s/%s (Priority: %d, Stars: %d+)\n",
+			fmt.Fprintf(stdout, "  [%s] %s/%s (Priority: %d, Stars: %d+)\n",
 				enabled, repo.Owner, repo.Name, repo.Priority, repo.MinStars)
 		}
 	}
 
-	fmt.Printf("\nTotal: %d repositories\n", len(repos))
+	fmt.Fprintf(stdout, "\nTotal: %d repositories\n", len(repos))
 	return nil
 }
 
@@ -884,24 +1153,24 @@ func runHistoryCommand(finder *IssueFinder, args []string) error {
 	}
 
 	if len(records) == 0 {
-		fmt.Println("No comment history found.")
+		fmt.Fprintln(stdout, "No comment history found.")
 		return nil
 	}
 
-	fmt.Printf("\n📜 COMMENT HISTORY (last %d)\n", len(records))
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n📜 COMMENT HISTORY (last %d)\n", len(records))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	for i, record := range records {
-		fmt.Printf("\n[%d] %s\n", i+1, record.IssueURL)
-		fmt.Printf("    Repo: %s#%d\n", record.Repo, record.IssueNumber)
-		fmt.Printf("    Commented: %s\n", record.CommentedAt.Format("2006-01-02 15:04"))
-		fmt.Printf("    Score: %.2f\n", record.Score)
+		fmt.Fprintf(stdout, "\n[%d] %s\n", i+1, record.IssueURL)
+		fmt.Fprintf(stdout, "    Repo: %s#%d\n", record.Repo, record.IssueNumber)
+		fmt.Fprintf(stdout, "    Commented: %s\n", record.CommentedAt.Format("2006-01-02 15:04"))
+		fmt.Fprintf(stdout, "    Score: %.2f\n", record.Score)
 		if record.CommentText != "" {
 			preview := record.CommentText
 			if len(preview) > 100 {
 				preview = preview[:100] + "..."
 			}
-			fmt.Printf("    Preview: %s\n", preview)
+			fmt.Fprintf(stdout, "    Preview: %s\n", preview)
 		}
 	}
 
@@ -913,8 +1182,8 @@ func runPreviewCommand(ctx context.Context, finder *IssueFinder) error {
 		return fmt.Errorf("auto finder not initialized")
 	}
 
-	fmt.Println("\n👁️  PREVIEW MODE - Dry Run")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n👁️  PREVIEW MODE - Dry Run")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	previews, err := finder.autoFinder.Preview(ctx)
 	if err != nil {
@@ -922,25 +1191,32 @@ func runPreviewCommand(ctx context.Context, finder *IssueFinder) error {
 	}
 
 	if len(previews) == 0 {
-		fmt.Println("No issues selected for commenting.")
+		fmt.Fprintln(stdout, "No issues selected for commenting.")
 		return nil
 	}
 
-	fmt.Printf("\nWould comment on %d issue(s):\n", len(previews))
+	fmt.Fprintf(stdout, "\nWould comment on %d issue(s):\n", len(previews))
 	for i, preview := range previews {
-		fmt.Printf("\n[%d] %s/%s#%d\n", i+1, preview.Repo, preview.Repo, preview.IssueNumber)
-		fmt.Printf("    Title: %s\n", preview.Title)
-		fmt.Printf("    Score: %.2f\n", preview.Score)
-		fmt.Printf("    URL: %s\n", preview.URL)
-		fmt.Printf("    Comment Preview:\n")
+		fmt.Fprintf(stdout, "\n[%d] %s/%s#%d\n", i+1, preview.Repo, preview.Repo, preview.IssueNumber)
+		fmt.Fprintf(stdout, "    Title: %s\n", preview.Title)
+		fmt.Fprintf(stdout, "    Score: %.2f\n", preview.Score)
+		fmt.Fprintf(stdout, "    URL: %s\n", preview.URL)
+		if !preview.PostAt.IsZero() {
+			timing := "now"
+			if wait := time.Until(preview.PostAt); wait > 0 {
+				timing = fmt.Sprintf("in %s", wait.Round(time.Minute))
+			}
+			fmt.Fprintf(stdout, "    Maintainers active: %s, post %s\n", preview.ActiveWindow, timing)
+		}
+		fmt.Fprintf(stdout, "    Comment Preview:\n")
 		commentPreview := preview.Comment
 		if len(commentPreview) > 150 {
 			commentPreview = commentPreview[:150] + "..."
 		}
-		fmt.Printf("    %s\n", strings.ReplaceAll(commentPreview, "\n", "\n    "))
+		fmt.Fprintf(stdout, "    %s\n", strings.ReplaceAll(commentPreview, "\n", "\n    "))
 	}
 
-	fmt.Println("\n💡 Run 'github-issue-finder commit' to post these comments")
+	fmt.Fprintln(stdout, "\n💡 Run 'github-issue-finder commit' to post these comments")
 	return nil
 }
 
@@ -949,8 +1225,8 @@ func runCommitCommand(ctx context.Context, finder *IssueFinder) error {
 		return fmt.Errorf("auto finder not initialized")
 	}
 
-	fmt.Println("\n✍️  COMMITTING COMMENTS")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n✍️  COMMITTING COMMENTS")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	previews, err := finder.autoFinder.Preview(ctx)
 	if err != nil {
@@ -958,17 +1234,17 @@ func runCommitCommand(ctx context.Context, finder *IssueFinder) error {
 	}
 
 	if len(previews) == 0 {
-		fmt.Println("No issues selected for commenting.")
+		fmt.Fprintln(stdout, "No issues selected for commenting.")
 		return nil
 	}
 
-	fmt.Printf("Ready to post %d comment(s).\n", len(previews))
-	fmt.Print("Proceed? (y/N): ")
+	fmt.Fprintf(stdout, "Ready to post %d comment(s).\n", len(previews))
+	fmt.Fprint(stdout, "Proceed? (y/N): ")
 
 	var response string
 	fmt.Scanln(&response)
 	if strings.ToLower(response) != "y" {
-		fmt.Println("Aborted.")
+		fmt.Fprintln(stdout, "Aborted.")
 		return nil
 	}
 
@@ -981,75 +1257,88 @@ func runCommitCommand(ctx context.Context, finder *IssueFinder) error {
 	for _, result := range results {
 		if result.Success {
 			successCount++
-			fmt.Printf("✅ %s/%s#%d - Comment posted\n", result.Repo, result.Repo, result.IssueNumber)
+			fmt.Fprintf(stdout, "✅ %s/%s#%d - Comment posted\n", result.Repo, result.Repo, result.IssueNumber)
+		} else if !result.QueuedUntil.IsZero() {
+			fmt.Fprintf(stdout, "⏳ %s/%s#%d - Queued until %s, when maintainers are usually active\n", result.Repo, result.Repo, result.IssueNumber, result.QueuedUntil.Format("2006-01-02 15:04 MST"))
 		} else {
-			fmt.Printf("❌ %s/%s#%d - Failed: %s\n", result.Repo, result.Repo, result.IssueNumber, result.Error)
+			fmt.Fprintf(stdout, "❌ %s/%s#%d - Failed: %s\n", result.Repo, result.Repo, result.IssueNumber, result.Error)
 		}
 	}
 
-	fmt.Printf("\n📊 Summary: %d/%d comments posted successfully\n", successCount, len(results))
+	fmt.Fprintf(stdout, "\n📊 Summary: %d/%d comments posted successfully\n", successCount, len(results))
 	return nil
 }
 
 func runLimitsCommand(finder *IssueFinder) error {
 	if finder == nil || finder.autoFinder == nil {
-		fmt.Println("\n📊 SMART COMMENTING LIMITS")
-		fmt.Println(strings.Repeat("=", 80))
-		fmt.Println("\nSmart limiter not initialized (requires database connection).")
-		fmt.Println("\nDefault configuration:")
-		fmt.Println("   Base daily limit: 3")
-		fmt.Println("   Max daily limit: 7 (with high-quality issues)")
-		fmt.Println("   Weekly cap: 15")
-		fmt.Println("   Max per repo per day: 1")
-		fmt.Println("   Quality threshold: 0.85")
-		fmt.Println("   Min score to comment: 0.70")
+		fmt.Fprintln(stdout, "\n"+T("limits.title"))
+		fmt.Fprintln(stdout, strings.Repeat("=", 80))
+		fmt.Fprintln(stdout, "\n"+T("limits.not_initialized"))
+		defaults := &Record{Title: "\n" + T("limits.defaults"), TitleColor: colorBold}
+		defaults.Add("Base daily limit", "3")
+		defaults.Add("Max daily limit", "7 (with high-quality issues)")
+		defaults.Add("Weekly cap", "15")
+		defaults.Add("Max per repo per day", "1")
+		defaults.Add("Quality threshold", "0.85")
+		defaults.Add("Min score to comment", "0.70")
+		defaults.Render(stdout, "   ")
 		return nil
 	}
 
 	if finder.autoFinder.smartLimiter == nil {
-		fmt.Println("\n📊 SMART COMMENTING LIMITS")
-		fmt.Println(strings.Repeat("=", 80))
-		fmt.Println("\nSmart limiter not available.")
+		fmt.Fprintln(stdout, "\n"+T("limits.title"))
+		fmt.Fprintln(stdout, strings.Repeat("=", 80))
+		fmt.Fprintln(stdout, "\n"+T("limits.not_available"))
 		return nil
 	}
 
 	status := finder.autoFinder.smartLimiter.GetStatus()
 
-	fmt.Println("\n📊 SMART COMMENTING LIMITS")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "\n"+T("limits.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
-	fmt.Printf("\n📅 Daily Limits:\n")
-	fmt.Printf("   Comments today: %d\n", status.TodayComments)
-	fmt.Printf("   Repos commented: %d\n", status.TodayRepos)
-	fmt.Printf("   Base limit: %d\n", status.BaseLimit)
-	fmt.Printf("   Max limit (high quality): %d\n", status.MaxLimit)
-	fmt.Printf("   Remaining today: %d\n", status.RemainingToday)
+	daily := &Record{Title: "\n" + T("limits.daily"), TitleColor: colorBold}
+	daily.Add(T("limits.comments_today"), strconv.Itoa(status.TodayComments))
+	daily.Add(T("limits.repos_commented"), strconv.Itoa(status.TodayRepos))
+	daily.Add(T("limits.base_limit"), strconv.Itoa(status.BaseLimit))
+	daily.Add(T("limits.max_limit"), strconv.Itoa(status.MaxLimit))
+	daily.AddColored(T("limits.remaining_today"), strconv.Itoa(status.RemainingToday), remainingColor(status.RemainingToday))
+	daily.Render(stdout, "   ")
 
-	fmt.Printf("\n📆 Weekly Limits:\n")
-	fmt.Printf("   Comments this week: %d\n", status.WeekComments)
-	fmt.Printf("   Weekly cap: %d\n", status.WeeklyLimit)
-	fmt.Printf("   Remaining this week: %d\n", status.RemainingWeekly)
+	weekly := &Record{Title: "\n" + T("limits.weekly"), TitleColor: colorBold}
+	weekly.Add(T("limits.comments_week"), strconv.Itoa(status.WeekComments))
+	weekly.Add(T("limits.weekly_cap"), strconv.Itoa(status.WeeklyLimit))
+	weekly.AddColored(T("limits.remaining_week"), strconv.Itoa(status.RemainingWeekly), remainingColor(status.RemainingWeekly))
+	weekly.Render(stdout, "   ")
 
-	fmt.Printf("\n🎯 Quality Thresholds:\n")
-	fmt.Printf("   Quality threshold (great): %.2f\n", status.QualityThreshold)
-	fmt.Printf("   Minimum score to comment: %.2f\n", status.MinScore)
+	quality := &Record{Title: "\n" + T("limits.quality"), TitleColor: colorBold}
+	quality.Add(T("limits.quality_threshold"), fmt.Sprintf("%.2f", status.QualityThreshold))
+	quality.Add(T("limits.min_score"), fmt.Sprintf("%.2f", status.MinScore))
+	quality.Render(stdout, "   ")
 
 	if len(status.ReposCommented) > 0 {
-		fmt.Printf("\n📝 Repos commented today:\n")
+		fmt.Fprintln(stdout, "\n"+T("limits.repos_today"))
 		for repo := range status.ReposCommented {
-			fmt.Printf("   - %s\n", repo)
+			fmt.Fprintf(stdout, "   - %s\n", repo)
 		}
 	}
 
-	fmt.Println("\n💡 Tips:")
-	fmt.Println("   - Base limit (3) is used for normal quality issues")
-	fmt.Println("   - Max limit (7) applies when you have 3+ high-quality issues from different repos")
-	fmt.Println("   - Each repo gets at most 1 comment per day")
-	fmt.Println("   - Weekly cap prevents over-commenting across the week")
+	fmt.Fprintln(stdout, "\n"+T("limits.tips"))
+	fmt.Fprintln(stdout, "   - Base limit (3) is used for normal quality issues")
+	fmt.Fprintln(stdout, "   - Max limit (7) applies when you have 3+ high-quality issues from different repos")
+	fmt.Fprintln(stdout, "   - Each repo gets at most 1 comment per day")
+	fmt.Fprintln(stdout, "   - Weekly cap prevents over-commenting across the week")
 
 	return nil
 }
 
+func remainingColor(remaining int) string {
+	if remaining <= 0 {
+		return colorRed
+	}
+	return colorGreen
+}
+
 func ParseIssueNumberFromURL(url string) (string, string, int, error) {
 	parts := strings.Split(url, "/")
 	if len(parts) < 7 {
@@ -1102,43 +1391,43 @@ func runStartMonitorCommand(ctx context.Context, finder *IssueFinder) error {
 		return fmt.Errorf("failed to create monitor: %w", err)
 	}
 
-	fmt.Println("\n🔍 Starting Issue Monitor...")
-	fmt.Printf("   Check interval: %v\n", monitorConfig.CheckInterval)
-	fmt.Printf("   Repositories: %d\n", len(monitorConfig.Repos))
-	fmt.Printf("   Min score: %.2f\n", monitorConfig.MinScore)
-	fmt.Printf("   Notifications: Local=%v, Email=%v\n", monitorConfig.NotifyLocal, monitorConfig.NotifyEmail)
-	fmt.Println("\nPress Ctrl+C to stop...")
+	fmt.Fprintln(stdout, "\n🔍 Starting Issue Monitor...")
+	fmt.Fprintf(stdout, "   Check interval: %v\n", monitorConfig.CheckInterval)
+	fmt.Fprintf(stdout, "   Repositories: %d\n", len(monitorConfig.Repos))
+	fmt.Fprintf(stdout, "   Min score: %.2f\n", monitorConfig.MinScore)
+	fmt.Fprintf(stdout, "   Notifications: Local=%v, Email=%v\n", monitorConfig.NotifyLocal, monitorConfig.NotifyEmail)
+	fmt.Fprintln(stdout, "\nPress Ctrl+C to stop...")
 
 	return monitor.Start(ctx)
 }
 
 func runStopMonitorCommand(finder *IssueFinder) error {
-	fmt.Println("Monitor stop signal sent (no persistent monitor running)")
+	fmt.Fprintln(stdout, "Monitor stop signal sent (no persistent monitor running)")
 	return nil
 }
 
 func runMonitorStatusCommand(finder *IssueFinder) error {
-	fmt.Println("\n📊 MONITOR STATUS")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Println("   Running: No active daemon")
-	fmt.Println("   Note: Use 'monitor start' to begin monitoring")
-	fmt.Println("\n📋 Default Configuration:")
+	fmt.Fprintln(stdout, "\n📊 MONITOR STATUS")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "   Running: No active daemon")
+	fmt.Fprintln(stdout, "   Note: Use 'monitor start' to begin monitoring")
+	fmt.Fprintln(stdout, "\n📋 Default Configuration:")
 	config := DefaultMonitorConfig()
-	fmt.Printf("   Check Interval: %v\n", config.CheckInterval)
-	fmt.Printf("   Min Score: %.2f\n", config.MinScore)
-	fmt.Printf("   Max Issues Per Check: %d\n", config.MaxIssuesPerCheck)
-	fmt.Printf("   Notifications: Local=%v, Email=%v\n", config.NotifyLocal, config.NotifyEmail)
-	fmt.Printf("   Repositories: %d\n", len(config.Repos))
+	fmt.Fprintf(stdout, "   Check Interval: %v\n", config.CheckInterval)
+	fmt.Fprintf(stdout, "   Min Score: %.2f\n", config.MinScore)
+	fmt.Fprintf(stdout, "   Max Issues Per Check: %d\n", config.MaxIssuesPerCheck)
+	fmt.Fprintf(stdout, "   Notifications: Local=%v, Email=%v\n", config.NotifyLocal, config.NotifyEmail)
+	fmt.Fprintf(stdout, "   Repositories: %d\n", len(config.Repos))
 
-	fmt.Println("\n📁 Monitored Repositories (by category):")
+	fmt.Fprintln(stdout, "\n📁 Monitored Repositories (by category):")
 	categories := make(map[string][]RepoConfig)
 	for _, repo := range config.Repos {
 		categories[repo.Category] = append(categories[repo.Category], repo)
 	}
 	for cat, repos := range categories {
-		fmt.Printf("\n   %s (%d repos):\n", strings.Title(cat), len(repos))
+		fmt.Fprintf(stdout, "\n   %s (%d repos):\n", strings.Title(cat), len(repos))
 		for _, r := range repos {
-			fmt.Printf("      - %s/%s (priority: %d)\n", r.Owner, r.Name, r.Priority)
+			fmt.Fprintf(stdout, "      - %s/%s (priority: %d)\n", r.Owner, r.Name, r.Priority)
 		}
 	}
 
@@ -1150,8 +1439,8 @@ func runMonitorCheckCommand(ctx context.Context, finder *IssueFinder) error {
 		return fmt.Errorf("finder not initialized")
 	}
 
-	fmt.Println("\n🔍 Running One-Time Monitor Check...")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n🔍 Running One-Time Monitor Check...")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 
 	monitorConfig := DefaultMonitorConfig()
 	monitor, err := NewIssueMonitor(monitorConfig, finder.client, finder.notifier, finder.fileStore)
@@ -1165,9 +1454,9 @@ func runMonitorCheckCommand(ctx context.Context, finder *IssueFinder) error {
 	}
 
 	if len(issues) == 0 {
-		fmt.Println("\n✅ Check complete. No new qualifying issues found.")
+		fmt.Fprintln(stdout, "\n✅ Check complete. No new qualifying issues found.")
 	} else {
-		fmt.Printf("\n✅ Check complete. Found %d new qualifying issues.\n", len(issues))
+		fmt.Fprintf(stdout, "\n✅ Check complete. Found %d new qualifying issues.\n", len(issues))
 	}
 
 	return nil
@@ -1178,8 +1467,8 @@ func runMonitorNotifyCommand(finder *IssueFinder) error {
 		return fmt.Errorf("finder not initialized")
 	}
 
-	fmt.Println("\n🔔 Testing Monitor Notifications...")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n🔔 Testing Monitor Notifications...")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 
 	monitorConfig := DefaultMonitorConfig()
 	monitor, err := NewIssueMonitor(monitorConfig, finder.client, finder.notifier, finder.fileStore)
@@ -1191,34 +1480,34 @@ func runMonitorNotifyCommand(finder *IssueFinder) error {
 		return fmt.Errorf("notification test failed: %w", err)
 	}
 
-	fmt.Println("\n✅ Test notification sent successfully!")
+	fmt.Fprintln(stdout, "\n✅ Test notification sent successfully!")
 	return nil
 }
 
 func PrintMonitorUsage() {
-	fmt.Println("\n📊 MONITOR COMMANDS")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Println("\nUsage: github-issue-finder monitor <subcommand>")
-	fmt.Println("\nSubcommands:")
-	fmt.Println("  start     Start the monitoring daemon (runs continuously)")
-	fmt.Println("  stop      Stop the monitoring daemon")
-	fmt.Println("  status    Show monitor status and configuration")
-	fmt.Println("  check     Run a single check now (one-time)")
-	fmt.Println("  notify    Test notification system")
-	fmt.Println("\nExamples:")
-	fmt.Println("  ./github-issue-finder monitor start")
-	fmt.Println("  ./github-issue-finder monitor check")
-	fmt.Println("  ./github-issue-finder monitor status")
-	fmt.Println("  ./github-issue-finder monitor notify")
+	fmt.Fprintln(stdout, "\n📊 MONITOR COMMANDS")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\nUsage: github-issue-finder monitor <subcommand>")
+	fmt.Fprintln(stdout, "\nSubcommands:")
+	fmt.Fprintln(stdout, "  start     Start the monitoring daemon (runs continuously)")
+	fmt.Fprintln(stdout, "  stop      Stop the monitoring daemon")
+	fmt.Fprintln(stdout, "  status    Show monitor status and configuration")
+	fmt.Fprintln(stdout, "  check     Run a single check now (one-time)")
+	fmt.Fprintln(stdout, "  notify    Test notification system")
+	fmt.Fprintln(stdout, "\nExamples:")
+	fmt.Fprintln(stdout, "  ./github-issue-finder monitor start")
+	fmt.Fprintln(stdout, "  ./github-issue-finder monitor check")
+	fmt.Fprintln(stdout, "  ./github-issue-finder monitor status")
+	fmt.Fprintln(stdout, "  ./github-issue-finder monitor notify")
 }
 
 func runMCPCommand(args []string) error {
-	fmt.Println("\n🔌 Starting MCP Server (stdio mode)...")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Println("This mode is for use with Claude Desktop and other MCP clients.")
-	fmt.Println("The server communicates via stdio (standard input/output).")
-	fmt.Println("\nPress Ctrl+C to stop.")
-	fmt.Println(strings.Repeat("-", 60))
+	fmt.Fprintln(stdout, "\n🔌 Starting MCP Server (stdio mode)...")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "This mode is for use with Claude Desktop and other MCP clients.")
+	fmt.Fprintln(stdout, "The server communicates via stdio (standard input/output).")
+	fmt.Fprintln(stdout, "\nPress Ctrl+C to stop.")
+	fmt.Fprintln(stdout, strings.Repeat("-", 60))
 
 	if err := RunMCPStdioServer(); err != nil {
 		return fmt.Errorf("MCP server error: %w", err)
@@ -1228,28 +1517,77 @@ func runMCPCommand(args []string) error {
 
 func runMCPHTTPCommand(args []string) error {
 	port := 8080
+	host := "127.0.0.1"
+	if h := os.Getenv("MCP_HTTP_HOST"); h != "" {
+		host = h
+	}
 	for i := 0; i < len(args); i++ {
 		if args[i] == "--port" && i+1 < len(args) {
 			if p, err := strconv.Atoi(args[i+1]); err == nil && p > 0 {
 				port = p
 			}
 			i++
+		} else if args[i] == "--host" && i+1 < len(args) {
+			host = args[i+1]
+			i++
+		}
+	}
+
+	fmt.Fprintf(stdout, "\n🔌 Starting MCP HTTP Server on %s port %d...\n", host, port)
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "This mode is for web integrations and HTTP-based MCP clients.")
+	fmt.Fprintf(stdout, "Server will be available at: http://%s/mcp\n", net.JoinHostPort(host, strconv.Itoa(port)))
+	fmt.Fprintln(stdout, "\nPress Ctrl+C to stop.")
+	fmt.Fprintln(stdout, strings.Repeat("-", 60))
+
+	return RunMCPHTTPServer(host, port)
+}
+
+func runOpenLinkCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: open-link <github-issue-finder://action?url=...>")
+	}
+
+	action, issueURL, err := ParseDeepLink(args[0])
+	if err != nil {
+		return err
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	result, err := server.newDeepLinkActionHandler().Execute(context.Background(), action, issueURL)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, result)
+	return nil
+}
+
+func runExtensionAPICommand(args []string) error {
+	config := loadExtensionAPIConfigFromEnv()
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--port" && i+1 < len(args) {
+			if p, err := strconv.Atoi(args[i+1]); err == nil && p > 0 {
+				config.Port = p
+			}
+			i++
 		}
 	}
 
-	fmt.Printf("\n🔌 Starting MCP HTTP Server on port %d...\n", port)
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Println("This mode is for web integrations and HTTP-based MCP clients.")
-	fmt.Printf("Server will be available at: http://localhost:%d/mcp\n", port)
-	fmt.Println("\nPress Ctrl+C to stop.")
-	fmt.Println(strings.Repeat("-", 60))
+	fmt.Fprintf(stdout, "\n🧩 Starting browser extension API on http://%s:%d...\n", config.Host, config.Port)
+	fmt.Fprintf(stdout, "Allowed origins: %s\n", strings.Join(config.AllowedOrigins, ", "))
 
-	return RunMCPHTTPServer(port)
+	return RunExtensionAPIServer(config)
 }
 
 func runMCPListToolsCommand(args []string) error {
-	fmt.Println("\n📋 Available MCP Tools")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n📋 Available MCP Tools")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 
 	tools := []struct {
 		name        string
@@ -1270,55 +1608,55 @@ func runMCPListToolsCommand(args []string) error {
 	}
 
 	for i, tool := range tools {
-		fmt.Printf("\n%d. %s\n", i+1, tool.name)
-		fmt.Printf("   %s\n", tool.description)
+		fmt.Fprintf(stdout, "\n%d. %s\n", i+1, tool.name)
+		fmt.Fprintf(stdout, "   %s\n", tool.description)
 	}
 
-	fmt.Printf("\n\nTotal: %d tools available\n", len(tools))
-	fmt.Println("\n💡 Usage: Use these tools through an MCP client like Claude Desktop")
+	fmt.Fprintf(stdout, "\n\nTotal: %d tools available\n", len(tools))
+	fmt.Fprintln(stdout, "\n💡 Usage: Use these tools through an MCP client like Claude Desktop")
 	return nil
 }
 
 func runMCPTestCommand(args []string) error {
-	fmt.Println("\n🧪 Testing MCP Server Functionality...")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n🧪 Testing MCP Server Functionality...")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 
-	fmt.Println("\n1. Checking MCP server initialization...")
+	fmt.Fprintln(stdout, "\n1. Checking MCP server initialization...")
 	server, err := NewMCPServer()
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
 	}
-	fmt.Println("   ✅ MCP server initialized successfully")
+	fmt.Fprintln(stdout, "   ✅ MCP server initialized successfully")
 
-	fmt.Println("\n2. Checking tool registration...")
+	fmt.Fprintln(stdout, "\n2. Checking tool registration...")
 	srv := server.CreateServer()
 	if srv == nil {
 		return fmt.Errorf("failed to create MCP server instance")
 	}
-	fmt.Println("   ✅ MCP tools registered successfully")
+	fmt.Fprintln(stdout, "   ✅ MCP tools registered successfully")
 
-	fmt.Println("\n3. Testing GitHub API connection...")
+	fmt.Fprintln(stdout, "\n3. Testing GitHub API connection...")
 	ctx := context.Background()
-	_, _, err = server.client.Users.Get(ctx, "")
+	_, _, err = server.client.GetUser(ctx, "")
 	if err != nil {
-		fmt.Printf("   ⚠️  GitHub API connection issue: %v\n", err)
+		fmt.Fprintf(stdout, "   ⚠️  GitHub API connection issue: %v\n", err)
 	} else {
-		fmt.Println("   ✅ GitHub API connection successful")
+		fmt.Fprintln(stdout, "   ✅ GitHub API connection successful")
 	}
 
-	fmt.Println("\n4. Testing database connection...")
+	fmt.Fprintln(stdout, "\n4. Testing database connection...")
 	var testResult int
 	err = server.db.Get(&testResult, "SELECT 1")
 	if err != nil {
-		fmt.Printf("   ⚠️  Database connection issue: %v\n", err)
+		fmt.Fprintf(stdout, "   ⚠️  Database connection issue: %v\n", err)
 	} else {
-		fmt.Println("   ✅ Database connection successful")
+		fmt.Fprintln(stdout, "   ✅ Database connection successful")
 	}
 
-	fmt.Println("\n✅ MCP server test complete!")
-	fmt.Println("\nTo start the server:")
-	fmt.Println("  ./github-issue-finder mcp          # For stdio mode (Claude Desktop)")
-	fmt.Println("  ./github-issue-finder mcp-http     # For HTTP mode (web integrations)")
+	fmt.Fprintln(stdout, "\n✅ MCP server test complete!")
+	fmt.Fprintln(stdout, "\nTo start the server:")
+	fmt.Fprintln(stdout, "  ./github-issue-finder mcp          # For stdio mode (Claude Desktop)")
+	fmt.Fprintln(stdout, "  ./github-issue-finder mcp-http     # For HTTP mode (web integrations)")
 
 	return nil
 }
@@ -12,7 +12,7 @@ import (
 )
 
 type CommentManager struct {
-	client *github.Client
+	client GitHubAPI
 	db     *sqlx.DB
 	mu     sync.RWMutex
 
@@ -56,7 +56,7 @@ func DefaultAntiSpamConfig() *AntiSpamConfig {
 	}
 }
 
-func NewCommentManager(client *github.Client, db *sqlx.DB, config *AntiSpamConfig) *CommentManager {
+func NewCommentManager(client GitHubAPI, db *sqlx.DB, config *AntiSpamConfig) *CommentManager {
 	if config == nil {
 		config = DefaultAntiSpamConfig()
 	}
@@ -92,7 +92,10 @@ func (cm *CommentManager) initTables() error {
 	CREATE INDEX IF NOT EXISTS idx_comment_history_project ON comment_history(project_name);
 	CREATE INDEX IF NOT EXISTS idx_comment_history_time ON comment_history(commented_at);
 	`
-	_, err := cm.db.Exec(schema)
+	if _, err := cm.db.Exec(schema); err != nil {
+		return err
+	}
+	_, err := cm.db.Exec(commentedIssuesSchema)
 	return err
 }
 
@@ -124,7 +127,8 @@ func (cm *CommentManager) CanComment(ctx context.Context, issueURL, projectName
 	}
 
 	var alreadyCommented bool
-	err = cm.db.Get(&alreadyCommented, "SELECT EXISTS(SELECT 1 FROM comment_history WHERE issue_url = $1)", issueURL)
+	err = cm.db.Get(&alreadyCommented, `SELECT EXISTS(SELECT 1 FROM comment_history WHERE issue_url = $1)
+		OR EXISTS(SELECT 1 FROM commented_issues WHERE issue_url = $1)`, issueURL)
 	if err == nil && alreadyCommented {
 		return false, "Already commented on this issue"
 	}
@@ -148,7 +152,7 @@ func (cm *CommentManager) PostComment(ctx context.Context, owner, repo string, i
 		return fmt.Errorf("cannot comment: %s", reason)
 	}
 
-	comment, _, err := cm.client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{
+	comment, _, err := cm.client.CreateIssueComment(ctx, owner, repo, issueNumber, &github.IssueComment{
 		Body: github.String(body),
 	})
 	if err != nil {
@@ -235,7 +239,8 @@ func (cm *CommentManager) GetStats() (map[string]interface{}, error) {
 
 func (cm *CommentManager) IsAlreadyCommented(issueURL string) bool {
 	var exists bool
-	err := cm.db.Get(&exists, "SELECT EXISTS(SELECT 1 FROM comment_history WHERE issue_url = $1 AND success = true)", issueURL)
+	err := cm.db.Get(&exists, `SELECT EXISTS(SELECT 1 FROM comment_history WHERE issue_url = $1 AND success = true)
+		OR EXISTS(SELECT 1 FROM commented_issues WHERE issue_url = $1)`, issueURL)
 	return err == nil && exists
 }
 
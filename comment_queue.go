@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// DeferredComment is a comment held back until the repository's maintainers
+// are usually active. Queued comments live in deferred_comments and are
+// posted by PostDueComments once PostAt has passed.
+type DeferredComment struct {
+	IssueURL    string    `db:"issue_url"`
+	Owner       string    `db:"owner"`
+	Repo        string    `db:"repo"`
+	IssueNumber int       `db:"issue_number"`
+	Score       float64   `db:"score"`
+	Body        string    `db:"body"`
+	PostAt      time.Time `db:"post_at"`
+}
+
+const deferredCommentsSchema = `
+	CREATE TABLE IF NOT EXISTS deferred_comments (
+		issue_url VARCHAR(500) PRIMARY KEY,
+		owner VARCHAR(255) NOT NULL,
+		repo VARCHAR(255) NOT NULL,
+		issue_number INT NOT NULL,
+		score FLOAT NOT NULL DEFAULT 0,
+		body TEXT NOT NULL,
+		post_at TIMESTAMP NOT NULL,
+		queued_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_deferred_comments_post_at ON deferred_comments(post_at);
+	`
+
+// deferUntil returns when a comment on the repository should be posted, and
+// false when it can be posted now. Comments are only held back with a
+// database, since the queue needs somewhere to live between runs.
+func (af *AutoFinder) deferUntil(ctx context.Context, owner, repo string) (time.Time, ActivityWindow, bool) {
+	if !af.useDB {
+		return time.Now(), ActivityWindow{}, false
+	}
+	postAt, window, ok := af.timing.NextCommentTime(ctx, owner, repo, time.Now())
+	if !ok || !postAt.After(time.Now()) {
+		return postAt, window, false
+	}
+	return postAt, window, true
+}
+
+// deferComment queues a comment, replacing one already queued for the issue.
+func (af *AutoFinder) deferComment(comment DeferredComment) error {
+	_, err := af.db.Exec(`
+		INSERT INTO deferred_comments (issue_url, owner, repo, issue_number, score, body, post_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (issue_url) DO UPDATE SET score = $5, body = $6, post_at = $7
+	`, comment.IssueURL, comment.Owner, comment.Repo, comment.IssueNumber, comment.Score, comment.Body, comment.PostAt)
+	return err
+}
+
+func (af *AutoFinder) dropDeferredComment(issueURL string) {
+	if _, err := af.db.Exec(`DELETE FROM deferred_comments WHERE issue_url = $1`, issueURL); err != nil {
+		log.Printf("[AutoFinder] Failed to remove queued comment for %s: %v", issueURL, err)
+	}
+}
+
+// PostDueComments posts queued comments whose time has come and returns how
+// many were posted. A comment stays queued while the comment limits are
+// reached or the window has closed again, and is dropped once its issue is
+// closed, assigned or has a linked PR.
+func (af *AutoFinder) PostDueComments(ctx context.Context) int {
+	if !af.useDB {
+		return 0
+	}
+
+	var due []DeferredComment
+	err := af.db.Select(&due, `
+		SELECT issue_url, owner, repo, issue_number, score, body, post_at
+		FROM deferred_comments
+		WHERE post_at <= $1
+		ORDER BY post_at
+	`, time.Now())
+	if err != nil {
+		log.Printf("[AutoFinder] Failed to load queued comments: %v", err)
+		return 0
+	}
+
+	posted := 0
+	for _, comment := range due {
+		if ctx.Err() != nil || !af.canCommentToday() {
+			break
+		}
+		if !af.canCommentOnRepo(comment.Repo) {
+			continue
+		}
+		if ok, reason := af.smartLimiter.CanComment(comment.Repo, comment.Score); !ok {
+			log.Printf("[AutoFinder] Keeping queued comment on %s: %s", comment.IssueURL, reason)
+			continue
+		}
+
+		issue, _, err := af.githubClient.GetIssue(ctx, comment.Owner, comment.Repo, comment.IssueNumber)
+		if err != nil {
+			log.Printf("[AutoFinder] Failed to refresh %s: %v", comment.IssueURL, err)
+			continue
+		}
+		if issue.GetState() != "open" || len(issue.Assignees) > 0 || issue.PullRequestLinks != nil {
+			log.Printf("[AutoFinder] Dropping queued comment on %s - no longer open and unclaimed", comment.IssueURL)
+			af.dropDeferredComment(comment.IssueURL)
+			continue
+		}
+
+		// A daemon that was down past the window waits for the next one.
+		if postAt, _, deferred := af.deferUntil(ctx, comment.Owner, comment.Repo); deferred {
+			comment.PostAt = postAt
+			if err := af.deferComment(comment); err != nil {
+				log.Printf("[AutoFinder] Failed to reschedule comment on %s: %v", comment.IssueURL, err)
+			}
+			continue
+		}
+
+		_, _, err = af.githubClient.CreateIssueComment(ctx, comment.Owner, comment.Repo, comment.IssueNumber, &github.IssueComment{
+			Body: github.String(comment.Body),
+		})
+		if err != nil {
+			log.Printf("[AutoFinder] Failed to post queued comment on %s: %v", comment.IssueURL, err)
+			continue
+		}
+
+		scored := ScoredIssue{
+			Issue:     issue,
+			Project:   Project{Org: comment.Owner, Name: comment.Repo},
+			Score:     IssueScore{Total: comment.Score},
+			IssueData: Issue{URL: comment.IssueURL, Number: comment.IssueNumber},
+		}
+		if err := af.recordComment(scored, comment.Body); err != nil {
+			log.Printf("[AutoFinder] Failed to record comment: %v", err)
+		}
+		if err := af.smartLimiter.RecordComment(comment.Repo); err != nil {
+			log.Printf("[AutoFinder] Failed to record comment: %v", err)
+		}
+		af.dropDeferredComment(comment.IssueURL)
+
+		log.Printf("[AutoFinder] Posted queued comment on %s/%s#%d", comment.Owner, comment.Repo, comment.IssueNumber)
+		posted++
+	}
+
+	return posted
+}
@@ -99,6 +99,10 @@ type ScoringConfig struct {
 	ContributorFriendlyBonus float64
 	WeekendBonus             float64
 	MaxScore                 float64
+	LongThreadMinutes        int
+	LongThreadPenalty        float64
+	KeywordCaps              map[string]float64
+	KeywordDecay             float64
 }
 
 type DisplayConfig struct {
@@ -543,6 +547,8 @@ func loadScoringConfigFromEnv() *ScoringConfig {
 		ContributorFriendlyBonus: 0.15,
 		WeekendBonus:             0.05,
 		MaxScore:                 1.5,
+		LongThreadMinutes:        10,
+		LongThreadPenalty:        0,
 	}
 
 	if weight := os.Getenv("SCORING_STAR_WEIGHT"); weight != "" {
@@ -599,6 +605,20 @@ func loadScoringConfigFromEnv() *ScoringConfig {
 		}
 	}
 
+	if minutes := os.Getenv("SCORING_LONG_THREAD_MINUTES"); minutes != "" {
+		if val, err := strconv.Atoi(minutes); err == nil && val > 0 {
+			config.LongThreadMinutes = val
+		}
+	}
+
+	if penalty := os.Getenv("SCORING_LONG_THREAD_PENALTY"); penalty != "" {
+		if val, err := strconv.ParseFloat(penalty, 64); err == nil && val >= 0 {
+			config.LongThreadPenalty = val
+		}
+	}
+
+	loadKeywordCapsFromEnv(config)
+
 	return config
 }
 
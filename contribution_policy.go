@@ -12,7 +12,7 @@ import (
 )
 
 type ContributionPolicy struct {
-	client           *github.Client
+	client           GitHubAPI
 	db               *sqlx.DB
 	username         string
 	trustedRepos     map[string]*RepoStats
@@ -116,7 +116,7 @@ var defaultPolicies = map[TrustLevel]CommentPolicy{
 	},
 }
 
-func NewContributionPolicy(client *github.Client, db *sqlx.DB, username string) *ContributionPolicy {
+func NewContributionPolicy(client GitHubAPI, db *sqlx.DB, username string) *ContributionPolicy {
 	cp := &ContributionPolicy{
 		client:           client,
 		db:               db,
@@ -181,7 +181,7 @@ func (cp *ContributionPolicy) FetchUserContributions(ctx context.Context) error
 	}
 
 	for _, query := range queries {
-		result, _, err := cp.client.Search.Issues(ctx, query, &github.SearchOptions{
+		result, _, err := cp.client.SearchIssues(ctx, query, &github.SearchOptions{
 			ListOptions: github.ListOptions{PerPage: 100},
 		})
 		if err != nil {
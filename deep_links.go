@@ -0,0 +1,386 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+type DeepLinkAction string
+
+const (
+	DeepLinkTrack   DeepLinkAction = "track"
+	DeepLinkSnooze  DeepLinkAction = "snooze"
+	DeepLinkPreview DeepLinkAction = "preview"
+)
+
+const deepLinkScheme = "github-issue-finder"
+
+type DeepLinkConfig struct {
+	Enabled       bool
+	Mode          string
+	BaseURL       string
+	SnoozeDefault time.Duration
+	Secret        []byte
+}
+
+type DeepLinks struct {
+	Track   string
+	Snooze  string
+	Preview string
+}
+
+var (
+	activeDeepLinkConfig *DeepLinkConfig
+	deepLinkOnce         sync.Once
+	deepLinkKeyOnce      sync.Once
+	deepLinkKey          []byte
+)
+
+func loadDeepLinkConfigFromEnv() *DeepLinkConfig {
+	config := &DeepLinkConfig{
+		Enabled:       false,
+		Mode:          "protocol",
+		BaseURL:       "http://localhost:8080",
+		SnoozeDefault: 7 * 24 * time.Hour,
+	}
+
+	if enabled := os.Getenv("DEEP_LINKS_ENABLED"); enabled == "true" {
+		config.Enabled = true
+	}
+
+	if mode := os.Getenv("DEEP_LINK_MODE"); mode != "" {
+		validModes := map[string]bool{"protocol": true, "web": true}
+		if validModes[strings.ToLower(mode)] {
+			config.Mode = strings.ToLower(mode)
+		}
+	}
+
+	if baseURL := os.Getenv("DEEP_LINK_BASE_URL"); baseURL != "" {
+		config.BaseURL = strings.TrimRight(baseURL, "/")
+	}
+
+	if days := os.Getenv("DEEP_LINK_SNOOZE_DAYS"); days != "" {
+		if val, err := strconv.Atoi(days); err == nil && val > 0 {
+			config.SnoozeDefault = time.Duration(val) * 24 * time.Hour
+		}
+	}
+
+	if secret := os.Getenv("DEEP_LINK_SECRET"); secret != "" {
+		config.Secret = []byte(secret)
+	}
+
+	return config
+}
+
+func deepLinkKeyPath() string {
+	if path := os.Getenv("DEEP_LINK_KEY_FILE"); path != "" {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "deep_link.key"
+	}
+	return filepath.Join(homeDir, ".github-issue-finder", "deep_link.key")
+}
+
+// loadDeepLinkKey reads the signing key file, creating it on first use so
+// links in older alerts stay valid across restarts.
+func loadDeepLinkKey() []byte {
+	path := deepLinkKeyPath()
+	if data, err := os.ReadFile(path); err == nil && len(data) >= 32 {
+		return data
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Printf("Warning: failed to generate deep link key: %v", err)
+		return nil
+	}
+	encoded := []byte(hex.EncodeToString(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+		if err := os.WriteFile(path, encoded, 0600); err != nil {
+			log.Printf("Warning: failed to save deep link key, links expire on restart: %v", err)
+		}
+	}
+	return encoded
+}
+
+func (c *DeepLinkConfig) signingKey() []byte {
+	if len(c.Secret) > 0 {
+		return c.Secret
+	}
+	deepLinkKeyOnce.Do(func() {
+		deepLinkKey = loadDeepLinkKey()
+	})
+	return deepLinkKey
+}
+
+// Sign returns the token that web links carry, so the HTTP server only acts
+// on links this tool generated.
+func (c *DeepLinkConfig) Sign(action DeepLinkAction, issueURL string) string {
+	mac := hmac.New(sha256.New, c.signingKey())
+	mac.Write([]byte(string(action) + "\n" + issueURL))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *DeepLinkConfig) Verify(action DeepLinkAction, issueURL, token string) bool {
+	if token == "" || len(c.signingKey()) == 0 {
+		return false
+	}
+	return hmac.Equal([]byte(c.Sign(action, issueURL)), []byte(token))
+}
+
+func getDeepLinkConfig() *DeepLinkConfig {
+	deepLinkOnce.Do(func() {
+		activeDeepLinkConfig = loadDeepLinkConfigFromEnv()
+	})
+	return activeDeepLinkConfig
+}
+
+func (c *DeepLinkConfig) BuildLink(action DeepLinkAction, issueURL string) string {
+	query := url.Values{}
+	query.Set("url", issueURL)
+
+	if c.Mode == "web" {
+		query.Set("sig", c.Sign(action, issueURL))
+		return fmt.Sprintf("%s/actions/%s?%s", c.BaseURL, action, query.Encode())
+	}
+	return fmt.Sprintf("%s://%s?%s", deepLinkScheme, action, query.Encode())
+}
+
+// LinksFor returns nil when deep links are disabled so callers can skip the
+// action row entirely.
+func (c *DeepLinkConfig) LinksFor(issueURL string) *DeepLinks {
+	if c == nil || !c.Enabled || issueURL == "" {
+		return nil
+	}
+	return &DeepLinks{
+		Track:   c.BuildLink(DeepLinkTrack, issueURL),
+		Snooze:  c.BuildLink(DeepLinkSnooze, issueURL),
+		Preview: c.BuildLink(DeepLinkPreview, issueURL),
+	}
+}
+
+func (c *DeepLinkConfig) IsWebMode() bool {
+	return c != nil && c.Mode == "web"
+}
+
+func ParseDeepLink(raw string) (DeepLinkAction, string, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid deep link: %w", err)
+	}
+
+	var action string
+	switch parsed.Scheme {
+	case deepLinkScheme:
+		action = parsed.Host
+		if action == "" {
+			action = strings.Trim(parsed.Opaque, "/")
+		}
+	case "http", "https":
+		action = strings.TrimPrefix(parsed.Path, "/actions/")
+		if action == parsed.Path {
+			return "", "", fmt.Errorf("not a deep link path: %s", parsed.Path)
+		}
+	default:
+		return "", "", fmt.Errorf("unsupported deep link scheme: %q", parsed.Scheme)
+	}
+
+	issueURL := parsed.Query().Get("url")
+	if issueURL == "" {
+		return "", "", fmt.Errorf("deep link is missing the url parameter")
+	}
+
+	switch DeepLinkAction(action) {
+	case DeepLinkTrack, DeepLinkSnooze, DeepLinkPreview:
+		return DeepLinkAction(action), issueURL, nil
+	default:
+		return "", "", fmt.Errorf("unknown deep link action: %q", action)
+	}
+}
+
+func (l *DeepLinks) TextLine() string {
+	if l == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s\n%s: %s\n%s: %s",
+		T("deeplink.track"), l.Track, T("deeplink.snooze"), l.Snooze, T("deeplink.preview"), l.Preview)
+}
+
+func (l *DeepLinks) HTMLRow() string {
+	if l == nil {
+		return ""
+	}
+	linkStyle := "display:inline-block;border:1px solid #0366d6;color:#0366d6;padding:6px 14px;border-radius:6px;text-decoration:none;margin-right:8px;font-size:14px;"
+	return fmt.Sprintf(`<div style="margin-top:12px;"><a href="%s" style="%s">%s</a><a href="%s" style="%s">%s</a><a href="%s" style="%s">%s</a></div>`,
+		html.EscapeString(l.Track), linkStyle, html.EscapeString(T("deeplink.track")),
+		html.EscapeString(l.Snooze), linkStyle, html.EscapeString(T("deeplink.snooze")),
+		html.EscapeString(l.Preview), linkStyle, html.EscapeString(T("deeplink.preview")))
+}
+
+func (l *DeepLinks) MarkdownRow() string {
+	if l == nil {
+		return ""
+	}
+	return fmt.Sprintf("[%s](%s) · [%s](%s) · [%s](%s)",
+		T("deeplink.track"), l.Track, T("deeplink.snooze"), l.Snooze, T("deeplink.preview"), l.Preview)
+}
+
+type DeepLinkActionHandler struct {
+	client   GitHubAPI
+	tracker  *IssueTracker
+	antiSpam *NotificationSpamManager
+	config   *DeepLinkConfig
+	// score is the pipeline scorer; without one the main scorer is used
+	// with no sign-off adjustment.
+	score func(context.Context, Project, *github.Issue) (ScoreResult, SignOffRequirement)
+}
+
+func NewDeepLinkActionHandler(client GitHubAPI, tracker *IssueTracker, antiSpam *NotificationSpamManager) *DeepLinkActionHandler {
+	return &DeepLinkActionHandler{
+		client:   client,
+		tracker:  tracker,
+		antiSpam: antiSpam,
+		config:   getDeepLinkConfig(),
+	}
+}
+
+func (s *MCPServer) newDeepLinkActionHandler() *DeepLinkActionHandler {
+	var antiSpam *NotificationSpamManager
+	if s.db != nil && s.config != nil && s.config.AntiSpam != nil {
+		manager, err := NewNotificationSpamManager(*s.config.AntiSpam, s.db.DB)
+		if err != nil {
+			log.Printf("Warning: failed to create notification spam manager: %v", err)
+		} else {
+			antiSpam = manager
+		}
+	}
+
+	handler := NewDeepLinkActionHandler(s.client, s.tracker, antiSpam)
+	handler.score = s.pipelineScore
+	return handler
+}
+
+func (h *DeepLinkActionHandler) scoreIssue(ctx context.Context, p Project, issue *github.Issue) (ScoreResult, SignOffRequirement) {
+	if h.score != nil {
+		return h.score(ctx, p, issue)
+	}
+	return NewIssueScorer().ScoreIssueWithConfidence(issue, p), SignOffUnknown
+}
+
+func (h *DeepLinkActionHandler) Execute(ctx context.Context, action DeepLinkAction, issueURL string) (string, error) {
+	owner, repo, number, err := ParseIssueURL(issueURL)
+	if err != nil {
+		return "", err
+	}
+
+	switch action {
+	case DeepLinkTrack:
+		return h.track(ctx, owner, repo, number, issueURL)
+	case DeepLinkSnooze:
+		return h.snooze(issueURL)
+	case DeepLinkPreview:
+		return h.preview(ctx, owner, repo, number)
+	default:
+		return "", fmt.Errorf("unknown deep link action: %q", action)
+	}
+}
+
+func (h *DeepLinkActionHandler) track(ctx context.Context, owner, repo string, number int, issueURL string) (string, error) {
+	if h.tracker == nil {
+		return "", fmt.Errorf("issue tracker not initialized")
+	}
+
+	tracked := &TrackedIssue{
+		IssueURL:    issueURL,
+		ProjectOrg:  owner,
+		ProjectName: repo,
+		IssueNumber: number,
+		Status:      StatusInterested,
+	}
+
+	if h.client != nil {
+		issue, _, err := h.client.GetIssue(ctx, owner, repo, number)
+		if err == nil {
+			labels := getLabelNames(issue.Labels)
+			tracked.IssueTitle = issue.GetTitle()
+			tracked.Labels = strings.Join(labels, ",")
+			tracked.HasGoodFirst = hasGoodFirstIssueLabel(issue.Labels)
+			tracked.HasConfirmed = hasConfirmedLabel(issue.Labels)
+			tracked.HasAssignee = len(issue.Assignees) > 0
+			result, _ := h.scoreIssue(ctx, Project{Org: owner, Name: repo}, issue)
+			tracked.Score = result.Score
+		}
+	}
+
+	if err := h.tracker.AddIssue(tracked); err != nil {
+		return "", fmt.Errorf("failed to track issue: %w", err)
+	}
+
+	return fmt.Sprintf("Tracking %s/%s#%d (status: %s)", owner, repo, number, tracked.Status), nil
+}
+
+func (h *DeepLinkActionHandler) snooze(issueURL string) (string, error) {
+	if h.antiSpam == nil {
+		return "", fmt.Errorf("anti-spam manager not initialized")
+	}
+
+	until, err := h.antiSpam.SnoozeIssue(issueURL, h.config.SnoozeDefault)
+	if err != nil {
+		return "", fmt.Errorf("failed to snooze issue: %w", err)
+	}
+
+	return fmt.Sprintf("Snoozed %s until %s", issueURL, until.Format("2006-01-02 15:04")), nil
+}
+
+func (h *DeepLinkActionHandler) preview(ctx context.Context, owner, repo string, number int) (string, error) {
+	if h.client == nil {
+		return "", fmt.Errorf("github client not initialized")
+	}
+
+	issue, _, err := h.client.GetIssue(ctx, owner, repo, number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	project := Project{Org: owner, Name: repo}
+	if repoInfo, _, err := h.client.GetRepository(ctx, owner, repo); err == nil && repoInfo != nil {
+		project.Stars = repoInfo.GetStargazersCount()
+	}
+
+	result, signOff := h.scoreIssue(ctx, project, issue)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s\n", issue.GetTitle()))
+	sb.WriteString(fmt.Sprintf("%s/%s#%d | %s | %d comments | %s\n", owner, repo, number, issue.GetState(), issue.GetComments(), EstimateReadingTime(issue)))
+	sb.WriteString(fmt.Sprintf("Score: %.2f (confidence %.0f%%)\n", result.Score, result.Confidence*100))
+	sb.WriteString(fmt.Sprintf("  Stars: %.2f | Comments: %.2f | Recency: %.2f | Labels: %.2f | Difficulty: %.2f\n",
+		result.Factors["stars_factor"], result.Factors["comments_factor"], result.Factors["recency_factor"],
+		result.Factors["labels_factor"], result.Factors["difficulty_factor"]))
+	sb.WriteString(fmt.Sprintf("  Keywords: %.2f | Bonus: %.2f | Penalty: %.2f\n", result.Keywords, result.Bonus, result.Penalty))
+	if badge := signOff.Badge(); badge != "" {
+		sb.WriteString(fmt.Sprintf("Sign-off: %s (%+.2f)\n", badge, result.SignOff))
+	}
+	if labels := getLabelNames(issue.Labels); len(labels) > 0 {
+		sb.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(labels, ", ")))
+	}
+	sb.WriteString(issue.GetHTMLURL())
+
+	return sb.String(), nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeepLinkConfig_BuildLink(t *testing.T) {
+	issueURL := "https://github.com/golang/go/issues/77519"
+
+	tests := []struct {
+		name     string
+		config   DeepLinkConfig
+		action   DeepLinkAction
+		expected string
+	}{
+		{
+			name:     "protocol track",
+			config:   DeepLinkConfig{Enabled: true, Mode: "protocol"},
+			action:   DeepLinkTrack,
+			expected: "github-issue-finder://track?url=https%3A%2F%2Fgithub.com%2Fgolang%2Fgo%2Fissues%2F77519",
+		},
+		{
+			name:     "web snooze",
+			config:   DeepLinkConfig{Enabled: true, Mode: "web", BaseURL: "http://localhost:8080", Secret: []byte("test-secret")},
+			action:   DeepLinkSnooze,
+			expected: "http://localhost:8080/actions/snooze?sig=16ae7651d605d212a4bd59605e2fdd0afb8cd773140dbb4d954f168ca167bd58&url=https%3A%2F%2Fgithub.com%2Fgolang%2Fgo%2Fissues%2F77519",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.BuildLink(tt.action, issueURL)
+			if result != tt.expected {
+				t.Errorf("BuildLink() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDeepLink(t *testing.T) {
+	issueURL := "https://github.com/golang/go/issues/77519"
+	protocol := &DeepLinkConfig{Enabled: true, Mode: "protocol", SnoozeDefault: 24 * time.Hour}
+	web := &DeepLinkConfig{Enabled: true, Mode: "web", BaseURL: "https://finder.example.com", Secret: []byte("test-secret")}
+
+	tests := []struct {
+		name      string
+		link      string
+		action    DeepLinkAction
+		shouldErr bool
+	}{
+		{"protocol preview", protocol.BuildLink(DeepLinkPreview, issueURL), DeepLinkPreview, false},
+		{"web track", web.BuildLink(DeepLinkTrack, issueURL), DeepLinkTrack, false},
+		{"unknown action", "github-issue-finder://delete?url=" + issueURL, "", true},
+		{"missing url", "github-issue-finder://track", "", true},
+		{"unsupported scheme", "ftp://track?url=" + issueURL, "", true},
+		{"web path without actions", "https://finder.example.com/track?url=" + issueURL, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, parsedURL, err := ParseDeepLink(tt.link)
+			if tt.shouldErr {
+				if err == nil {
+					t.Errorf("ParseDeepLink(%v) should have returned error", tt.link)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDeepLink(%v) returned error: %v", tt.link, err)
+			}
+			if action != tt.action || parsedURL != issueURL {
+				t.Errorf("ParseDeepLink(%v) = (%v, %v), want (%v, %v)", tt.link, action, parsedURL, tt.action, issueURL)
+			}
+		})
+	}
+}
+
+func TestDeepLinkConfig_LinksForDisabled(t *testing.T) {
+	config := &DeepLinkConfig{Enabled: false, Mode: "protocol"}
+	links := config.LinksFor("https://github.com/golang/go/issues/1")
+	if links != nil {
+		t.Errorf("LinksFor() should return nil when disabled, got %+v", links)
+	}
+	if links.HTMLRow() != "" || links.TextLine() != "" || links.MarkdownRow() != "" {
+		t.Error("nil DeepLinks should render empty rows")
+	}
+
+	config.Enabled = true
+	links = config.LinksFor("https://github.com/golang/go/issues/1")
+	if links == nil || !strings.HasPrefix(links.Track, "github-issue-finder://track") {
+		t.Errorf("LinksFor() = %+v, want protocol track link", links)
+	}
+}
+
+func TestDeepLinkConfig_Verify(t *testing.T) {
+	issueURL := "https://github.com/golang/go/issues/77519"
+	config := &DeepLinkConfig{Mode: "web", Secret: []byte("test-secret")}
+	sig := config.Sign(DeepLinkTrack, issueURL)
+
+	if !config.Verify(DeepLinkTrack, issueURL, sig) {
+		t.Error("Verify() rejected its own signature")
+	}
+	if config.Verify(DeepLinkSnooze, issueURL, sig) {
+		t.Error("Verify() accepted a track signature for snooze")
+	}
+	if config.Verify(DeepLinkTrack, "https://github.com/golang/go/issues/1", sig) {
+		t.Error("Verify() accepted a signature for another issue")
+	}
+	if config.Verify(DeepLinkTrack, issueURL, "") {
+		t.Error("Verify() accepted an empty signature")
+	}
+	other := &DeepLinkConfig{Mode: "web", Secret: []byte("other-secret")}
+	if other.Verify(DeepLinkTrack, issueURL, sig) {
+		t.Error("Verify() accepted a signature made with another key")
+	}
+}
+
+func TestDeepLinks_HTMLRowEscapes(t *testing.T) {
+	links := &DeepLinks{
+		Track:   `http://localhost:8080/actions/track?url=x"><script>alert(1)</script>`,
+		Snooze:  "http://localhost:8080/actions/snooze?sig=a&url=b",
+		Preview: "http://localhost:8080/actions/preview?sig=a&url=b",
+	}
+	row := links.HTMLRow()
+	if strings.Contains(row, "<script>") || strings.Contains(row, `x">`) {
+		t.Errorf("HTMLRow() did not escape the href: %s", row)
+	}
+	if !strings.Contains(row, "sig=a&amp;url=b") {
+		t.Errorf("HTMLRow() = %s, want escaped ampersands", row)
+	}
+}
+
+func TestDeepLinkRoutes_RequireSignatureAndConfirm(t *testing.T) {
+	t.Setenv("DEEP_LINK_KEY_FILE", filepath.Join(t.TempDir(), "deep_link.key"))
+
+	mux := http.NewServeMux()
+	(&MCPServer{}).RegisterDeepLinkRoutes(mux)
+	config := getDeepLinkConfig()
+
+	issueURL := "https://github.com/golang/go/issues/77519"
+	sig := config.Sign(DeepLinkTrack, issueURL)
+	query := url.Values{"url": {issueURL}, "sig": {sig}}
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(httptest.NewRequest(http.MethodGet, "/actions/track?url="+url.QueryEscape(issueURL), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("unsigned GET status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	rec = serve(httptest.NewRequest(http.MethodGet, "/actions/track?"+query.Encode(), nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `method="POST"`) {
+		t.Errorf("signed GET = %d %q, want confirm form", rec.Code, rec.Body.String())
+	}
+
+	forged := url.Values{"url": {issueURL}, "sig": {config.Sign(DeepLinkSnooze, issueURL)}}
+	req := httptest.NewRequest(http.MethodPost, "/actions/track", strings.NewReader(forged.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if rec = serve(req); rec.Code != http.StatusForbidden {
+		t.Errorf("POST with snooze signature status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	// A valid POST reaches the handler, which has no tracker here.
+	req = httptest.NewRequest(http.MethodPost, "/actions/track", strings.NewReader(query.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if rec = serve(req); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "tracker") {
+		t.Errorf("signed POST = %d %q, want tracker error", rec.Code, rec.Body.String())
+	}
+}
@@ -2,16 +2,16 @@ package main
 
 import (
 	"fmt"
-	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
 func DisplayQualifiedIssues(issues []QualifiedIssue, emailCount int) {
-	fmt.Println()
-	fmt.Println(strings.Repeat("=", 80))
-	fmt.Println("🎯 QUALIFIED ISSUES - Resume-Worthy Opportunities")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, "🎯 QUALIFIED ISSUES - Resume-Worthy Opportunities")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	highImpact := []QualifiedIssue{}
 	mediumImpact := []QualifiedIssue{}
@@ -28,21 +28,21 @@ func DisplayQualifiedIssues(issues []QualifiedIssue, emailCount int) {
 	}
 
 	if len(highImpact) > 0 {
-		fmt.Println()
-		fmt.Println("🔥 HIGH IMPACT (Score >= 0.8)")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "🔥 HIGH IMPACT (Score >= 0.8)")
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range highImpact {
 			displayQualifiedIssueCard(issue, i+1)
 		}
 	}
 
 	if len(mediumImpact) > 0 {
-		fmt.Println()
-		fmt.Println("⭐ MEDIUM IMPACT (Score 0.6 - 0.79)")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "⭐ MEDIUM IMPACT (Score 0.6 - 0.79)")
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range mediumImpact {
 			if i >= 10 {
-				fmt.Printf("\n   ... and %d more medium impact issues\n", len(mediumImpact)-10)
+				fmt.Fprintf(stdout, "\n   ... and %d more medium impact issues\n", len(mediumImpact)-10)
 				break
 			}
 			displayQualifiedIssueCard(issue, i+1)
@@ -50,31 +50,31 @@ func DisplayQualifiedIssues(issues []QualifiedIssue, emailCount int) {
 	}
 
 	if len(lowImpact) > 0 {
-		fmt.Println()
-		fmt.Println("📋 LOWER PRIORITY (Score < 0.6)")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "📋 LOWER PRIORITY (Score < 0.6)")
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range lowImpact {
 			if i >= 5 {
-				fmt.Printf("\n   ... and %d more lower priority issues\n", len(lowImpact)-5)
+				fmt.Fprintf(stdout, "\n   ... and %d more lower priority issues\n", len(lowImpact)-5)
 				break
 			}
 			displayCompactQualifiedIssue(issue, i+1)
 		}
 	}
 
-	fmt.Println()
-	fmt.Println("📊 SUMMARY")
-	fmt.Printf("   High Impact: %d | Medium: %d | Low: %d\n", len(highImpact), len(mediumImpact), len(lowImpact))
-	fmt.Printf("   Total Qualified: %d\n", len(issues))
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "📊 SUMMARY")
+	fmt.Fprintf(stdout, "   High Impact: %d | Medium: %d | Low: %d\n", len(highImpact), len(mediumImpact), len(lowImpact))
+	fmt.Fprintf(stdout, "   Total Qualified: %d\n", len(issues))
 
 	if emailCount > 0 {
-		fmt.Println()
-		fmt.Println("🔔 NOTIFICATIONS SENT")
-		fmt.Printf("   Email: %d (score > 0.7)\n", emailCount)
-		fmt.Printf("   Local: %d (all qualified)\n", len(issues))
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "🔔 NOTIFICATIONS SENT")
+		fmt.Fprintf(stdout, "   Email: %d (score > 0.7)\n", emailCount)
+		fmt.Fprintf(stdout, "   Local: %d (all qualified)\n", len(issues))
 	}
 
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 }
 
 func displayQualifiedIssueCard(issue QualifiedIssue, num int) {
@@ -83,42 +83,42 @@ func displayQualifiedIssueCard(issue QualifiedIssue, num int) {
 		emoji = "⭐"
 	}
 
-	fmt.Printf("\n%s [%d] %s (Score: %.2f)\n", emoji, num, issue.Title, issue.QualifiedScore.TotalScore)
-	fmt.Printf("   📦 %s/%s (%s ⭐) | %s", issue.Project.Org, issue.Project.Name, formatStars(issue.Project.Stars), issue.Project.Category)
+	fmt.Fprintf(stdout, "\n%s [%d] %s (Score: %.2f)\n", emoji, num, issue.Title, issue.QualifiedScore.TotalScore)
+	fmt.Fprintf(stdout, "   📦 %s/%s (%s ⭐) | %s", issue.Project.Org, issue.Project.Name, formatStars(issue.Project.Stars), issue.Project.Category)
 	if issue.Priority != "" {
-		fmt.Printf(" | Priority: %s", issue.Priority)
+		fmt.Fprintf(stdout, " | Priority: %s", issue.Priority)
 	}
-	fmt.Println()
+	fmt.Fprintln(stdout)
 
-	fmt.Printf("   📝 Type: %s", strings.Title(string(issue.Type)))
+	fmt.Fprintf(stdout, "   📝 Type: %s", strings.Title(string(issue.Type)))
 	if len(issue.Labels) > 0 {
 		relevantLabels := filterRelevantLabels(issue.Labels)
 		if len(relevantLabels) > 0 {
-			fmt.Printf(" | Labels: %s", strings.Join(relevantLabels, ", "))
+			fmt.Fprintf(stdout, " | Labels: %s", strings.Join(relevantLabels, ", "))
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(stdout)
 
-	fmt.Printf("   👤 Assignee: None | PRs: 0 | Comments: %d\n", issue.Comments)
+	fmt.Fprintf(stdout, "   👤 Assignee: None | PRs: 0 | Comments: %d\n", issue.Comments)
 
-	fmt.Printf("   🔗 %s\n", issue.URL)
+	fmt.Fprintf(stdout, "   🔗 %s\n", issue.URL)
 
 	whyGood := issue.GenerateWhyGood()
 	if len(whyGood) > 0 {
-		fmt.Println("   Why it's good:")
+		fmt.Fprintln(stdout, "   Why it's good:")
 		for _, reason := range whyGood {
-			fmt.Printf("   • %s\n", reason)
+			fmt.Fprintf(stdout, "   • %s\n", reason)
 		}
 	}
 
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 }
 
 func displayCompactQualifiedIssue(issue QualifiedIssue, num int) {
 	emoji := "✨"
-	fmt.Printf("\n%s [%d] %s (Score: %.2f)\n", emoji, num, issue.Title, issue.QualifiedScore.TotalScore)
-	fmt.Printf("   %s/%s | Type: %s\n", issue.Project.Org, issue.Project.Name, issue.Type)
-	fmt.Printf("   %s\n", issue.URL)
+	fmt.Fprintf(stdout, "\n%s [%d] %s (Score: %.2f)\n", emoji, num, issue.Title, issue.QualifiedScore.TotalScore)
+	fmt.Fprintf(stdout, "   %s/%s | Type: %s\n", issue.Project.Org, issue.Project.Name, issue.Type)
+	fmt.Fprintf(stdout, "   %s\n", issue.URL)
 }
 
 func formatStars(stars int) string {
@@ -177,72 +177,66 @@ func DisplayPartitionedIssuesWithConfig(goodFirstIssues, otherIssues, assignedIs
 		}
 	}
 
-	fmt.Printf("\n%s\n", "ISSUE FINDER RESULTS")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n%s\n", "ISSUE FINDER RESULTS")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
-	sort.Slice(goodFirstIssues, func(i, j int) bool {
-		return goodFirstIssues[i].Score > goodFirstIssues[j].Score
-	})
-	sort.Slice(otherIssues, func(i, j int) bool {
-		return otherIssues[i].Score > otherIssues[j].Score
-	})
-	sort.Slice(assignedIssues, func(i, j int) bool {
-		return assignedIssues[i].Score > assignedIssues[j].Score
-	})
+	SortIssues(goodFirstIssues)
+	SortIssues(otherIssues)
+	SortIssues(assignedIssues)
 
 	printSectionHeader("GOOD FIRST ISSUES", len(goodFirstIssues), "🔥")
-	fmt.Println("(Issues with good-first-issue + confirmed/triage-accepted labels)")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(stdout, "(Issues with good-first-issue + confirmed/triage-accepted labels)")
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 	for i, issue := range goodFirstIssues {
 		if i >= config.MaxGoodFirstIssues {
-			fmt.Printf("\n   ... and %d more good first issues\n", len(goodFirstIssues)-config.MaxGoodFirstIssues)
+			fmt.Fprintf(stdout, "\n   ... and %d more good first issues\n", len(goodFirstIssues)-config.MaxGoodFirstIssues)
 			break
 		}
 		printIssueCardWithScore(issue, i+1, "✅", config.ShowScoreBreakdown)
 	}
 
 	printSectionHeader("OTHER OPPORTUNITIES", len(otherIssues), "📋")
-	fmt.Println("(Bugs, enhancements, help wanted without GFI label)")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(stdout, "(Bugs, enhancements, help wanted without GFI label)")
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 	if len(otherIssues) > 0 {
 		categorized := categorizeOtherIssues(otherIssues)
 		if len(categorized["bug"]) > 0 {
-			fmt.Printf("\n  🐛 Bug Issues (%d)\n", len(categorized["bug"]))
+			fmt.Fprintf(stdout, "\n  🐛 Bug Issues (%d)\n", len(categorized["bug"]))
 			for i, issue := range categorized["bug"] {
 				if i >= 5 {
-					fmt.Printf("     ... and %d more\n", len(categorized["bug"])-5)
+					fmt.Fprintf(stdout, "     ... and %d more\n", len(categorized["bug"])-5)
 					break
 				}
 				printCompactIssueWithScore(issue, "🔴")
 			}
 		}
 		if len(categorized["enhancement"]) > 0 {
-			fmt.Printf("\n  ✨ Enhancement Issues (%d)\n", len(categorized["enhancement"]))
+			fmt.Fprintf(stdout, "\n  ✨ Enhancement Issues (%d)\n", len(categorized["enhancement"]))
 			for i, issue := range categorized["enhancement"] {
 				if i >= 5 {
-					fmt.Printf("     ... and %d more\n", len(categorized["enhancement"])-5)
+					fmt.Fprintf(stdout, "     ... and %d more\n", len(categorized["enhancement"])-5)
 					break
 				}
 				printCompactIssueWithScore(issue, "🟢")
 			}
 		}
 		if len(categorized["help"]) > 0 {
-			fmt.Printf("\n  🆘 Help Wanted (%d)\n", len(categorized["help"]))
+			fmt.Fprintf(stdout, "\n  🆘 Help Wanted (%d)\n", len(categorized["help"]))
 			for i, issue := range categorized["help"] {
 				if i >= 5 {
-					fmt.Printf("     ... and %d more\n", len(categorized["help"])-5)
+					fmt.Fprintf(stdout, "     ... and %d more\n", len(categorized["help"])-5)
 					break
 				}
 				printCompactIssueWithScore(issue, "🟡")
 			}
 		}
 		if len(categorized["other"]) > 0 {
-			fmt.Printf("\n  📌 Other Issues (%d)\n", len(categorized["other"]))
+			fmt.Fprintf(stdout, "\n  📌 Other Issues (%d)\n", len(categorized["other"]))
 			for i, issue := range categorized["other"] {
 				if i >= 5 {
-					fmt.Printf("     ... and %d more\n", len(categorized["other"])-5)
+					fmt.Fprintf(stdout, "     ... and %d more\n", len(categorized["other"])-5)
 					break
 				}
 				printCompactIssueWithScore(issue, "⚪")
@@ -253,34 +247,46 @@ func DisplayPartitionedIssuesWithConfig(goodFirstIssues, otherIssues, assignedIs
 	printSectionHeader("YOUR ASSIGNED ISSUES", len(assignedIssues), "👤")
 	for i, issue := range assignedIssues {
 		if i >= config.MaxAssignedIssues {
-			fmt.Printf("\n   ... and %d more assigned issues\n", len(assignedIssues)-config.MaxAssignedIssues)
+			fmt.Fprintf(stdout, "\n   ... and %d more assigned issues\n", len(assignedIssues)-config.MaxAssignedIssues)
 			break
 		}
 		printIssueCardWithScore(issue, i+1, "📌", config.ShowScoreBreakdown)
 	}
 
-	fmt.Printf("\n%s\n", strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n%s\n", strings.Repeat("=", 80))
 	printSummary(goodFirstIssues, otherIssues, assignedIssues)
 }
 
 func printSectionHeader(title string, count int, emoji string) {
-	fmt.Printf("\n\n%s %s (%d issues)\n", emoji, title, count)
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintf(stdout, "\n\n%s %s (%d issues)\n", emoji, colorize(colorBold, title), count)
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 	if count == 0 {
-		fmt.Println("  No issues in this category")
+		fmt.Fprintln(stdout, "  No issues in this category")
 	}
 }
 
 func printIssueCardWithScore(issue Issue, num int, emoji string, showBreakdown bool) {
 	scoreEmoji := getScoreEmoji(issue.Score)
 
-	fmt.Printf("\n%s [%d] %s\n", scoreEmoji, num, issue.Title)
-	fmt.Printf("   Score: %.2f %s\n", issue.Score, getScoreLabel(issue.Score))
-	fmt.Printf("   Project: %s/%s (%d★) | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
-	fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-	fmt.Printf("   URL: %s\n", issue.URL)
+	fmt.Fprintf(stdout, "\n%s [%d] %s\n", scoreEmoji, num, issue.Title)
+	if confidence := formatConfidence(issue); confidence != "" {
+		fmt.Fprintf(stdout, "   Score: %.2f %s | Confidence: %s\n", issue.Score, getScoreLabel(issue.Score), confidence)
+	} else {
+		fmt.Fprintf(stdout, "   Score: %.2f %s\n", issue.Score, getScoreLabel(issue.Score))
+	}
+	if badge := issue.SignOff.Badge(); badge != "" {
+		fmt.Fprintf(stdout, "   Project: %s/%s (%d★) | %s | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category, badge)
+	} else {
+		fmt.Fprintf(stdout, "   Project: %s/%s (%d★) | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
+	}
+	if issue.ReadingTime.Minutes > 0 {
+		fmt.Fprintf(stdout, "   Comments: %d | Created: %s | %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"), issue.ReadingTime)
+	} else {
+		fmt.Fprintf(stdout, "   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
+	}
+	fmt.Fprintf(stdout, "   URL: %s\n", issue.URL)
 	if len(issue.Labels) > 0 {
-		fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
+		fmt.Fprintf(stdout, "   Labels: %s\n", strings.Join(issue.Labels, ", "))
 	}
 
 	if showBreakdown && issue.Score > 0 {
@@ -289,7 +295,7 @@ func printIssueCardWithScore(issue Issue, num int, emoji string, showBreakdown b
 }
 
 func printMiniScoreBreakdown(issue Issue) {
-	fmt.Printf("   Score factors: ")
+	fmt.Fprintf(stdout, "   Score factors: ")
 	var factors []string
 
 	if issue.Project.Stars >= 10000 {
@@ -315,13 +321,13 @@ func printMiniScoreBreakdown(issue Issue) {
 	if len(factors) == 0 {
 		factors = append(factors, "standard")
 	}
-	fmt.Printf("%s\n", strings.Join(factors, ", "))
+	fmt.Fprintf(stdout, "%s\n", strings.Join(factors, ", "))
 }
 
 func printCompactIssueWithScore(issue Issue, emoji string) {
 	scoreEmoji := getScoreEmoji(issue.Score)
-	fmt.Printf("   %s %s [%.2f] - %s/%s\n", emoji, scoreEmoji, issue.Score, issue.Project.Org, issue.Project.Name)
-	fmt.Printf("      %s\n", issue.URL)
+	fmt.Fprintf(stdout, "   %s %s [%.2f] - %s/%s\n", emoji, scoreEmoji, issue.Score, issue.Project.Org, issue.Project.Name)
+	fmt.Fprintf(stdout, "      %s\n", issue.URL)
 }
 
 func getScoreEmoji(score float64) string {
@@ -383,20 +389,20 @@ func categorizeOtherIssues(issues []Issue) map[string][]Issue {
 
 func printSummary(goodFirstIssues, otherIssues, assignedIssues []Issue) {
 	total := len(goodFirstIssues) + len(otherIssues) + len(assignedIssues)
-	fmt.Printf("\n📊 SUMMARY\n")
-	fmt.Printf("   Good First Issues: %d\n", len(goodFirstIssues))
-	fmt.Printf("   Other Opportunities: %d\n", len(otherIssues))
-	fmt.Printf("   Your Assigned Issues: %d\n", len(assignedIssues))
-	fmt.Printf("   Total Displayed: %d\n", total)
+	fmt.Fprintf(stdout, "\n📊 SUMMARY\n")
+	fmt.Fprintf(stdout, "   Good First Issues: %d\n", len(goodFirstIssues))
+	fmt.Fprintf(stdout, "   Other Opportunities: %d\n", len(otherIssues))
+	fmt.Fprintf(stdout, "   Your Assigned Issues: %d\n", len(assignedIssues))
+	fmt.Fprintf(stdout, "   Total Displayed: %d\n", total)
 
 	if total > 0 {
 		avgScore := calculateAverageScore(goodFirstIssues, otherIssues, assignedIssues)
-		fmt.Printf("   Average Score: %.2f\n", avgScore)
+		fmt.Fprintf(stdout, "   Average Score: %.2f\n", avgScore)
 
 		topIssue := getTopIssue(goodFirstIssues, otherIssues)
 		if topIssue != nil {
-			fmt.Printf("\n   🏆 Top Issue: %s (%.2f)\n", truncateString(topIssue.Title, 40), topIssue.Score)
-			fmt.Printf("      %s\n", topIssue.URL)
+			fmt.Fprintf(stdout, "\n   🏆 Top Issue: %s (%.2f)\n", truncateString(topIssue.Title, 40), topIssue.Score)
+			fmt.Fprintf(stdout, "      %s\n", topIssue.URL)
 		}
 	}
 }
@@ -429,13 +435,13 @@ func getTopIssue(issueLists ...[]Issue) *Issue {
 }
 
 func DisplayEligibleIssues(eligible, ineligible []Issue) {
-	fmt.Printf("\n%s\n", "ISSUE ELIGIBILITY CHECK")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n%s\n", "ISSUE ELIGIBILITY CHECK")
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	printSectionHeader("ELIGIBLE FOR ASSIGNMENT", len(eligible), "✅")
 	for i, issue := range eligible {
 		if i >= 15 {
-			fmt.Printf("\n   ... and %d more eligible issues\n", len(eligible)-15)
+			fmt.Fprintf(stdout, "\n   ... and %d more eligible issues\n", len(eligible)-15)
 			break
 		}
 		printIssueCardWithScore(issue, i+1, "🔥", true)
@@ -444,26 +450,26 @@ func DisplayEligibleIssues(eligible, ineligible []Issue) {
 	printSectionHeader("NOT ELIGIBLE", len(ineligible), "⚠️")
 	for i, issue := range ineligible {
 		if i >= 10 {
-			fmt.Printf("\n   ... and %d more ineligible issues\n", len(ineligible)-10)
+			fmt.Fprintf(stdout, "\n   ... and %d more ineligible issues\n", len(ineligible)-10)
 			break
 		}
-		fmt.Printf("\n[%d] %s\n", i+1, issue.Title)
-		fmt.Printf("   URL: %s\n", issue.URL)
-		fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
+		fmt.Fprintf(stdout, "\n[%d] %s\n", i+1, issue.Title)
+		fmt.Fprintf(stdout, "   URL: %s\n", issue.URL)
+		fmt.Fprintf(stdout, "   Labels: %s\n", strings.Join(issue.Labels, ", "))
 	}
 }
 
 func DisplayIssueDigest(issues []Issue, date string) {
-	fmt.Printf("\n%s\n", "DAILY ISSUE DIGEST")
-	fmt.Println(strings.Repeat("=", 80))
-	fmt.Printf("Date: %s\n", date)
+	fmt.Fprintf(stdout, "\n%s\n", T("digest.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, T("digest.date", date))
 
 	if len(issues) == 0 {
-		fmt.Println("\nNo issues found for today's digest.")
+		fmt.Fprintln(stdout, "\n"+T("digest.empty"))
 		return
 	}
 
-	fmt.Printf("\nFound %d issues\n", len(issues))
+	fmt.Fprintln(stdout, "\n"+T("digest.found", len(issues)))
 
 	goodFirst := []Issue{}
 	other := []Issue{}
@@ -477,38 +483,60 @@ func DisplayIssueDigest(issues []Issue, date string) {
 	}
 
 	if len(goodFirst) > 0 {
-		fmt.Printf("\n🔥 Good First Issues (%d):\n", len(goodFirst))
-		for i, issue := range goodFirst {
-			if i >= 5 {
-				fmt.Printf("   ... and %d more\n", len(goodFirst)-5)
-				break
-			}
-			printCompactIssueWithScore(issue, "✅")
+		fmt.Fprintln(stdout, "\n"+T("digest.good_first", len(goodFirst)))
+		issueSummaryTable(goodFirst, 5).Render(stdout)
+		if len(goodFirst) > 5 {
+			fmt.Fprintln(stdout, T("digest.more", len(goodFirst)-5))
 		}
 	}
 
 	if len(other) > 0 {
-		fmt.Printf("\n📋 Other Issues (%d):\n", len(other))
-		for i, issue := range other {
-			if i >= 5 {
-				fmt.Printf("   ... and %d more\n", len(other)-5)
-				break
-			}
-			printCompactIssueWithScore(issue, "📌")
+		fmt.Fprintln(stdout, "\n"+T("digest.other", len(other)))
+		issueSummaryTable(other, 5).Render(stdout)
+		if len(other) > 5 {
+			fmt.Fprintln(stdout, T("digest.more", len(other)-5))
 		}
 	}
 }
 
+func issueSummaryTable(issues []Issue, limit int) *Table {
+	table := NewTable(
+		TableColumn{Header: T("col.score"), Align: AlignRight},
+		TableColumn{Header: T("col.grade")},
+		TableColumn{Header: T("col.project")},
+		TableColumn{Header: T("col.comments"), Align: AlignRight},
+		TableColumn{Header: T("col.title"), Flex: true},
+		TableColumn{Header: T("col.url")},
+	)
+
+	for i, issue := range issues {
+		if limit > 0 && i >= limit {
+			break
+		}
+		color := scoreColor(issue.Score)
+		table.AddCells(
+			TableCell{Text: fmt.Sprintf("%.2f", issue.Score), Color: color},
+			TableCell{Text: scoreGrade(issue.Score), Color: color},
+			TableCell{Text: fmt.Sprintf("%s/%s", issue.Project.Org, issue.Project.Name)},
+			TableCell{Text: strconv.Itoa(issue.Comments)},
+			TableCell{Text: issue.Title},
+			TableCell{Text: issue.URL},
+		)
+	}
+
+	return table
+}
+
 func DisplayIssuesJSON(issues []Issue) {
-	fmt.Printf("{\"issues\":[")
+	fmt.Fprintf(stdout, "{\"issues\":[")
 	for i, issue := range issues {
 		if i > 0 {
-			fmt.Printf(",")
+			fmt.Fprintf(stdout, ",")
 		}
-		fmt.Printf("{\"title\":\"%s\",\"url\":\"%s\",\"score\":%.2f,\"project\":\"%s/%s\",\"stars\":%d,\"comments\":%d,\"is_good_first\":%v}",
-			escapeJSON(issue.Title), issue.URL, issue.Score, issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Comments, issue.IsGoodFirst)
+		fmt.Fprintf(stdout, "{\"title\":\"%s\",\"url\":\"%s\",\"score\":%.2f,\"project\":\"%s/%s\",\"stars\":%d,\"comments\":%d,\"reading_minutes\":%d,\"is_good_first\":%v,\"sign_off\":\"%s\",\"confidence\":%.2f,\"keyword_score\":%.2f}",
+			escapeJSON(issue.Title), issue.URL, issue.Score, issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Comments, issue.ReadingTime.Minutes, issue.IsGoodFirst, issue.SignOff, issue.scoreConfidence(), issue.KeywordScore)
 	}
-	fmt.Printf("],\"total\":%d}\n", len(issues))
+	fmt.Fprintf(stdout, "],\"total\":%d}\n", len(issues))
 }
 
 func escapeJSON(s string) string {
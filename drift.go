@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+// DriftConfig controls the verification pass that compares what the database
+// believes about issues and comments with what GitHub shows now.
+type DriftConfig struct {
+	Enabled       bool
+	SampleSize    int
+	AutoReconcile bool
+}
+
+type DriftKind string
+
+const (
+	DriftIssueClosed    DriftKind = "issue_closed"
+	DriftCommentDeleted DriftKind = "comment_deleted"
+)
+
+type DriftItem struct {
+	Kind       DriftKind
+	IssueURL   string
+	Stored     string
+	Live       string
+	Reconciled bool
+}
+
+type DriftReport struct {
+	IssuesChecked   int
+	CommentsChecked int
+	Items           []DriftItem
+}
+
+type DriftChecker struct {
+	api    GitHubAPI
+	db     *sqlx.DB
+	config *DriftConfig
+}
+
+func loadDriftConfigFromEnv() *DriftConfig {
+	return &DriftConfig{
+		Enabled:       getEnvBool("DRIFT_CHECK_ENABLED", true),
+		SampleSize:    getEnvInt("DRIFT_SAMPLE_SIZE", 10),
+		AutoReconcile: getEnvBool("DRIFT_AUTO_RECONCILE", false),
+	}
+}
+
+func NewDriftChecker(api GitHubAPI, db *sqlx.DB, config *DriftConfig) *DriftChecker {
+	if config == nil {
+		config = loadDriftConfigFromEnv()
+	}
+	return &DriftChecker{api: api, db: db, config: config}
+}
+
+// sampleURLs picks up to n URLs. The input is sorted first so the sample
+// depends only on the run seed.
+func sampleURLs(urls []string, n int, rng *rand.Rand) []string {
+	sorted := append([]string{}, urls...)
+	sort.Strings(sorted)
+	rng.Shuffle(len(sorted), func(i, j int) { sorted[i], sorted[j] = sorted[j], sorted[i] })
+	if n > 0 && len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// reconciledStatus is the tracker status for an issue found closed upstream:
+// completed if it was closed as done while our PR was open, abandoned
+// otherwise.
+func reconciledStatus(stored WorkStatus, issue *github.Issue) WorkStatus {
+	if stored == StatusPRSubmitted && issue.GetStateReason() == "completed" {
+		return StatusCompleted
+	}
+	return StatusAbandoned
+}
+
+// Check samples tracked issues and recorded comments and reports those that
+// no longer match GitHub. With AutoReconcile, the stored state is corrected.
+// It reads GitHub past the API cache, which could still hold the old state.
+func (d *DriftChecker) Check(ctx context.Context) (*DriftReport, error) {
+	ctx = withoutAPICache(ctx)
+	report := &DriftReport{}
+	rng := runRand()
+
+	var tracked []struct {
+		IssueURL string `db:"issue_url"`
+		Status   string `db:"status"`
+	}
+	if err := d.db.Select(&tracked, `
+		SELECT issue_url, status FROM tracked_issues WHERE status NOT IN ($1, $2)
+	`, StatusCompleted, StatusAbandoned); err != nil {
+		return nil, fmt.Errorf("failed to load tracked issues: %w", err)
+	}
+
+	statuses := make(map[string]WorkStatus, len(tracked))
+	urls := make([]string, 0, len(tracked))
+	for _, t := range tracked {
+		statuses[t.IssueURL] = WorkStatus(t.Status)
+		urls = append(urls, t.IssueURL)
+	}
+
+	for _, issueURL := range sampleURLs(urls, d.config.SampleSize, rng) {
+		owner, repo, number, err := ParseIssueURL(issueURL)
+		if err != nil {
+			continue
+		}
+		issue, _, err := d.api.GetIssue(ctx, owner, repo, number)
+		if err != nil {
+			log.Printf("[Drift] Failed to fetch %s: %v", issueURL, err)
+			continue
+		}
+		report.IssuesChecked++
+
+		if issue.GetState() != "closed" {
+			continue
+		}
+		item := DriftItem{
+			Kind:     DriftIssueClosed,
+			IssueURL: issueURL,
+			Stored:   string(statuses[issueURL]),
+			Live:     strings.TrimSpace("closed " + issue.GetStateReason()),
+		}
+		if d.config.AutoReconcile {
+			item.Reconciled = d.reconcileClosedIssue(issueURL, reconciledStatus(statuses[issueURL], issue))
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	if err := d.checkComments(ctx, rng, report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+// checkComments looks for our own comment on sampled issues we recorded
+// commenting on. Without a login to match against, the check is skipped.
+func (d *DriftChecker) checkComments(ctx context.Context, rng *rand.Rand, report *DriftReport) error {
+	user, _, err := d.api.GetUser(ctx, "")
+	if err != nil || user.GetLogin() == "" {
+		log.Printf("[Drift] Skipping comment check, could not determine GitHub login: %v", err)
+		return nil
+	}
+	login := user.GetLogin()
+
+	filter, err := d.postedCommentFilter()
+	if err != nil {
+		return fmt.Errorf("failed to inspect comment history: %w", err)
+	}
+	var urls []string
+	if err := d.db.Select(&urls, `
+		SELECT DISTINCT issue_url FROM comment_history
+		WHERE issue_url IS NOT NULL AND issue_url <> ''`+filter); err != nil {
+		return fmt.Errorf("failed to load comment history: %w", err)
+	}
+
+	for _, issueURL := range sampleURLs(urls, d.config.SampleSize, rng) {
+		owner, repo, number, err := ParseIssueURL(issueURL)
+		if err != nil {
+			continue
+		}
+		found, err := d.hasCommentBy(ctx, owner, repo, number, login)
+		if err != nil {
+			log.Printf("[Drift] Failed to list comments on %s: %v", issueURL, err)
+			continue
+		}
+		report.CommentsChecked++
+
+		if found {
+			continue
+		}
+
+		item := DriftItem{
+			Kind:     DriftCommentDeleted,
+			IssueURL: issueURL,
+			Stored:   "commented as " + login,
+			Live:     "no comment by " + login,
+		}
+		if d.config.AutoReconcile {
+			item.Reconciled = d.reconcileDeletedComment(issueURL)
+		}
+		report.Items = append(report.Items, item)
+	}
+	return nil
+}
+
+// postedCommentFilter limits comment_history to comments that were posted
+// and not already found deleted. CommentManager records failed attempts with
+// success = false and AutoFinder keeps a status, so the columns are looked up.
+func (d *DriftChecker) postedCommentFilter() (string, error) {
+	var columns []string
+	err := d.db.Select(&columns, `
+		SELECT column_name FROM information_schema.columns
+		WHERE table_name = 'comment_history' AND column_name IN ('success', 'status', 'deleted_at')
+		ORDER BY column_name`)
+	if err != nil {
+		return "", err
+	}
+
+	var filter string
+	for _, column := range columns {
+		switch column {
+		case "success":
+			filter += " AND success = true"
+		case "status":
+			filter += " AND status = 'posted'"
+		case "deleted_at":
+			filter += " AND deleted_at IS NULL"
+		}
+	}
+	return filter, nil
+}
+
+// hasCommentBy pages through an issue's comments looking for one by login.
+func (d *DriftChecker) hasCommentBy(ctx context.Context, owner, repo string, number int, login string) (bool, error) {
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, resp, err := d.api.ListIssueComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return false, err
+		}
+		for _, comment := range comments {
+			if strings.EqualFold(comment.GetUser().GetLogin(), login) {
+				return true, nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return false, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (d *DriftChecker) reconcileClosedIssue(issueURL string, status WorkStatus) bool {
+	_, err := d.db.Exec(`
+		UPDATE tracked_issues SET status = $2, updated_at = NOW(),
+			completed_at = CASE WHEN $2 = 'completed' THEN NOW() ELSE completed_at END
+		WHERE issue_url = $1
+	`, issueURL, status)
+	if err != nil {
+		log.Printf("[Drift] Failed to reconcile %s: %v", issueURL, err)
+		return false
+	}
+	return true
+}
+
+// reconcileDeletedComment marks a comment that no longer exists as deleted.
+// The rows stay, since they are what stops a second comment on the issue;
+// the mark keeps later checks from reporting the same comment again.
+func (d *DriftChecker) reconcileDeletedComment(issueURL string) bool {
+	if _, err := d.db.Exec(`ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP`); err != nil {
+		log.Printf("[Drift] Failed to reconcile comment on %s: %v", issueURL, err)
+		return false
+	}
+	if _, err := d.db.Exec(`
+		UPDATE comment_history SET deleted_at = NOW() WHERE issue_url = $1 AND deleted_at IS NULL
+	`, issueURL); err != nil {
+		log.Printf("[Drift] Failed to reconcile comment on %s: %v", issueURL, err)
+		return false
+	}
+	return true
+}
+
+func (r *DriftReport) Summary() string {
+	return fmt.Sprintf("checked %d issues and %d comments, %d drifted", r.IssuesChecked, r.CommentsChecked, len(r.Items))
+}
+
+func printDriftReport(report *DriftReport) {
+	fmt.Fprintf(stdout, "\n%s\n", T("drift.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, T("drift.checked", report.IssuesChecked, report.CommentsChecked))
+
+	if len(report.Items) == 0 {
+		fmt.Fprintln(stdout, T("drift.none"))
+		return
+	}
+
+	table := NewTable(
+		TableColumn{Header: T("col.kind")},
+		TableColumn{Header: T("col.stored")},
+		TableColumn{Header: T("col.live")},
+		TableColumn{Header: T("col.url"), Flex: true},
+		TableColumn{Header: T("col.fixed")},
+	)
+	for _, item := range report.Items {
+		fixed := ""
+		if item.Reconciled {
+			fixed = "✓"
+		}
+		table.AddCells(
+			TableCell{Text: string(item.Kind), Color: colorYellow},
+			TableCell{Text: item.Stored},
+			TableCell{Text: item.Live},
+			TableCell{Text: item.IssueURL},
+			TableCell{Text: fixed, Color: colorGreen},
+		)
+	}
+	table.Render(stdout)
+}
+
+// checkDrift runs the sampled drift check at the end of a daemon run and logs
+// what it finds.
+func (f *IssueFinder) checkDrift(ctx context.Context) {
+	config := loadDriftConfigFromEnv()
+	if !config.Enabled || liteOptions.Enabled || ctx.Err() != nil {
+		return
+	}
+
+	report, err := NewDriftChecker(f.client, f.db, config).Check(ctx)
+	if err != nil {
+		log.Printf("[Drift] Check failed: %v", err)
+		return
+	}
+	log.Printf("[Drift] %s", report.Summary())
+	for _, item := range report.Items {
+		log.Printf("[Drift] %s %s: stored %q, live %q (reconciled: %v)", item.Kind, item.IssueURL, item.Stored, item.Live, item.Reconciled)
+	}
+}
+
+func runDriftCommand(args []string) error {
+	config := loadDriftConfigFromEnv()
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--sample" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --sample value %q", args[i+1])
+			}
+			config.SampleSize = n
+			i++
+		case args[i] == "--fix":
+			config.AutoReconcile = true
+		}
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	report, err := NewDriftChecker(server.client, server.db, config).Check(context.Background())
+	if err != nil {
+		return err
+	}
+	printDriftReport(report)
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestSampleURLs(t *testing.T) {
+	urls := []string{"c", "a", "e", "b", "d"}
+
+	first := sampleURLs(urls, 3, rand.New(rand.NewSource(7)))
+	shuffledInput := sampleURLs([]string{"e", "d", "c", "b", "a"}, 3, rand.New(rand.NewSource(7)))
+	if len(first) != 3 || !reflect.DeepEqual(first, shuffledInput) {
+		t.Errorf("sample should depend only on the seed: %v vs %v", first, shuffledInput)
+	}
+	if urls[0] != "c" {
+		t.Error("input should not be modified")
+	}
+	if all := sampleURLs(urls, 0, rand.New(rand.NewSource(1))); len(all) != len(urls) {
+		t.Errorf("n=0 should keep everything, got %d", len(all))
+	}
+}
+
+func TestReconciledStatus(t *testing.T) {
+	done := &github.Issue{State: github.String("closed"), StateReason: github.String("completed")}
+	dropped := &github.Issue{State: github.String("closed"), StateReason: github.String("not_planned")}
+
+	tests := []struct {
+		stored WorkStatus
+		issue  *github.Issue
+		want   WorkStatus
+	}{
+		{StatusPRSubmitted, done, StatusCompleted},
+		{StatusPRSubmitted, dropped, StatusAbandoned},
+		{StatusInProgress, done, StatusAbandoned},
+	}
+	for _, tt := range tests {
+		if got := reconciledStatus(tt.stored, tt.issue); got != tt.want {
+			t.Errorf("reconciledStatus(%s, %s) = %s, want %s", tt.stored, tt.issue.GetStateReason(), got, tt.want)
+		}
+	}
+}
+
+func TestDriftCheck_MarksDeletedComments(t *testing.T) {
+	fake, db := newFakeSQL(t)
+
+	// golang/go#1 has our comment on its second page; golang/go#2 lost it.
+	var page []*github.IssueComment
+	for i := 0; i < 100; i++ {
+		page = append(page, &github.IssueComment{User: &github.User{Login: github.String(fmt.Sprintf("user%d", i))}})
+	}
+	api := &fakeGitHubAPI{comments: map[string][]*github.IssueComment{
+		"golang/go#1": append(page, &github.IssueComment{User: &github.User{Login: github.String("Tester")}}),
+		"golang/go#2": page,
+	}}
+
+	fake.expect("FROM tracked_issues").returns([]string{"issue_url", "status"})
+	fake.expect("FROM information_schema.columns").returns([]string{"column_name"}, []driver.Value{"status"}, []driver.Value{"success"})
+	fake.expect("AND status = 'posted' AND success = true").returns([]string{"issue_url"},
+		[]driver.Value{"https://github.com/golang/go/issues/1"},
+		[]driver.Value{"https://github.com/golang/go/issues/2"},
+	)
+	fake.expect("ADD COLUMN IF NOT EXISTS deleted_at")
+	fake.expect("UPDATE comment_history SET deleted_at = NOW()", "https://github.com/golang/go/issues/2").affects(1)
+
+	checker := NewDriftChecker(api, db, &DriftConfig{Enabled: true, SampleSize: 10, AutoReconcile: true})
+	report, err := checker.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if report.CommentsChecked != 2 {
+		t.Errorf("CommentsChecked = %d, want 2", report.CommentsChecked)
+	}
+	if len(report.Items) != 1 {
+		t.Fatalf("got %d drift items, want 1: %+v", len(report.Items), report.Items)
+	}
+	item := report.Items[0]
+	if item.Kind != DriftCommentDeleted || item.IssueURL != "https://github.com/golang/go/issues/2" || !item.Reconciled {
+		t.Errorf("unexpected item %+v", item)
+	}
+}
@@ -1,11 +1,5 @@
 package main
 
-import (
-	"fmt"
-	"strings"
-	"time"
-)
-
 type EmailTemplate struct {
 	Subject  string
 	HTMLBody string
@@ -22,6 +16,8 @@ type ScoreBreakdown struct {
 	ActivityScore     float64
 	MaintainerScore   float64
 	BonusScore        float64
+	KeywordScore      float64 // capped text heuristics, see scoreSignals
+	Confidence        float64
 	TotalScore        float64
 	StarsWeight       float64
 	CommentsWeight    float64
@@ -31,248 +27,213 @@ type ScoreBreakdown struct {
 }
 
 func NewIssueEmailTemplate(issue Issue, breakdown *ScoreBreakdown) *EmailTemplate {
-	scoreEmoji := "✨"
-	if issue.Score >= 0.8 {
-		scoreEmoji = "🔥"
-	} else if issue.Score >= 0.6 {
-		scoreEmoji = "⭐"
-	}
-
-	labelsHTML := ""
-	if len(issue.Labels) > 0 {
-		for _, label := range issue.Labels {
-			labelsHTML += fmt.Sprintf(`<span style="background:#e1e4e8;padding:2px 8px;border-radius:12px;font-size:12px;margin-right:4px;">%s</span>`, label)
-		}
-	}
-
-	breakdownHTML := ""
-	if breakdown != nil {
-		breakdownHTML = fmt.Sprintf(`
-		<div style="background:#f6f8fa;padding:16px;border-radius:8px;margin-top:20px;">
-			<h3 style="margin-top:0;color:#24292e;">Score Breakdown</h3>
-			<table style="width:100%%;border-collapse:collapse;">
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Project Popularity</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Competition (Comments)</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Recency</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Labels Match</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Description Quality</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Project Activity</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Bonus Factors</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">%.2f</td></tr>
-				<tr style="font-weight:bold;background:#fff8c5;"><td style="padding:12px 0;">Total Score</td><td style="padding:12px 0;text-align:right;">%.2f</td></tr>
-			</table>
-		</div>
-		`, breakdown.StarsScore, breakdown.CommentsScore, breakdown.RecencyScore,
-			breakdown.LabelsScore, breakdown.DescriptionScore, breakdown.ActivityScore,
-			breakdown.BonusScore, breakdown.TotalScore)
-	}
-
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
+	links := getDeepLinkConfig().LinksFor(issue.URL)
+	return getNotificationTemplates().RenderEmail(AlertNewIssue, NotificationData{Issue: issue, Breakdown: breakdown, Links: links})
+}
+
+func DigestEmailTemplate(issues []Issue) *EmailTemplate {
+	return getNotificationTemplates().RenderEmail(AlertDigest, NotificationData{Issues: issues})
+}
+
+func AssignmentConfirmationTemplate(issue Issue) *EmailTemplate {
+	return getNotificationTemplates().RenderEmail(AlertAssignmentConfirmed, NotificationData{Issue: issue})
+}
+
+func AssignmentRequestTemplate(issue Issue) *EmailTemplate {
+	return getNotificationTemplates().RenderEmail(AlertAssignmentRequest, NotificationData{Issue: issue})
+}
+
+func QualifiedIssueEmailTemplate(issue QualifiedIssue) *EmailTemplate {
+	return getNotificationTemplates().RenderEmail(AlertQualified, NotificationData{Issue: issue.Issue, Qualified: &issue})
+}
+
+// Email layouts shared by the default templates below.
+const (
+	emailPageStart = `<!DOCTYPE html>
+<html dir="{{dir}}">
 <head>
 	<meta charset="UTF-8">
 </head>
-<body style="font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;font-size:16px;line-height:1.5;color:#24292e;max-width:600px;margin:0 auto;padding:20px;">
-	<div style="background:linear-gradient(135deg,#667eea 0%%,#764ba2 100%%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
-		<h1 style="color:#fff;margin:0;font-size:24px;">%s New Issue Found</h1>
-		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">A great learning opportunity awaits!</p>
+<body style="font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;font-size:16px;line-height:1.5;color:#24292e;max-width:600px;margin:0 auto;padding:20px;">`
+
+	emailPageEnd = `
+	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
+		<p>GitHub Issue Finder • {{date .Now "2006-01-02"}}</p>
+	</div>
+</body>
+</html>
+`
+
+	emailTextFooter = `
+---
+GitHub Issue Finder • {{date .Now "2006-01-02"}}
+`
+)
+
+// defaultEmailTemplates are the built-in subject, text and HTML parts for each
+// alert. Override files replace them part by part.
+var defaultEmailTemplates = map[string]string{
+	"email/new_issue.subject": `{{scoreEmoji .Issue.Score}} [{{printf "%.2f" .Issue.Score}}] {{truncate .Issue.Title 50}}`,
+
+	"email/new_issue.text": `
+{{T "email.new_text"}}
+
+{{.Issue.Title}} (Score: {{printf "%.2f" .Issue.Score}})
+
+Project: {{.Issue.Project.Org}}/{{.Issue.Project.Name}} ({{.Issue.Project.Stars}} stars)
+Category: {{.Issue.Project.Category}}
+Comments: {{.Issue.Comments}}
+Created: {{date .Issue.CreatedAt "2006-01-02"}}
+
+URL: {{.Issue.URL}}
+
+Labels: {{join .Issue.Labels ", "}}
+{{with .Links}}
+{{.TextLine}}
+{{end}}` + emailTextFooter,
+
+	"email/new_issue.html": emailPageStart + `
+	<div style="background:linear-gradient(135deg,#667eea 0%,#764ba2 100%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
+		<h1 style="color:#fff;margin:0;font-size:24px;">{{T "email.new_heading" (scoreEmoji .Issue.Score)}}</h1>
+		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">{{T "email.new_tagline"}}</p>
 	</div>
-	
+
 	<div style="background:#fff;border:1px solid #e1e4e8;border-top:none;padding:24px;border-radius:0 0 12px 12px;">
-		<h2 style="margin-top:0;color:#0366d6;font-size:20px;">%s</h2>
-		
+		<h2 style="margin-top:0;color:#0366d6;font-size:20px;">{{.Issue.Title}}</h2>
+
 		<div style="margin:16px 0;">
-			<span style="display:inline-block;background:#28a745;color:#fff;padding:4px 12px;border-radius:4px;font-weight:bold;">Score: %.2f</span>
-			<span style="margin-left:12px;color:#586069;">%s/%s (%d★)</span>
+			<span style="display:inline-block;background:#28a745;color:#fff;padding:4px 12px;border-radius:4px;font-weight:bold;">Score: {{printf "%.2f" .Issue.Score}}</span>
+			<span style="margin-left:12px;color:#586069;">{{.Issue.Project.Org}}/{{.Issue.Project.Name}} ({{.Issue.Project.Stars}}★)</span>
 		</div>
-		
-		<table style="width:100%%;margin:16px 0;">
+
+		<table style="width:100%;margin:16px 0;">
 			<tr>
 				<td style="color:#586069;padding:8px 0;width:120px;">Category:</td>
-				<td style="padding:8px 0;">%s</td>
+				<td style="padding:8px 0;">{{.Issue.Project.Category}}</td>
 			</tr>
 			<tr>
 				<td style="color:#586069;padding:8px 0;">Comments:</td>
-				<td style="padding:8px 0;">%d</td>
+				<td style="padding:8px 0;">{{.Issue.Comments}}</td>
 			</tr>
 			<tr>
 				<td style="color:#586069;padding:8px 0;">Created:</td>
-				<td style="padding:8px 0;">%s</td>
+				<td style="padding:8px 0;">{{date .Issue.CreatedAt "January 2, 2006"}}</td>
 			</tr>
 		</table>
-		
-		<div style="margin:16px 0;">%s</div>
-		
-		<a href="%s" style="display:inline-block;background:#0366d6;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">View Issue →</a>
-		
-		%s
-	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder • %s</p>
-	</div>
-</body>
-</html>
-	`, scoreEmoji, issue.Title, issue.Score, issue.Project.Org, issue.Project.Name,
-		issue.Project.Stars, issue.Project.Category, issue.Comments,
-		issue.CreatedAt.Format("January 2, 2006"), labelsHTML, issue.URL,
-		breakdownHTML, time.Now().Format("2006"))
-
-	textBody := fmt.Sprintf(`
-New Issue Found!
 
-%s (Score: %.2f)
+		<div style="margin:16px 0;">{{range .Issue.Labels}}<span style="background:#e1e4e8;padding:2px 8px;border-radius:12px;font-size:12px;margin-right:4px;">{{.}}</span>{{end}}</div>
 
-Project: %s/%s (%d stars)
-Category: %s
-Comments: %d
-Created: %s
-
-URL: %s
+		<a href="{{.Issue.URL}}" style="display:inline-block;background:#0366d6;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">{{T "email.view_issue"}}</a>
+		{{htmlLinks .Links}}
+{{with .Breakdown}}
+		<div style="background:#f6f8fa;padding:16px;border-radius:8px;margin-top:20px;">
+			<h3 style="margin-top:0;color:#24292e;">{{T "email.breakdown"}}</h3>
+			<table style="width:100%;border-collapse:collapse;">
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Project Popularity</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .StarsScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Competition (Comments)</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .CommentsScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Recency</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .RecencyScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Labels Match</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .LabelsScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Description Quality</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .DescriptionScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Project Activity</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .ActivityScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Bonus Factors</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .BonusScore}}</td></tr>
+				<tr><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;">Text Keywords</td><td style="padding:8px 0;border-bottom:1px solid #e1e4e8;text-align:right;">{{printf "%.2f" .KeywordScore}}</td></tr>
+				<tr style="font-weight:bold;background:#fff8c5;"><td style="padding:12px 0;">{{T "email.total_score"}}</td><td style="padding:12px 0;text-align:right;">{{printf "%.2f" .TotalScore}}</td></tr>
+			</table>
+		</div>
+{{end}}
+	</div>
+` + emailPageEnd,
+
+	"email/digest.subject": `{{T "email.digest_subject" (date .Now "January 2, 2006") (len .Issues)}}`,
+
+	"email/digest.text": `{{T "email.digest_text" (date .Now "January 2, 2006")}}
+
+{{T "email.digest_count" (len .Issues)}}
+{{with goodFirst .Issues}}
+{{T "email.digest_gfi"}}:
+{{range first . 10}}- [{{printf "%.2f" .Score}}] {{.Title}}
+  {{.Project.Org}}/{{.Project.Name}} • {{.URL}}
+{{with linksFor .URL}}  {{indent .TextLine "  "}}
+{{end}}
+{{end}}{{with more . 10}}{{T "email.digest_more_gfi" .}}
+{{end}}{{end}}{{with notGoodFirst .Issues}}
+{{T "email.digest_other"}}:
+{{range first . 5}}- [{{printf "%.2f" .Score}}] {{.Title}}
+  {{.Project.Org}}/{{.Project.Name}} • {{.URL}}
+{{with linksFor .URL}}  {{indent .TextLine "  "}}
+{{end}}
+{{end}}{{with more . 5}}{{T "email.digest_more" .}}
+{{end}}{{end}}`,
+
+	"email/digest.html": emailPageStart + `
+	<div style="background:linear-gradient(135deg,#667eea 0%,#764ba2 100%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
+		<h1 style="color:#fff;margin:0;font-size:24px;">{{T "email.digest_text" (date .Now "January 2, 2006")}}</h1>
+		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">{{T "email.digest_count" (len .Issues)}}</p>
+	</div>
 
-Labels: %s
+	<div style="background:#fff;border:1px solid #e1e4e8;border-top:none;padding:24px;border-radius:0 0 12px 12px;">
+{{with goodFirst .Issues}}
+		<h2 style="color:#28a745;margin-top:0;">{{T "email.digest_gfi"}}</h2>
+{{range first . 10}}
+		<div style="border:1px solid #e1e4e8;border-radius:8px;padding:16px;margin:12px 0;">
+			<h3 style="margin:0 0 8px;color:#0366d6;"><a href="{{.URL}}" style="color:#0366d6;text-decoration:none;">{{.Title}}</a></h3>
+			<p style="margin:0;color:#586069;font-size:14px;">
+				<span style="background:#28a745;color:#fff;padding:2px 8px;border-radius:4px;">{{printf "%.2f" .Score}}</span>
+				{{.Project.Org}}/{{.Project.Name}} • {{T "email.comments_count" .Comments}}
+			</p>
+			{{htmlLinks (linksFor .URL)}}
+		</div>
+{{end}}{{with more . 10}}
+		<p style="color:#586069;">{{T "email.digest_more_gfi" .}}</p>
+{{end}}{{end}}{{with notGoodFirst .Issues}}
+		<h2 style="color:#0366d6;margin-top:24px;">{{T "email.digest_other"}}</h2>
+{{range first . 5}}
+		<div style="border:1px solid #e1e4e8;border-radius:8px;padding:16px;margin:12px 0;">
+			<h3 style="margin:0 0 8px;color:#0366d6;"><a href="{{.URL}}" style="color:#0366d6;text-decoration:none;">{{.Title}}</a></h3>
+			<p style="margin:0;color:#586069;font-size:14px;">
+				<span style="background:#0366d6;color:#fff;padding:2px 8px;border-radius:4px;">{{printf "%.2f" .Score}}</span>
+				{{.Project.Org}}/{{.Project.Name}} • {{T "email.comments_count" .Comments}}
+			</p>
+			{{htmlLinks (linksFor .URL)}}
+		</div>
+{{end}}{{with more . 5}}
+		<p style="color:#586069;">{{T "email.digest_more" .}}</p>
+{{end}}{{end}}
+	</div>
+` + emailPageEnd,
 
----
-GitHub Issue Finder • %s
-	`, issue.Title, issue.Score, issue.Project.Org, issue.Project.Name,
-		issue.Project.Stars, issue.Project.Category, issue.Comments,
-		issue.CreatedAt.Format("2006-01-02"), issue.URL,
-		strings.Join(issue.Labels, ", "), time.Now().Format("2006-01-02"))
-
-	return &EmailTemplate{
-		Subject:  fmt.Sprintf("%s [%.2f] %s", scoreEmoji, issue.Score, truncateString(issue.Title, 50)),
-		HTMLBody: htmlBody,
-		TextBody: textBody,
-	}
-}
+	"email/assignment_confirmed.subject": `✅ Assignment Confirmed: {{truncate .Issue.Title 50}}`,
 
-func DigestEmailTemplate(issues []Issue) *EmailTemplate {
-	date := time.Now().Format("January 2, 2006")
+	"email/assignment_confirmed.text": `
+Assignment Confirmed!
 
-	goodFirstIssues := []Issue{}
-	otherIssues := []Issue{}
+You've been assigned to: {{.Issue.Title}}
 
-	for _, issue := range issues {
-		if issue.IsGoodFirst {
-			goodFirstIssues = append(goodFirstIssues, issue)
-		} else {
-			otherIssues = append(otherIssues, issue)
-		}
-	}
+Project: {{.Issue.Project.Org}}/{{.Issue.Project.Name}}
+URL: {{.Issue.URL}}
 
-	var issuesHTML strings.Builder
-	issuesHTML.WriteString(`
-	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder</p>
-	</div>
-</body>
-</html>
-	`)
-
-	if len(goodFirstIssues) > 0 {
-		issuesHTML.WriteString(`<h2 style="color:#28a745;margin-top:0;">🔥 Good First Issues</h2>`)
-		for i, issue := range goodFirstIssues {
-			if i >= 10 {
-				issuesHTML.WriteString(fmt.Sprintf(`<p style="color:#586069;">... and %d more good first issues</p>`, len(goodFirstIssues)-10))
-				break
-			}
-			issuesHTML.WriteString(fmt.Sprintf(`
-			<div style="border:1px solid #e1e4e8;border-radius:8px;padding:16px;margin:12px 0;">
-				<h3 style="margin:0 0 8px;color:#0366d6;"><a href="%s" style="color:#0366d6;text-decoration:none;">%s</a></h3>
-				<p style="margin:0;color:#586069;font-size:14px;">
-					<span style="background:#28a745;color:#fff;padding:2px 8px;border-radius:4px;">%.2f</span>
-					%s/%s • %d comments
-				</p>
-			</div>
-			`, issue.URL, issue.Title, issue.Score, issue.Project.Org, issue.Project.Name, issue.Comments))
-		}
-	}
-
-	if len(otherIssues) > 0 {
-		issuesHTML.WriteString(`<h2 style="color:#0366d6;margin-top:24px;">📋 Other Opportunities</h2>`)
-		for i, issue := range otherIssues {
-			if i >= 5 {
-				issuesHTML.WriteString(fmt.Sprintf(`<p style="color:#586069;">... and %d more issues</p>`, len(otherIssues)-5))
-				break
-			}
-			issuesHTML.WriteString(fmt.Sprintf(`
-			<div style="border:1px solid #e1e4e8;border-radius:8px;padding:16px;margin:12px 0;">
-				<h3 style="margin:0 0 8px;color:#0366d6;"><a href="%s" style="color:#0366d6;text-decoration:none;">%s</a></h3>
-				<p style="margin:0;color:#586069;font-size:14px;">
-					<span style="background:#0366d6;color:#fff;padding:2px 8px;border-radius:4px;">%.2f</span>
-					%s/%s • %d comments
-				</p>
-			</div>
-			`, issue.URL, issue.Title, issue.Score, issue.Project.Org, issue.Project.Name, issue.Comments))
-		}
-	}
-
-	issuesHTML.WriteString(`
-	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder</p>
-	</div>
-</body>
-</html>
-	`)
-
-	var textBody strings.Builder
-	textBody.WriteString(fmt.Sprintf("Daily Issue Digest - %s\n\n%d issues found\n\n", date, len(issues)))
-
-	if len(goodFirstIssues) > 0 {
-		textBody.WriteString("🔥 Good First Issues:\n")
-		for i, issue := range goodFirstIssues {
-			if i >= 10 {
-				textBody.WriteString(fmt.Sprintf("... and %d more\n", len(goodFirstIssues)-10))
-				break
-			}
-			textBody.WriteString(fmt.Sprintf("- [%.2f] %s\n  %s/%s • %s\n\n", issue.Score, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL))
-		}
-	}
-
-	if len(otherIssues) > 0 {
-		textBody.WriteString("\n📋 Other Opportunities:\n")
-		for i, issue := range otherIssues {
-			if i >= 5 {
-				textBody.WriteString(fmt.Sprintf("... and %d more\n", len(otherIssues)-5))
-				break
-			}
-			textBody.WriteString(fmt.Sprintf("- [%.2f] %s\n  %s/%s • %s\n\n", issue.Score, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL))
-		}
-	}
-
-	return &EmailTemplate{
-		Subject:  fmt.Sprintf("📰 Daily Issue Digest - %s (%d issues)", date, len(issues)),
-		HTMLBody: issuesHTML.String(),
-		TextBody: textBody.String(),
-	}
-}
+Next steps:
+1. Clone the repository
+2. Create a branch for your changes
+3. Make your contributions
+4. Submit a pull request
+` + emailTextFooter,
 
-func AssignmentConfirmationTemplate(issue Issue) *EmailTemplate {
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-	<meta charset="UTF-8">
-</head>
-<body style="font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;font-size:16px;line-height:1.5;color:#24292e;max-width:600px;margin:0 auto;padding:20px;">
-	<div style="background:linear-gradient(135deg,#28a745 0%%,#20863c 100%%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
+	"email/assignment_confirmed.html": emailPageStart + `
+	<div style="background:linear-gradient(135deg,#28a745 0%,#20863c 100%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
 		<h1 style="color:#fff;margin:0;font-size:24px;">✅ Assignment Confirmed!</h1>
 		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">You've been assigned to this issue</p>
 	</div>
-	
+
 	<div style="background:#fff;border:1px solid #e1e4e8;border-top:none;padding:24px;border-radius:0 0 12px 12px;">
-		<h2 style="margin-top:0;color:#0366d6;">%s</h2>
-		
+		<h2 style="margin-top:0;color:#0366d6;">{{.Issue.Title}}</h2>
+
 		<div style="margin:16px 0;">
-			<span style="margin-left:12px;color:#586069;">%s/%s</span>
+			<span style="margin-left:12px;color:#586069;">{{.Issue.Project.Org}}/{{.Issue.Project.Name}}</span>
 		</div>
-		
-		<a href="%s" style="display:inline-block;background:#28a745;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">Start Working →</a>
-		
+
+		<a href="{{.Issue.URL}}" style="display:inline-block;background:#28a745;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">Start Working →</a>
+
 		<div style="margin-top:24px;padding:16px;background:#f6f8fa;border-radius:8px;">
 			<p style="margin:0;color:#586069;font-size:14px;">
 				<strong>Next steps:</strong><br>
@@ -283,208 +244,106 @@ func AssignmentConfirmationTemplate(issue Issue) *EmailTemplate {
 			</p>
 		</div>
 	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder • %s</p>
-	</div>
-</body>
-</html>
-	`, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL, time.Now().Format("2006-01-02"))
+` + emailPageEnd,
 
-	textBody := fmt.Sprintf(`
-Assignment Confirmed!
+	"email/assignment_request.subject": `📤 Assignment Requested: {{truncate .Issue.Title 50}}`,
 
-You've been assigned to: %s
+	"email/assignment_request.text": `
+Assignment Request Sent
 
-Project: %s/%s
-URL: %s
+Issue: {{.Issue.Title}}
+Project: {{.Issue.Project.Org}}/{{.Issue.Project.Name}}
+URL: {{.Issue.URL}}
 
-Next steps:
-1. Clone the repository
-2. Create a branch for your changes
-3. Make your contributions
-4. Submit a pull request
-
----
-GitHub Issue Finder • %s
-	`, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL, time.Now().Format("2006-01-02"))
-
-	return &EmailTemplate{
-		Subject:  fmt.Sprintf("✅ Assignment Confirmed: %s", truncateString(issue.Title, 50)),
-		HTMLBody: htmlBody,
-		TextBody: textBody,
-	}
-}
+The maintainer will review your request. You'll receive another notification when the assignment is confirmed.
+` + emailTextFooter,
 
-func AssignmentRequestTemplate(issue Issue) *EmailTemplate {
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-	<meta charset="UTF-8">
-</head>
-<body style="font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;font-size:16px;line-height:1.5;color:#24292e;max-width:600px;margin:0 auto;padding:20px;">
-	<div style="background:linear-gradient(135deg,#f39c12 0%%,#e67e22 100%%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
+	"email/assignment_request.html": emailPageStart + `
+	<div style="background:linear-gradient(135deg,#f39c12 0%,#e67e22 100%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
 		<h1 style="color:#fff;margin:0;font-size:24px;">📤 Assignment Request Sent</h1>
 		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">Waiting for maintainer approval</p>
 	</div>
-	
+
 	<div style="background:#fff;border:1px solid #e1e4e8;border-top:none;padding:24px;border-radius:0 0 12px 12px;">
-		<h2 style="margin-top:0;color:#0366d6;">%s</h2>
-		
+		<h2 style="margin-top:0;color:#0366d6;">{{.Issue.Title}}</h2>
+
 		<div style="margin:16px 0;">
-			<span style="margin-left:12px;color:#586069;">%s/%s</span>
+			<span style="margin-left:12px;color:#586069;">{{.Issue.Project.Org}}/{{.Issue.Project.Name}}</span>
 		</div>
-		
-		<a href="%s" style="display:inline-block;background:#f39c12;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">View Issue →</a>
-		
+
+		<a href="{{.Issue.URL}}" style="display:inline-block;background:#f39c12;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-top:16px;">View Issue →</a>
+
 		<div style="margin-top:24px;padding:16px;background:#fff8e1;border-radius:8px;border-left:4px solid #f39c12;">
 			<p style="margin:0;color:#586069;font-size:14px;">
 				<strong>Note:</strong> The maintainer will review your request. You'll receive another notification when the assignment is confirmed.
 			</p>
 		</div>
 	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder • %s</p>
-	</div>
-</body>
-</html>
-	`, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL, time.Now().Format("2006-01-02"))
+` + emailPageEnd,
 
-	textBody := fmt.Sprintf(`
-Assignment Request Sent
+	"email/qualified.subject": `{{if ge .Qualified.QualifiedScore.TotalScore 0.8}}🔥{{else}}⭐{{end}} [{{printf "%.2f" .Qualified.QualifiedScore.TotalScore}}] {{truncate .Qualified.Title 50}}`,
 
-Issue: %s
-Project: %s/%s
-URL: %s
+	"email/qualified.text": `
+Qualified Issue Found!
 
-The maintainer will review your request. You'll receive another notification when the assignment is confirmed.
+{{.Qualified.Title}} (Score: {{printf "%.2f" .Qualified.QualifiedScore.TotalScore}})
+Type: {{title (print .Qualified.Type)}}
 
----
-GitHub Issue Finder • %s
-	`, issue.Title, issue.Project.Org, issue.Project.Name, issue.URL, time.Now().Format("2006-01-02"))
-
-	return &EmailTemplate{
-		Subject:  fmt.Sprintf("📤 Assignment Requested: %s", truncateString(issue.Title, 50)),
-		HTMLBody: htmlBody,
-		TextBody: textBody,
-	}
-}
+Project: {{.Qualified.Project.Org}}/{{.Qualified.Project.Name}} ({{stars .Qualified.Project.Stars}} stars)
+Category: {{.Qualified.Project.Category}}
+Comments: {{.Qualified.Comments}}
 
-func QualifiedIssueEmailTemplate(issue QualifiedIssue) *EmailTemplate {
-	scoreEmoji := "⭐"
-	if issue.QualifiedScore.TotalScore >= 0.8 {
-		scoreEmoji = "🔥"
-	}
-
-	var labelsHTML strings.Builder
-	relevantLabels := filterRelevantLabels(issue.Labels)
-	for _, label := range relevantLabels {
-		labelsHTML.WriteString(fmt.Sprintf(`<span style="background:#e1e4e8;padding:2px 8px;border-radius:12px;font-size:12px;margin-right:4px;">%s</span>`, label))
-	}
-
-	whyGoodHTML := ""
-	whyGood := issue.GenerateWhyGood()
-	if len(whyGood) > 0 {
-		whyGoodHTML = `<div style="margin-top:16px;padding:16px;background:#f0fff4;border-radius:8px;border-left:4px solid #28a745;"><h4 style="margin:0 0 8px;color:#28a745;">Why it's a good fit:</h4><ul style="margin:0;padding-left:20px;">`
-		for _, reason := range whyGood {
-			whyGoodHTML += fmt.Sprintf(`<li style="color:#24292e;margin:4px 0;">%s</li>`, reason)
-		}
-		whyGoodHTML += `</ul></div>`
-	}
-
-	cloneURL := fmt.Sprintf("https://github.com/%s/%s.git", issue.Project.Org, issue.Project.Name)
-
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-	<meta charset="UTF-8">
-</head>
-<body style="font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;font-size:16px;line-height:1.5;color:#24292e;max-width:600px;margin:0 auto;padding:20px;">
-	<div style="background:linear-gradient(135deg,#667eea 0%%,#764ba2 100%%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
-		<h1 style="color:#fff;margin:0;font-size:24px;">%s Qualified Issue Found</h1>
+URL: {{.Qualified.URL}}
+
+Why it's good:
+{{range .Qualified.GenerateWhyGood}}- {{.}}
+{{end}}
+Quick Clone: git clone https://github.com/{{.Qualified.Project.Org}}/{{.Qualified.Project.Name}}.git
+` + emailTextFooter,
+
+	"email/qualified.html": emailPageStart + `
+	<div style="background:linear-gradient(135deg,#667eea 0%,#764ba2 100%);padding:30px;border-radius:12px 12px 0 0;text-align:center;">
+		<h1 style="color:#fff;margin:0;font-size:24px;">{{if ge .Qualified.QualifiedScore.TotalScore 0.8}}🔥{{else}}⭐{{end}} Qualified Issue Found</h1>
 		<p style="color:rgba(255,255,255,0.9);margin:10px 0 0;">A resume-worthy opportunity!</p>
 	</div>
-	
+
 	<div style="background:#fff;border:1px solid #e1e4e8;border-top:none;padding:24px;border-radius:0 0 12px 12px;">
-		<h2 style="margin-top:0;color:#0366d6;font-size:20px;">%s</h2>
-		
+		<h2 style="margin-top:0;color:#0366d6;font-size:20px;">{{.Qualified.Title}}</h2>
+
 		<div style="margin:16px 0;">
-			<span style="display:inline-block;background:#28a745;color:#fff;padding:4px 12px;border-radius:4px;font-weight:bold;">Score: %.2f</span>
-			<span style="display:inline-block;background:#0366d6;color:#fff;padding:4px 12px;border-radius:4px;margin-left:8px;">%s</span>
-			<span style="margin-left:12px;color:#586069;">%s/%s (%s ⭐)</span>
+			<span style="display:inline-block;background:#28a745;color:#fff;padding:4px 12px;border-radius:4px;font-weight:bold;">Score: {{printf "%.2f" .Qualified.QualifiedScore.TotalScore}}</span>
+			<span style="display:inline-block;background:#0366d6;color:#fff;padding:4px 12px;border-radius:4px;margin-left:8px;">{{title (print .Qualified.Type)}}</span>
+			<span style="margin-left:12px;color:#586069;">{{.Qualified.Project.Org}}/{{.Qualified.Project.Name}} ({{stars .Qualified.Project.Stars}} ⭐)</span>
 		</div>
-		
-		<table style="width:100%%;margin:16px 0;">
+
+		<table style="width:100%;margin:16px 0;">
 			<tr>
 				<td style="color:#586069;padding:8px 0;width:120px;">Type:</td>
-				<td style="padding:8px 0;">%s</td>
+				<td style="padding:8px 0;">{{title (print .Qualified.Type)}}</td>
 			</tr>
 			<tr>
 				<td style="color:#586069;padding:8px 0;">Category:</td>
-				<td style="padding:8px 0;">%s</td>
+				<td style="padding:8px 0;">{{.Qualified.Project.Category}}</td>
 			</tr>
 			<tr>
 				<td style="color:#586069;padding:8px 0;">Comments:</td>
-				<td style="padding:8px 0;">%d</td>
+				<td style="padding:8px 0;">{{.Qualified.Comments}}</td>
 			</tr>
 		</table>
-		
-		<div style="margin:16px 0;">%s</div>
-		
+
+		<div style="margin:16px 0;">{{range relevantLabels .Qualified.Labels}}<span style="background:#e1e4e8;padding:2px 8px;border-radius:12px;font-size:12px;margin-right:4px;">{{.}}</span>{{end}}</div>
+
 		<div style="margin:16px 0;">
-			<a href="%s" style="display:inline-block;background:#0366d6;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-right:8px;">Open Issue →</a>
-			<a href="https://github.com/%s/%s" style="display:inline-block;background:#24292e;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;">View Repo</a>
+			<a href="{{.Qualified.URL}}" style="display:inline-block;background:#0366d6;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;margin-right:8px;">Open Issue →</a>
+			<a href="https://github.com/{{.Qualified.Project.Org}}/{{.Qualified.Project.Name}}" style="display:inline-block;background:#24292e;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:bold;">View Repo</a>
 		</div>
-		
-		%s
-		
+{{with .Qualified.GenerateWhyGood}}
+		<div style="margin-top:16px;padding:16px;background:#f0fff4;border-radius:8px;border-left:4px solid #28a745;"><h4 style="margin:0 0 8px;color:#28a745;">Why it's a good fit:</h4><ul style="margin:0;padding-left:20px;">{{range .}}<li style="color:#24292e;margin:4px 0;">{{.}}</li>{{end}}</ul></div>
+{{end}}
 		<div style="margin-top:24px;padding:16px;background:#f6f8fa;border-radius:8px;">
 			<p style="margin:0 0 8px;color:#586069;font-size:14px;"><strong>Quick Clone:</strong></p>
-			<code style="display:block;background:#24292e;color:#fff;padding:12px;border-radius:4px;font-size:13px;overflow-x:auto;">git clone %s</code>
+			<code style="display:block;background:#24292e;color:#fff;padding:12px;border-radius:4px;font-size:13px;overflow-x:auto;">git clone https://github.com/{{.Qualified.Project.Org}}/{{.Qualified.Project.Name}}.git</code>
 		</div>
 	</div>
-	
-	<div style="text-align:center;padding:20px;color:#586069;font-size:14px;">
-		<p>GitHub Issue Finder • %s</p>
-	</div>
-</body>
-</html>
-	`, scoreEmoji, issue.Title, issue.QualifiedScore.TotalScore, strings.Title(string(issue.Type)),
-		issue.Project.Org, issue.Project.Name, formatStars(issue.Project.Stars),
-		strings.Title(string(issue.Type)), issue.Project.Category, issue.Comments,
-		labelsHTML.String(), issue.URL, issue.Project.Org, issue.Project.Name,
-		whyGoodHTML, cloneURL, time.Now().Format("2006"))
-
-	textBody := fmt.Sprintf(`
-Qualified Issue Found!
-
-%s (Score: %.2f)
-Type: %s
-
-Project: %s/%s (%s stars)
-Category: %s
-Comments: %d
-
-URL: %s
-
-Why it's good:
-%s
-
-Quick Clone: git clone %s
-
----
-GitHub Issue Finder • %s
-	`, issue.Title, issue.QualifiedScore.TotalScore, strings.Title(string(issue.Type)),
-		issue.Project.Org, issue.Project.Name, formatStars(issue.Project.Stars),
-		issue.Project.Category, issue.Comments, issue.URL,
-		strings.Join(whyGood, "\n- "), cloneURL, time.Now().Format("2006-01-02"))
-
-	return &EmailTemplate{
-		Subject:  fmt.Sprintf("%s [%.2f] %s", scoreEmoji, issue.QualifiedScore.TotalScore, truncateString(issue.Title, 50)),
-		HTMLBody: htmlBody,
-		TextBody: textBody,
-	}
+` + emailPageEnd,
 }
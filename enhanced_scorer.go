@@ -33,6 +33,8 @@ func NewEnhancedScorerWithConfig(config *ScoringConfig) *EnhancedScorer {
 			ContributorFriendlyBonus: 0.15,
 			WeekendBonus:             0.05,
 			MaxScore:                 1.5,
+			LongThreadMinutes:        10,
+			LongThreadPenalty:        0,
 		}
 	}
 
@@ -61,8 +63,12 @@ func (s *EnhancedScorer) ScoreIssueEnhanced(issue *github.Issue, project Project
 	return s.ScoreIssueWithBreakdown(issue, project, repoActivity).TotalScore
 }
 
+// ScoreIssueWithBreakdown scores an issue by component. Keyword hits in the
+// title and body are pooled across components and capped per category with
+// diminishing returns, as in IssueScorer.
 func (s *EnhancedScorer) ScoreIssueWithBreakdown(issue *github.Issue, project Project, repoActivity *RepoActivityInfo) *ScoreBreakdown {
 	breakdown := &ScoreBreakdown{}
+	var signals scoreSignals
 
 	baseScorer := NewIssueScorer()
 	breakdown.StarsScore = baseScorer.normalizeStars(project.Stars) * s.weights["stars_factor"]
@@ -70,19 +76,24 @@ func (s *EnhancedScorer) ScoreIssueWithBreakdown(issue *github.Issue, project Pr
 	breakdown.RecencyScore = baseScorer.normalizeRecency(issue.CreatedAt.Time) * s.weights["recency_factor"]
 	breakdown.LabelsScore = baseScorer.normalizeLabels(issue.Labels) * s.weights["labels_factor"]
 	breakdown.DifficultyScore = baseScorer.normalizeDifficulty(issue.Labels, safeString(issue.Body)) * s.weights["difficulty_factor"]
-	breakdown.DescriptionScore = s.scoreDescriptionQuality(issue) * s.weights["description_factor"]
+	breakdown.DescriptionScore = s.scoreDescriptionQuality(issue, &signals) * s.weights["description_factor"]
 
 	if repoActivity != nil {
 		breakdown.ActivityScore = s.scoreProjectActivity(repoActivity) * s.weights["activity_factor"]
 		breakdown.MaintainerScore = s.scoreMaintainerResponsiveness(repoActivity) * s.weights["maintainer_factor"]
 	}
 
-	breakdown.BonusScore = s.applyBonusModifiers(issue, project)
+	breakdown.BonusScore = s.applyBonusModifiers(issue, project, &signals)
+	breakdown.KeywordScore = signals.keywordScore(s.config)
 	penalty := s.calculatePenaltyModifiers(issue)
 
-	breakdown.TotalScore = breakdown.StarsScore + breakdown.CommentsScore + breakdown.RecencyScore +
+	signals.base = breakdown.StarsScore + breakdown.CommentsScore + breakdown.RecencyScore +
 		breakdown.LabelsScore + breakdown.DifficultyScore + breakdown.DescriptionScore +
-		breakdown.ActivityScore + breakdown.MaintainerScore + breakdown.BonusScore - penalty
+		breakdown.ActivityScore + breakdown.MaintainerScore
+	signals.strong = breakdown.BonusScore
+	breakdown.Confidence = signals.confidence(breakdown.KeywordScore)
+
+	breakdown.TotalScore = signals.base + breakdown.BonusScore + breakdown.KeywordScore - penalty
 
 	breakdown.TotalScore = s.clampScore(breakdown.TotalScore)
 
@@ -99,7 +110,9 @@ func (s *EnhancedScorer) clampScore(score float64) float64 {
 	return score
 }
 
-func (s *EnhancedScorer) scoreDescriptionQuality(issue *github.Issue) float64 {
+// scoreDescriptionQuality rates the body's structure. Keyword hits go to
+// signals at the description weight, so they share the keyword caps.
+func (s *EnhancedScorer) scoreDescriptionQuality(issue *github.Issue, signals *scoreSignals) float64 {
 	body := safeString(issue.Body)
 	title := safeString(issue.Title)
 
@@ -108,6 +121,9 @@ func (s *EnhancedScorer) scoreDescriptionQuality(issue *github.Issue) float64 {
 	}
 
 	score := 0.0
+	keyword := func(category string, v float64) {
+		signals.addKeyword(category, v*s.weights["description_factor"])
+	}
 
 	if len(body) >= 100 {
 		score += 0.2
@@ -125,14 +141,14 @@ func (s *EnhancedScorer) scoreDescriptionQuality(issue *github.Issue) float64 {
 	lowerBody := strings.ToLower(body)
 	for _, kw := range stepsKeywords {
 		if strings.Contains(lowerBody, kw) {
-			score += 0.1
+			keyword(KeywordClarity, 0.1)
 		}
 	}
 
 	acceptanceKeywords := []string{"acceptance criteria", "definition of done", "success criteria", "todo:", "checklist"}
 	for _, kw := range acceptanceKeywords {
 		if strings.Contains(lowerBody, kw) {
-			score += 0.15
+			keyword(KeywordClarity, 0.15)
 		}
 	}
 
@@ -144,11 +160,11 @@ func (s *EnhancedScorer) scoreDescriptionQuality(issue *github.Issue) float64 {
 		}
 	}
 	if scopeCount >= 2 {
-		score += 0.15
+		keyword(KeywordClarity, 0.15)
 	}
 
 	if strings.Contains(lowerBody, "good first issue") || strings.Contains(lowerBody, "beginner") {
-		score += s.config.ContributorFriendlyBonus
+		keyword(KeywordFriendliness, s.config.ContributorFriendlyBonus)
 	}
 
 	if score > 1.0 {
@@ -270,7 +286,7 @@ func (s *EnhancedScorer) scoreTimeFactors(issue *github.Issue) float64 {
 	return score
 }
 
-func (s *EnhancedScorer) scoreContributorFriendliness(issue *github.Issue, project Project) float64 {
+func (s *EnhancedScorer) scoreContributorFriendliness(issue *github.Issue, project Project, signals *scoreSignals) float64 {
 	score := 0.0
 	title := strings.ToLower(safeString(issue.Title))
 	body := strings.ToLower(safeString(issue.Body))
@@ -293,15 +309,12 @@ func (s *EnhancedScorer) scoreContributorFriendliness(issue *github.Issue, proje
 	}
 
 	if strings.Contains(combined, "beginner") || strings.Contains(combined, "newcomer") {
-		score += 0.05
+		signals.addKeyword(KeywordFriendliness, 0.05)
 	}
 
 	easyKeywords := []string{"quick", "easy", "simple", "trivial", "small", "minor", "typo", "spelling"}
-	for _, kw := range easyKeywords {
-		if strings.Contains(combined, kw) {
-			score += 0.05
-			break
-		}
+	if containsAny(combined, easyKeywords) {
+		signals.addKeyword(KeywordFriendliness, 0.05)
 	}
 
 	if len(issue.Assignees) == 0 {
@@ -311,7 +324,9 @@ func (s *EnhancedScorer) scoreContributorFriendliness(issue *github.Issue, proje
 	return score
 }
 
-func (s *EnhancedScorer) applyBonusModifiers(issue *github.Issue, project Project) float64 {
+// applyBonusModifiers sums the label and project bonuses. Text-only hits are
+// added to signals instead.
+func (s *EnhancedScorer) applyBonusModifiers(issue *github.Issue, project Project, signals *scoreSignals) float64 {
 	var bonus float64
 	title := strings.ToLower(safeString(issue.Title))
 	body := strings.ToLower(safeString(issue.Body))
@@ -337,9 +352,10 @@ func (s *EnhancedScorer) applyBonusModifiers(issue *github.Issue, project Projec
 		}
 	}
 
-	if strings.Contains(combined, "documentation") || strings.Contains(combined, "docs") ||
-		hasLabel(issue.Labels, "documentation") || strings.Contains(title, "doc:") {
+	if hasLabel(issue.Labels, "documentation") {
 		bonus += 0.15
+	} else if strings.Contains(combined, "documentation") || strings.Contains(combined, "docs") || strings.Contains(title, "doc:") {
+		signals.addKeyword(KeywordFriendliness, 0.15)
 	}
 
 	cncfProjects := []string{
@@ -362,15 +378,12 @@ func (s *EnhancedScorer) applyBonusModifiers(issue *github.Issue, project Projec
 
 	if strings.Contains(combined, "tls") || strings.Contains(combined, "ssl") ||
 		strings.Contains(combined, "certificate") || strings.Contains(combined, "https") {
-		bonus += 0.10
+		signals.addKeyword(KeywordTopic, 0.10)
 	}
 
 	easyKeywords := []string{"quick", "easy", "simple", "trivial", "small", "minor", "typo", "spelling"}
-	for _, kw := range easyKeywords {
-		if strings.Contains(combined, kw) {
-			bonus += 0.05
-			break
-		}
+	if containsAny(combined, easyKeywords) {
+		signals.addKeyword(KeywordFriendliness, 0.05)
 	}
 
 	age := time.Since(issue.CreatedAt.Time).Hours()
@@ -383,7 +396,7 @@ func (s *EnhancedScorer) applyBonusModifiers(issue *github.Issue, project Projec
 	}
 
 	bonus += s.scoreTimeFactors(issue)
-	bonus += s.scoreContributorFriendliness(issue, project)
+	bonus += s.scoreContributorFriendliness(issue, project, signals)
 
 	return bonus
 }
@@ -447,6 +460,8 @@ func (s *EnhancedScorer) calculatePenaltyModifiers(issue *github.Issue) float64
 		penalty += 0.25
 	}
 
+	penalty += readingTimePenalty(EstimateReadingTime(issue), s.config)
+
 	return penalty
 }
 
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+// EpicExpansionConfig controls how umbrella issues are replaced by the
+// sub-issues their task lists link to.
+type EpicExpansionConfig struct {
+	Enabled      bool
+	MinSubIssues int // task-list references needed to treat an issue as an epic
+	MaxSubIssues int // open sub-issues fetched per epic
+}
+
+// TaskListRef is an issue referenced from a task-list item.
+type TaskListRef struct {
+	Owner  string
+	Repo   string
+	Number int
+	Done   bool
+}
+
+var (
+	taskListItemPattern = regexp.MustCompile(`(?m)^\s*[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	issueURLRefPattern  = regexp.MustCompile(`https://github\.com/([\w.-]+)/([\w.-]+)/issues/(\d+)`)
+	crossRepoRefPattern = regexp.MustCompile(`\b([\w.-]+)/([\w.-]+)#(\d+)\b`)
+	localRefPattern     = regexp.MustCompile(`(?:^|[^\w/])#(\d+)\b`)
+)
+
+func loadEpicExpansionConfigFromEnv() *EpicExpansionConfig {
+	config := &EpicExpansionConfig{
+		Enabled:      getEnvBool("EPIC_EXPANSION_ENABLED", true),
+		MinSubIssues: 2,
+		MaxSubIssues: 10,
+	}
+
+	if n := getEnvInt("EPIC_MIN_SUB_ISSUES", 2); n > 0 {
+		config.MinSubIssues = n
+	}
+	if n := getEnvInt("EPIC_MAX_SUB_ISSUES", 10); n > 0 {
+		config.MaxSubIssues = n
+	}
+
+	return config
+}
+
+// ParseTaskListRefs returns the issues referenced by task-list items, one per
+// item, in the order they appear. Bare #N references resolve to owner/repo.
+func ParseTaskListRefs(body, owner, repo string) []TaskListRef {
+	var refs []TaskListRef
+	seen := make(map[string]bool)
+
+	for _, item := range taskListItemPattern.FindAllStringSubmatch(body, -1) {
+		ref, ok := parseIssueRef(item[2], owner, repo)
+		if !ok {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s#%d", strings.ToLower(ref.Owner), strings.ToLower(ref.Repo), ref.Number)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		ref.Done = item[1] != " "
+		refs = append(refs, ref)
+	}
+
+	return refs
+}
+
+func parseIssueRef(text, owner, repo string) (TaskListRef, bool) {
+	if m := issueURLRefPattern.FindStringSubmatch(text); m != nil {
+		n, _ := strconv.Atoi(m[3])
+		return TaskListRef{Owner: m[1], Repo: m[2], Number: n}, true
+	}
+	if m := crossRepoRefPattern.FindStringSubmatch(text); m != nil {
+		n, _ := strconv.Atoi(m[3])
+		return TaskListRef{Owner: m[1], Repo: m[2], Number: n}, true
+	}
+	if m := localRefPattern.FindStringSubmatch(text); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return TaskListRef{Owner: owner, Repo: repo, Number: n}, true
+	}
+	return TaskListRef{}, false
+}
+
+// SubIssues reports whether body belongs to an epic and, if so, the numbers
+// of its unchecked sub-issues in owner/repo. Sub-issues in other repositories
+// are left out because they would need that repository's project settings
+// to be scored.
+func (c *EpicExpansionConfig) SubIssues(body, owner, repo string) (bool, []int) {
+	if c == nil || !c.Enabled {
+		return false, nil
+	}
+
+	refs := ParseTaskListRefs(body, owner, repo)
+	if len(refs) < c.MinSubIssues {
+		return false, nil
+	}
+
+	var numbers []int
+	for _, ref := range refs {
+		if ref.Done || !strings.EqualFold(ref.Owner, owner) || !strings.EqualFold(ref.Repo, repo) {
+			continue
+		}
+		numbers = append(numbers, ref.Number)
+		if len(numbers) >= c.MaxSubIssues {
+			break
+		}
+	}
+	return true, numbers
+}
+
+// epicExpandedSince reports whether the epic was expanded after its last
+// update. Its sub-issues were fetched then, so a run can skip the extra calls
+// until the epic's task list changes.
+func epicExpandedSince(db *sqlx.DB, issueID string, updatedAt time.Time) bool {
+	if db == nil {
+		return false
+	}
+	var rejectedAt time.Time
+	err := db.Get(&rejectedAt, `SELECT rejected_at FROM rejected_issues WHERE issue_id = $1 AND stage = $2`, issueID, RejectionStageEpic)
+	return err == nil && !rejectedAt.Before(updatedAt)
+}
+
+// subIssuesToFetch drops sub-issues that were already listed this run or
+// seen before, so only new ones cost a GetIssue call.
+func (f *IssueFinder) subIssuesToFetch(p Project, numbers []int, listed map[int]bool) []int {
+	var fresh []int
+	for _, number := range numbers {
+		if listed[number] || f.isIssueSeen(fmt.Sprintf("%s/%d", p.Name, number), "") {
+			continue
+		}
+		listed[number] = true
+		fresh = append(fresh, number)
+	}
+	return fresh
+}
+
+// fetchSubIssues loads the given issues from a project, skipping any that
+// fail to load.
+func (f *IssueFinder) fetchSubIssues(ctx context.Context, p Project, numbers []int) []*github.Issue {
+	var subIssues []*github.Issue
+	for _, number := range numbers {
+		var issue *github.Issue
+		err := f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("fetch sub-issue %s/%s#%d", p.Org, p.Name, number), func() (*github.Response, error) {
+			var apiErr error
+			var resp *github.Response
+			issue, resp, apiErr = f.client.GetIssue(ctx, p.Org, p.Name, number)
+			return resp, apiErr
+		})
+		if err != nil {
+			log.Printf("Error fetching sub-issue %s/%s#%d: %v", p.Org, p.Name, number, err)
+			continue
+		}
+		subIssues = append(subIssues, issue)
+	}
+	return subIssues
+}
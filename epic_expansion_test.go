@@ -0,0 +1,67 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTaskListRefs(t *testing.T) {
+	body := "## Tasks\n" +
+		"- [ ] #101\n" +
+		"- [x] Refactor loader (#102)\n" +
+		"* [ ] https://github.com/kubernetes/kubectl/issues/55\n" +
+		"- [ ] kubernetes/kubernetes#103 follow-up\n" +
+		"- [ ] #101 duplicate\n" +
+		"- [ ] write docs\n" +
+		"- see #999 outside a task list\n"
+
+	got := ParseTaskListRefs(body, "kubernetes", "kubernetes")
+	want := []TaskListRef{
+		{Owner: "kubernetes", Repo: "kubernetes", Number: 101},
+		{Owner: "kubernetes", Repo: "kubernetes", Number: 102, Done: true},
+		{Owner: "kubernetes", Repo: "kubectl", Number: 55},
+		{Owner: "kubernetes", Repo: "kubernetes", Number: 103},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTaskListRefs() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEpicSubIssues(t *testing.T) {
+	config := &EpicExpansionConfig{Enabled: true, MinSubIssues: 2, MaxSubIssues: 2}
+	body := "- [x] #1\n- [ ] #2\n- [ ] other/repo#3\n- [ ] #4\n- [ ] #5\n"
+
+	isEpic, numbers := config.SubIssues(body, "o", "r")
+	if !isEpic {
+		t.Fatal("expected an epic")
+	}
+	if !reflect.DeepEqual(numbers, []int{2, 4}) {
+		t.Errorf("sub-issues = %v, want [2 4] (open, same repo, capped)", numbers)
+	}
+
+	if isEpic, _ := config.SubIssues("- [ ] #7\nsingle reference", "o", "r"); isEpic {
+		t.Error("a single reference should not make an epic")
+	}
+	if isEpic, _ := (&EpicExpansionConfig{Enabled: false, MinSubIssues: 2}).SubIssues(body, "o", "r"); isEpic {
+		t.Error("disabled expansion should not report epics")
+	}
+}
+
+func TestSubIssuesToFetch(t *testing.T) {
+	f := &IssueFinder{
+		seenIssues: map[string]bool{"kubernetes/11": true},
+		seenNodes:  map[string]bool{},
+	}
+	listed := map[int]bool{12: true}
+
+	got := f.subIssuesToFetch(Project{Org: "kubernetes", Name: "kubernetes"}, []int{11, 12, 13, 14}, listed)
+	if len(got) != 2 || got[0] != 13 || got[1] != 14 {
+		t.Errorf("subIssuesToFetch() = %v, want [13 14]", got)
+	}
+	if !listed[13] || !listed[14] {
+		t.Error("fetched sub-issues should be marked as listed")
+	}
+	if again := f.subIssuesToFetch(Project{Org: "kubernetes", Name: "kubernetes"}, []int{13}, listed); len(again) != 0 {
+		t.Errorf("a second epic listing the same sub-issue should not fetch it again, got %v", again)
+	}
+}
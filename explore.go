@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	defaultExploreSampleSize = 10
+	defaultExploreDays       = 30
+)
+
+// scoreBand groups scores with the same cut-offs the notifier uses for
+// priorities, so a sample covers low-scored issues and not just the top.
+func scoreBand(score float64) string {
+	switch {
+	case score >= 0.8:
+		return "high"
+	case score >= 0.6:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// SampleIssues draws up to n issues spread across category/score-band
+// strata. Strata are visited round-robin in shuffled order, and within a
+// stratum issues from projects not yet in the sample are preferred so a single
+// large project cannot dominate it.
+func SampleIssues(issues []Issue, n int, rng *rand.Rand) []Issue {
+	if n <= 0 || len(issues) == 0 {
+		return nil
+	}
+
+	byKey := make(map[string][]Issue)
+	for _, issue := range issues {
+		category := issue.Project.Category
+		if category == "" {
+			category = "Other"
+		}
+		key := category + "|" + scoreBand(issue.Score)
+		byKey[key] = append(byKey[key], issue)
+	}
+
+	// Map iteration order is random, so strata are sorted before shuffling
+	// to keep the sample a pure function of the seed.
+	keys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	rng.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+
+	strata := make([][]Issue, len(keys))
+	for i, key := range keys {
+		members := append([]Issue{}, byKey[key]...)
+		SortIssues(members)
+		rng.Shuffle(len(members), func(a, b int) { members[a], members[b] = members[b], members[a] })
+		strata[i] = members
+	}
+
+	sample := make([]Issue, 0, n)
+	usedProjects := make(map[string]bool)
+	for len(sample) < n {
+		picked := false
+		for i := range strata {
+			if len(sample) >= n {
+				break
+			}
+			if len(strata[i]) == 0 {
+				continue
+			}
+
+			idx := 0
+			for j, issue := range strata[i] {
+				if !usedProjects[issue.Project.Org+"/"+issue.Project.Name] {
+					idx = j
+					break
+				}
+			}
+
+			issue := strata[i][idx]
+			strata[i] = append(strata[i][:idx], strata[i][idx+1:]...)
+			usedProjects[issue.Project.Org+"/"+issue.Project.Name] = true
+			sample = append(sample, issue)
+			picked = true
+		}
+		if !picked {
+			break
+		}
+	}
+
+	return sample
+}
+
+// exploreRow is one issue_history row, the latest per URL.
+type exploreRow struct {
+	IssueID   string    `db:"issue_id"`
+	Title     string    `db:"issue_title"`
+	URL       string    `db:"issue_url"`
+	Project   string    `db:"project_name"`
+	Category  string    `db:"category"`
+	Score     float64   `db:"score"`
+	Comments  int       `db:"comments"`
+	Labels    []byte    `db:"labels"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// loadExploreCandidates reads the issues discovered since the given time from
+// issue_history. Explore samples these instead of running the pipeline, which
+// would mark new issues seen before the daemon could alert on them.
+func loadExploreCandidates(db *sqlx.DB, projects []Project, since time.Time) ([]Issue, error) {
+	var rows []exploreRow
+	err := db.Select(&rows, `
+		SELECT DISTINCT ON (issue_url) issue_id, issue_title, issue_url, project_name, category, score, comments, labels, created_at
+		FROM issue_history
+		WHERE discovered_at >= $1
+		ORDER BY issue_url, discovered_at DESC`, since)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]Project, len(projects))
+	for _, p := range projects {
+		byName[p.Name] = p
+	}
+
+	issues := make([]Issue, 0, len(rows))
+	for _, row := range rows {
+		project, ok := byName[row.Project]
+		if !ok {
+			project = Project{Name: row.Project}
+		}
+		project.Category = row.Category
+
+		issue := Issue{
+			Project:   project,
+			Title:     row.Title,
+			URL:       row.URL,
+			Score:     row.Score,
+			Comments:  row.Comments,
+			CreatedAt: row.CreatedAt,
+		}
+		if _, number, ok := strings.Cut(row.IssueID, "/"); ok {
+			issue.Number, _ = strconv.Atoi(number)
+		}
+		_ = json.Unmarshal(row.Labels, &issue.Labels)
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+func runExploreCommand(args []string) error {
+	size := defaultExploreSampleSize
+	days := defaultExploreDays
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--sample" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --sample value %q", args[i+1])
+			}
+			size = n
+			i++
+		case args[i] == "--days" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --days value %q", args[i+1])
+			}
+			days = n
+			i++
+		}
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	finder, ok := server.finder.(*IssueFinder)
+	if !ok {
+		return fmt.Errorf("explore needs the database-backed issue finder")
+	}
+
+	fmt.Fprintln(stdout, T("explore.searching"))
+	issues, err := loadExploreCandidates(server.db, finder.projects, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return err
+	}
+
+	sample := SampleIssues(issues, size, runRand())
+	if len(sample) == 0 {
+		fmt.Fprintln(stdout, T("explore.none", days))
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "\n%s\n", T("explore.title", len(sample), len(issues)))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+
+	table := NewTable(
+		TableColumn{Header: T("col.category")},
+		TableColumn{Header: T("col.band")},
+		TableColumn{Header: T("col.score"), Align: AlignRight},
+		TableColumn{Header: T("col.project")},
+		TableColumn{Header: T("col.title"), Flex: true},
+		TableColumn{Header: T("col.url")},
+	)
+	for _, issue := range sample {
+		table.AddCells(
+			TableCell{Text: issue.Project.Category},
+			TableCell{Text: scoreBand(issue.Score)},
+			TableCell{Text: fmt.Sprintf("%.2f", issue.Score), Color: scoreColor(issue.Score)},
+			TableCell{Text: issue.Project.Org + "/" + issue.Project.Name},
+			TableCell{Text: issue.Title},
+			TableCell{Text: issue.URL},
+		)
+	}
+	table.Render(stdout)
+
+	fmt.Fprintln(stdout, "\n"+T("explore.seed", runOptions.Seed, runOptions.Seed))
+	return nil
+}
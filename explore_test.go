@@ -0,0 +1,117 @@
+package main
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func sampleFixture() []Issue {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var issues []Issue
+	categories := []string{"Kubernetes", "Monitoring", "Security"}
+	scores := []float64{0.9, 0.7, 0.4}
+	for c, category := range categories {
+		for s, score := range scores {
+			for n := 0; n < 4; n++ {
+				issues = append(issues, Issue{
+					Title:     fmt.Sprintf("%s %d", category, n),
+					URL:       fmt.Sprintf("https://github.com/org%d/repo%d/issues/%d", c, n%2, s*10+n),
+					Number:    s*10 + n,
+					Score:     score,
+					CreatedAt: created,
+					Project:   Project{Org: fmt.Sprintf("org%d", c), Name: fmt.Sprintf("repo%d", n%2), Category: category},
+				})
+			}
+		}
+	}
+	return issues
+}
+
+func TestScoreBand(t *testing.T) {
+	tests := []struct {
+		score    float64
+		expected string
+	}{
+		{0.95, "high"},
+		{0.8, "high"},
+		{0.65, "medium"},
+		{0.3, "low"},
+	}
+
+	for _, tt := range tests {
+		if got := scoreBand(tt.score); got != tt.expected {
+			t.Errorf("scoreBand(%.2f) = %q, want %q", tt.score, got, tt.expected)
+		}
+	}
+}
+
+func TestSampleIssues_CoversStrata(t *testing.T) {
+	sample := SampleIssues(sampleFixture(), 9, rand.New(rand.NewSource(1)))
+	if len(sample) != 9 {
+		t.Fatalf("len(sample) = %d, want 9", len(sample))
+	}
+
+	strata := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, issue := range sample {
+		strata[issue.Project.Category+"|"+scoreBand(issue.Score)] = true
+		if seen[issue.URL] {
+			t.Errorf("issue %s sampled twice", issue.URL)
+		}
+		seen[issue.URL] = true
+	}
+	if len(strata) != 9 {
+		t.Errorf("sample covers %d strata, want all 9", len(strata))
+	}
+}
+
+func TestSampleIssues_Reproducible(t *testing.T) {
+	first := SampleIssues(sampleFixture(), 5, rand.New(rand.NewSource(7)))
+	second := SampleIssues(sampleFixture(), 5, rand.New(rand.NewSource(7)))
+
+	for i := range first {
+		if first[i].URL != second[i].URL {
+			t.Fatalf("position %d differs with the same seed: %s vs %s", i, first[i].URL, second[i].URL)
+		}
+	}
+}
+
+func TestSampleIssues_Limits(t *testing.T) {
+	issues := sampleFixture()
+	if got := SampleIssues(issues, 0, rand.New(rand.NewSource(1))); got != nil {
+		t.Errorf("SampleIssues(n=0) = %v, want nil", got)
+	}
+	if got := SampleIssues(issues, 100, rand.New(rand.NewSource(1))); len(got) != len(issues) {
+		t.Errorf("SampleIssues(n>len) returned %d issues, want %d", len(got), len(issues))
+	}
+}
+
+func TestLoadExploreCandidates(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	since := time.Date(2026, 9, 16, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2026, 9, 20, 0, 0, 0, 0, time.UTC)
+
+	fake.expect("FROM issue_history", since).returns(
+		[]string{"issue_id", "issue_title", "issue_url", "project_name", "category", "score", "comments", "labels", "created_at"},
+		[]driver.Value{"prometheus/42", "Flaky test", "https://github.com/prometheus/prometheus/issues/42", "prometheus", "Monitoring", 0.55, int64(3), []byte(`["bug","help wanted"]`), created},
+		[]driver.Value{"unwatched/7", "Typo", "https://github.com/someone/unwatched/issues/7", "unwatched", "Other", 0.4, int64(0), []byte(`null`), created},
+	)
+
+	issues, err := loadExploreCandidates(db, []Project{{Org: "prometheus", Name: "prometheus", Category: "Monitoring", Stars: 53000}}, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("got %d issues, want 2", len(issues))
+	}
+	got := issues[0]
+	if got.Number != 42 || got.Project.Org != "prometheus" || got.Project.Stars != 53000 || len(got.Labels) != 2 || got.Score != 0.55 {
+		t.Errorf("issue = %+v", got)
+	}
+	if issues[1].Project.Name != "unwatched" || issues[1].Project.Category != "Other" {
+		t.Errorf("unwatched project = %+v", issues[1].Project)
+	}
+}
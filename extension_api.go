@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExtensionAPIConfig struct {
+	Host           string
+	Port           int
+	Token          string
+	AllowedOrigins []string
+}
+
+type ExtensionIssueStatus struct {
+	URL            string   `json:"url"`
+	Title          string   `json:"title"`
+	State          string   `json:"state"`
+	Score          float64  `json:"score"`
+	Confidence     float64  `json:"confidence"`
+	Grade          string   `json:"grade"`
+	Labels         []string `json:"labels"`
+	Comments       int      `json:"comments"`
+	ReadingMinutes int      `json:"reading_minutes"`
+	HasAssignee    bool     `json:"has_assignee"`
+	Seen           bool     `json:"seen"`
+	FirstSeen      string   `json:"first_seen,omitempty"`
+	Tracked        bool     `json:"tracked"`
+	TrackedStatus  string   `json:"tracked_status,omitempty"`
+}
+
+type extensionTrackRequest struct {
+	URL string `json:"url"`
+}
+
+// loadExtensionAPIConfigFromEnv allows nothing by default: set a token, or
+// the exact origin of the installed extension (chrome-extension://<id>).
+func loadExtensionAPIConfigFromEnv() *ExtensionAPIConfig {
+	config := &ExtensionAPIConfig{
+		Host:  "127.0.0.1",
+		Port:  8765,
+		Token: os.Getenv("EXTENSION_API_TOKEN"),
+	}
+
+	if host := os.Getenv("EXTENSION_API_HOST"); host != "" {
+		config.Host = host
+	}
+
+	if port := os.Getenv("EXTENSION_API_PORT"); port != "" {
+		if val, err := strconv.Atoi(port); err == nil && val > 0 {
+			config.Port = val
+		}
+	}
+
+	if origins := os.Getenv("EXTENSION_ALLOWED_ORIGINS"); origins != "" {
+		config.AllowedOrigins = nil
+		for _, origin := range strings.Split(origins, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				config.AllowedOrigins = append(config.AllowedOrigins, origin)
+			}
+		}
+	}
+
+	return config
+}
+
+// isOriginAllowed supports exact origins and, when configured explicitly,
+// "scheme://*" wildcards.
+func (c *ExtensionAPIConfig) isOriginAllowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+		if strings.HasSuffix(allowed, "*") && strings.HasPrefix(origin, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ExtensionAPIConfig) hasToken(r *http.Request) bool {
+	if c.Token == "" {
+		return false
+	}
+	got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(got), []byte(c.Token)) == 1
+}
+
+// withCORS admits requests that carry the token or come from an allowed
+// origin. A missing Origin is not trusted: local processes send none.
+// Preflights carry no credentials, so they pass for any origin once a token
+// is configured and the real request is checked.
+func (c *ExtensionAPIConfig) withCORS(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		allowed := c.hasToken(r) || c.isOriginAllowed(origin)
+		if r.Method == http.MethodOptions {
+			allowed = origin != "" && (c.Token != "" || c.isOriginAllowed(origin))
+		}
+		if !allowed {
+			http.Error(w, "missing extension token or allowed origin", http.StatusForbidden)
+			return
+		}
+
+		if origin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.Header().Add("Vary", "Origin")
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *MCPServer) RegisterExtensionRoutes(mux *http.ServeMux, config *ExtensionAPIConfig) {
+	mux.HandleFunc("/api/extension/issue", config.withCORS(s.handleExtensionIssue))
+	mux.HandleFunc("/api/extension/track", config.withCORS(s.handleExtensionTrack))
+}
+
+func (s *MCPServer) handleExtensionIssue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	issueURL := r.URL.Query().Get("url")
+	if issueURL == "" {
+		http.Error(w, "missing url parameter", http.StatusBadRequest)
+		return
+	}
+
+	status, err := s.extensionIssueStatus(r.Context(), issueURL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *MCPServer) handleExtensionTrack(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req extensionTrackRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil || req.URL == "" {
+		http.Error(w, "request body must be JSON with a url field", http.StatusBadRequest)
+		return
+	}
+
+	handler := NewDeepLinkActionHandler(s.client, s.tracker, nil)
+	handler.score = s.pipelineScore
+	if _, err := handler.Execute(r.Context(), DeepLinkTrack, req.URL); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	status, err := s.extensionIssueStatus(r.Context(), req.URL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *MCPServer) extensionIssueStatus(ctx context.Context, issueURL string) (*ExtensionIssueStatus, error) {
+	owner, repo, number, err := ParseIssueURL(issueURL)
+	if err != nil {
+		return nil, err
+	}
+	if s.client == nil {
+		return nil, fmt.Errorf("github client not initialized")
+	}
+
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	project := Project{Org: owner, Name: repo}
+	if repoInfo, _, err := s.client.GetRepository(ctx, owner, repo); err == nil && repoInfo != nil {
+		project.Stars = repoInfo.GetStargazersCount()
+	}
+
+	result, _ := s.pipelineScore(ctx, project, issue)
+	status := &ExtensionIssueStatus{
+		URL:            issue.GetHTMLURL(),
+		Title:          issue.GetTitle(),
+		State:          issue.GetState(),
+		Score:          result.Score,
+		Confidence:     result.Confidence,
+		Grade:          scoreGrade(result.Score),
+		Labels:         getLabelNames(issue.Labels),
+		Comments:       issue.GetComments(),
+		ReadingMinutes: EstimateReadingTime(issue).Minutes,
+		HasAssignee:    len(issue.Assignees) > 0,
+	}
+
+	if s.db != nil {
+		firstSeen, err := lookupSeenIssue(s.db, fmt.Sprintf("%s/%d", repo, number), issue.GetNodeID())
+		if err == nil {
+			status.Seen = true
+			status.FirstSeen = firstSeen.Format(time.RFC3339)
+		} else if err != sql.ErrNoRows {
+			log.Printf("Warning: failed to check seen status for %s: %v", issueURL, err)
+		}
+	}
+
+	if s.tracker != nil {
+		if tracked, err := s.tracker.GetIssue(issueURL); err == nil {
+			status.Tracked = true
+			status.TrackedStatus = string(tracked.Status)
+		}
+	}
+
+	return status, nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func RunExtensionAPIServer(config *ExtensionAPIConfig) error {
+	mcpServer, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer mcpServer.db.Close()
+
+	if config.Token == "" && len(config.AllowedOrigins) == 0 {
+		log.Printf("Warning: extension API rejects every request; set EXTENSION_API_TOKEN or EXTENSION_ALLOWED_ORIGINS")
+	}
+
+	mux := http.NewServeMux()
+	mcpServer.RegisterExtensionRoutes(mux, config)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("OK"))
+	})
+
+	server := &http.Server{
+		Addr:         fmt.Sprintf("%s:%d", config.Host, config.Port),
+		Handler:      mux,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		IdleTimeout:  120 * time.Second,
+	}
+
+	log.Printf("Extension API listening on http://%s", server.Addr)
+	return server.ListenAndServe()
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestExtensionAPIConfig_IsOriginAllowed(t *testing.T) {
+	config := &ExtensionAPIConfig{
+		AllowedOrigins: []string{"https://github.com", "chrome-extension://*"},
+	}
+
+	tests := []struct {
+		origin   string
+		expected bool
+	}{
+		{"https://github.com", true},
+		{"chrome-extension://abcdefghijklmnop", true},
+		{"moz-extension://1234", false},
+		{"https://evil.example.com", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		result := config.isOriginAllowed(tt.origin)
+		if result != tt.expected {
+			t.Errorf("isOriginAllowed(%q) = %v, want %v", tt.origin, result, tt.expected)
+		}
+	}
+}
+
+func TestExtensionAPIConfig_WithCORS(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       *ExtensionAPIConfig
+		method       string
+		origin       string
+		token        string
+		expectedCode int
+		expectAllow  bool
+	}{
+		{"preflight from extension", &ExtensionAPIConfig{AllowedOrigins: []string{"chrome-extension://abc"}}, http.MethodOptions, "chrome-extension://abc", "", http.StatusNoContent, true},
+		{"get from extension", &ExtensionAPIConfig{AllowedOrigins: []string{"chrome-extension://abc"}}, http.MethodGet, "chrome-extension://abc", "", http.StatusOK, true},
+		{"get from another extension", &ExtensionAPIConfig{AllowedOrigins: []string{"chrome-extension://abc"}}, http.MethodGet, "chrome-extension://xyz", "", http.StatusForbidden, false},
+		{"get without origin", &ExtensionAPIConfig{AllowedOrigins: []string{"chrome-extension://abc"}}, http.MethodGet, "", "", http.StatusForbidden, false},
+		{"default config rejects", loadExtensionAPIConfigFromEnv(), http.MethodGet, "chrome-extension://abc", "", http.StatusForbidden, false},
+		{"token without origin", &ExtensionAPIConfig{Token: "s3cret"}, http.MethodGet, "", "s3cret", http.StatusOK, false},
+		{"token from any origin", &ExtensionAPIConfig{Token: "s3cret"}, http.MethodGet, "chrome-extension://xyz", "s3cret", http.StatusOK, true},
+		{"wrong token", &ExtensionAPIConfig{Token: "s3cret"}, http.MethodGet, "", "guess", http.StatusForbidden, false},
+		{"preflight with token configured", &ExtensionAPIConfig{Token: "s3cret"}, http.MethodOptions, "chrome-extension://xyz", "", http.StatusNoContent, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := tt.config.withCORS(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(tt.method, "/api/extension/issue", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != tt.expectedCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.expectedCode)
+			}
+			allowOrigin := rec.Header().Get("Access-Control-Allow-Origin")
+			if tt.expectAllow && allowOrigin != tt.origin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", allowOrigin, tt.origin)
+			}
+			if !tt.expectAllow && allowOrigin != "" {
+				t.Errorf("Access-Control-Allow-Origin = %q, want empty", allowOrigin)
+			}
+		})
+	}
+}
+
+func TestExtensionIssueStatus_UsesPipelineScore(t *testing.T) {
+	issue := &github.Issue{
+		Number:    github.Int(7),
+		Title:     github.String("net/http: document Transport.Clone"),
+		Body:      github.String("good first issue, help wanted, docs"),
+		HTMLURL:   github.String("https://github.com/golang/go/issues/7"),
+		Comments:  github.Int(1),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+		Labels:    []*github.Label{{Name: github.String("help wanted")}},
+	}
+	client := &fakeGitHubAPI{
+		issues: map[string]*github.Issue{"golang/go#7": issue},
+		repos:  map[string]*github.Repository{"golang/go": {StargazersCount: github.Int(120000)}},
+	}
+	server := &MCPServer{client: client}
+
+	status, err := server.extensionIssueStatus(context.Background(), "https://github.com/golang/go/issues/7")
+	if err != nil {
+		t.Fatalf("extensionIssueStatus() error: %v", err)
+	}
+
+	want := NewIssueScorer().ScoreIssueWithConfidence(issue, Project{Org: "golang", Name: "go", Stars: 120000})
+	if status.Score != want.Score || status.Confidence != want.Confidence {
+		t.Errorf("status score = %.3f (%.2f), want pipeline score %.3f (%.2f)", status.Score, status.Confidence, want.Score, want.Confidence)
+	}
+}
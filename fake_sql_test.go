@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeSQL is a scripted database/sql driver for code whose behavior lives in
+// its SQL. Each expected call matches the next statement by substring, checks
+// its arguments, and supplies rows or a rows-affected count.
+type fakeSQL struct {
+	t        *testing.T
+	mu       sync.Mutex
+	expected []*fakeSQLCall
+}
+
+type fakeSQLCall struct {
+	query    string
+	args     []any
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+	err      error
+}
+
+// fakeSQLAnyArg matches any argument, for timestamps taken inside the code
+// under test.
+type fakeSQLAnyArg struct{}
+
+func newFakeSQL(t *testing.T) (*fakeSQL, *sqlx.DB) {
+	f := &fakeSQL{t: t}
+	db := sqlx.NewDb(sql.OpenDB(f), "postgres")
+	t.Cleanup(func() {
+		db.Close()
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, call := range f.expected {
+			t.Errorf("expected statement was not run: %s", call.query)
+		}
+	})
+	return f, db
+}
+
+func (f *fakeSQL) expect(query string, args ...any) *fakeSQLCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := &fakeSQLCall{query: query, args: args}
+	f.expected = append(f.expected, call)
+	return call
+}
+
+func (c *fakeSQLCall) returns(columns []string, rows ...[]driver.Value) *fakeSQLCall {
+	c.columns, c.rows = columns, rows
+	return c
+}
+
+func (c *fakeSQLCall) affects(n int64) *fakeSQLCall {
+	c.affected = n
+	return c
+}
+
+func (f *fakeSQL) next(query string, args []driver.NamedValue) (*fakeSQLCall, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.expected) == 0 {
+		f.t.Errorf("unexpected statement: %s", query)
+		return nil, fmt.Errorf("unexpected statement")
+	}
+	call := f.expected[0]
+	if !strings.Contains(query, call.query) {
+		f.t.Errorf("statement %q does not contain %q", query, call.query)
+		return nil, fmt.Errorf("unexpected statement")
+	}
+	f.expected = f.expected[1:]
+
+	if call.args != nil {
+		if len(call.args) != len(args) {
+			f.t.Errorf("%s: got %d args, want %d", call.query, len(args), len(call.args))
+		}
+		for i := 0; i < len(call.args) && i < len(args); i++ {
+			if _, ok := call.args[i].(fakeSQLAnyArg); ok {
+				continue
+			}
+			if got, want := fmt.Sprint(args[i].Value), fmt.Sprint(call.args[i]); got != want {
+				f.t.Errorf("%s: arg %d = %s, want %s", call.query, i+1, got, want)
+			}
+		}
+	}
+	return call, call.err
+}
+
+func (f *fakeSQL) Connect(context.Context) (driver.Conn, error) { return fakeSQLConn{f}, nil }
+func (f *fakeSQL) Driver() driver.Driver                        { return fakeSQLDriver{} }
+
+type fakeSQLDriver struct{}
+
+func (fakeSQLDriver) Open(string) (driver.Conn, error) { return nil, fmt.Errorf("use sql.OpenDB") }
+
+type fakeSQLConn struct{ f *fakeSQL }
+
+func (c fakeSQLConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+func (c fakeSQLConn) Close() error              { return nil }
+func (c fakeSQLConn) Begin() (driver.Tx, error) { return fakeSQLTx{}, nil }
+
+func (c fakeSQLConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	call, err := c.f.next(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(call.affected), nil
+}
+
+func (c fakeSQLConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	call, err := c.f.next(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeSQLRows{columns: call.columns, rows: call.rows}, nil
+}
+
+type fakeSQLTx struct{}
+
+func (fakeSQLTx) Commit() error   { return nil }
+func (fakeSQLTx) Rollback() error { return nil }
+
+type fakeSQLRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeSQLRows) Columns() []string { return r.columns }
+func (r *fakeSQLRows) Close() error      { return nil }
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// GitHubAPI is the slice of the GitHub API this tool uses. Components take it
+// instead of *github.Client so tests can substitute fakes and other backends
+// (GitHub Enterprise, recorded responses) can be plugged in.
+type GitHubAPI interface {
+	ListRepoIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error)
+	SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error)
+
+	ListIssueComments(ctx context.Context, owner, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error)
+	GetIssueComment(ctx context.Context, owner, repo string, commentID int64) (*github.IssueComment, *github.Response, error)
+	CreateIssueComment(ctx context.Context, owner, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error)
+	DeleteIssueComment(ctx context.Context, owner, repo string, commentID int64) (*github.Response, error)
+
+	GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
+	ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+	GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	GetUser(ctx context.Context, user string) (*github.User, *github.Response, error)
+	ListStarred(ctx context.Context, user string, opts *github.ActivityListStarredOptions) ([]*github.StarredRepository, *github.Response, error)
+	ListWatched(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
+	RateLimits(ctx context.Context) (*github.RateLimits, *github.Response, error)
+}
+
+// clientAPI implements GitHubAPI with go-github.
+type clientAPI struct {
+	client *github.Client
+}
+
+// NewGitHubAPI adapts a go-github client. A nil client gives a nil API so
+// callers can keep checking for "no client".
+func NewGitHubAPI(client *github.Client) GitHubAPI {
+	if client == nil {
+		return nil
+	}
+	return &clientAPI{client: client}
+}
+
+func (c *clientAPI) ListRepoIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return c.client.Issues.ListByRepo(ctx, owner, repo, opts)
+}
+
+func (c *clientAPI) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error) {
+	return c.client.Issues.Get(ctx, owner, repo, number)
+}
+
+func (c *clientAPI) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	return c.client.Search.Issues(ctx, query, opts)
+}
+
+func (c *clientAPI) ListIssueComments(ctx context.Context, owner, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+	return c.client.Issues.ListComments(ctx, owner, repo, number, opts)
+}
+
+func (c *clientAPI) GetIssueComment(ctx context.Context, owner, repo string, commentID int64) (*github.IssueComment, *github.Response, error) {
+	return c.client.Issues.GetComment(ctx, owner, repo, commentID)
+}
+
+func (c *clientAPI) CreateIssueComment(ctx context.Context, owner, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error) {
+	return c.client.Issues.CreateComment(ctx, owner, repo, number, comment)
+}
+
+func (c *clientAPI) DeleteIssueComment(ctx context.Context, owner, repo string, commentID int64) (*github.Response, error) {
+	return c.client.Issues.DeleteComment(ctx, owner, repo, commentID)
+}
+
+func (c *clientAPI) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	return c.client.Repositories.Get(ctx, owner, repo)
+}
+
+func (c *clientAPI) ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return c.client.Repositories.ListReleases(ctx, owner, repo, opts)
+}
+
+func (c *clientAPI) GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	return c.client.Repositories.GetContents(ctx, owner, repo, path, opts)
+}
+
+func (c *clientAPI) GetUser(ctx context.Context, user string) (*github.User, *github.Response, error) {
+	return c.client.Users.Get(ctx, user)
+}
+
+func (c *clientAPI) ListStarred(ctx context.Context, user string, opts *github.ActivityListStarredOptions) ([]*github.StarredRepository, *github.Response, error) {
+	return c.client.Activity.ListStarred(ctx, user, opts)
+}
+
+func (c *clientAPI) ListWatched(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	return c.client.Activity.ListWatched(ctx, user, opts)
+}
+
+func (c *clientAPI) RateLimits(ctx context.Context) (*github.RateLimits, *github.Response, error) {
+	return c.client.RateLimits(ctx)
+}
+
+// responseStatus is the HTTP status of a call, taken from the error when the
+// call failed; 0 when there was no response.
+func responseStatus(resp *github.Response, err error) int {
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode
+	}
+	if resp != nil && resp.Response != nil {
+		return resp.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// fakeGitHubAPI serves canned data keyed by "owner/repo" and
+// "owner/repo#number". Unknown keys return a not-found error.
+type fakeGitHubAPI struct {
+	issues   map[string]*github.Issue
+	lists    map[string][]*github.Issue
+	comments map[string][]*github.IssueComment
+	repos    map[string]*github.Repository
+	files    map[string]string // "owner/repo/path" -> content
+	releases map[string][]*github.RepositoryRelease
+	searched []*github.Issue
+	starred  []*github.Repository
+	watched  []*github.Repository
+	created  []string
+	deleted  []int64
+
+	ignoreDeletes bool // DeleteIssueComment succeeds but the comment stays
+}
+
+var errFakeNotFound = fmt.Errorf("404 Not Found")
+
+func (f *fakeGitHubAPI) ListRepoIssues(_ context.Context, owner, repo string, _ *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	issues, ok := f.lists[owner+"/"+repo]
+	if !ok {
+		return nil, nil, errFakeNotFound
+	}
+	return issues, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) GetIssue(_ context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error) {
+	issue, ok := f.issues[fmt.Sprintf("%s/%s#%d", owner, repo, number)]
+	if !ok {
+		return nil, nil, errFakeNotFound
+	}
+	return issue, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) SearchIssues(context.Context, string, *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	return &github.IssuesSearchResult{Issues: f.searched}, &github.Response{}, nil
+}
+
+// ListIssueComments pages by opts.PerPage when it is set.
+func (f *fakeGitHubAPI) ListIssueComments(_ context.Context, owner, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+	comments := f.comments[fmt.Sprintf("%s/%s#%d", owner, repo, number)]
+	if opts == nil || opts.PerPage <= 0 {
+		return comments, &github.Response{}, nil
+	}
+	page := max(opts.Page, 1)
+	start := min((page-1)*opts.PerPage, len(comments))
+	end := min(start+opts.PerPage, len(comments))
+	resp := &github.Response{}
+	if end < len(comments) {
+		resp.NextPage = page + 1
+	}
+	return comments[start:end], resp, nil
+}
+
+func (f *fakeGitHubAPI) CreateIssueComment(_ context.Context, owner, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error) {
+	f.created = append(f.created, fmt.Sprintf("%s/%s#%d", owner, repo, number))
+	return &github.IssueComment{ID: github.Int64(int64(len(f.created))), Body: comment.Body}, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) DeleteIssueComment(_ context.Context, _, _ string, commentID int64) (*github.Response, error) {
+	if !f.ignoreDeletes {
+		f.deleted = append(f.deleted, commentID)
+	}
+	return &github.Response{}, nil
+}
+
+// GetIssueComment finds comments made through CreateIssueComment and answers
+// 404 for deleted or unknown IDs, like the API.
+func (f *fakeGitHubAPI) GetIssueComment(_ context.Context, _, _ string, commentID int64) (*github.IssueComment, *github.Response, error) {
+	if commentID < 1 || commentID > int64(len(f.created)) || slices.Contains(f.deleted, commentID) {
+		resp := &http.Response{StatusCode: http.StatusNotFound, Request: &http.Request{Method: http.MethodGet, URL: &url.URL{}}}
+		return nil, &github.Response{Response: resp}, &github.ErrorResponse{Response: resp, Message: "Not Found"}
+	}
+	return &github.IssueComment{ID: github.Int64(commentID)}, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) GetRepository(_ context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	r, ok := f.repos[owner+"/"+repo]
+	if !ok {
+		return nil, nil, errFakeNotFound
+	}
+	return r, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) ListReleases(_ context.Context, owner, repo string, _ *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return f.releases[owner+"/"+repo], &github.Response{}, nil
+}
+
+// GetContents serves f.files; a path that prefixes other files is listed as
+// a directory.
+func (f *fakeGitHubAPI) GetContents(_ context.Context, owner, repo, path string, _ *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	key := owner + "/" + repo + "/" + path
+	if content, ok := f.files[key]; ok {
+		return &github.RepositoryContent{Path: github.String(path), Type: github.String("file"), Content: github.String(content)}, nil, &github.Response{}, nil
+	}
+
+	prefix := strings.TrimSuffix(key, "/") + "/"
+	seen := make(map[string]bool)
+	var entries []*github.RepositoryContent
+	for name := range f.files {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+		entry, kind := rest, "file"
+		if i := strings.Index(rest, "/"); i >= 0 {
+			entry, kind = rest[:i], "dir"
+		}
+		if !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, &github.RepositoryContent{Name: github.String(entry), Path: github.String(strings.TrimPrefix(path+"/"+entry, "/")), Type: github.String(kind)})
+		}
+	}
+	if len(entries) == 0 {
+		return nil, nil, nil, errFakeNotFound
+	}
+	return nil, entries, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) GetUser(context.Context, string) (*github.User, *github.Response, error) {
+	return &github.User{Login: github.String("tester")}, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) ListStarred(context.Context, string, *github.ActivityListStarredOptions) ([]*github.StarredRepository, *github.Response, error) {
+	starred := make([]*github.StarredRepository, len(f.starred))
+	for i, repo := range f.starred {
+		starred[i] = &github.StarredRepository{Repository: repo}
+	}
+	return starred, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) ListWatched(context.Context, string, *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	return f.watched, &github.Response{}, nil
+}
+
+func (f *fakeGitHubAPI) RateLimits(context.Context) (*github.RateLimits, *github.Response, error) {
+	return &github.RateLimits{Core: &github.Rate{Limit: 5000, Remaining: 5000}}, &github.Response{}, nil
+}
+
+func TestNewGitHubAPINil(t *testing.T) {
+	if api := NewGitHubAPI(nil); api != nil {
+		t.Errorf("NewGitHubAPI(nil) = %v, want nil", api)
+	}
+}
+
+func TestResolveRepoMoveWithFake(t *testing.T) {
+	api := &fakeGitHubAPI{repos: map[string]*github.Repository{
+		"old/tool": {Name: github.String("tool"), Owner: &github.User{Login: github.String("new")}},
+	}}
+
+	move, moved := resolveRepoMove(context.Background(), api, "old", "tool")
+	if !moved || move.NewFullName() != "new/tool" {
+		t.Errorf("resolveRepoMove() = %+v, %v", move, moved)
+	}
+}
+
+func TestMaintainerWindowWithFake(t *testing.T) {
+	var comments []*github.IssueComment
+	for _, h := range []int{9, 10, 10, 11, 12, 13} {
+		comments = append(comments, &github.IssueComment{
+			AuthorAssociation: github.String("MEMBER"),
+			CreatedAt:         &github.Timestamp{Time: time.Date(2026, 5, 4, h, 0, 0, 0, time.UTC)},
+		})
+	}
+	comments = append(comments, &github.IssueComment{
+		AuthorAssociation: github.String("NONE"),
+		CreatedAt:         &github.Timestamp{Time: time.Date(2026, 5, 4, 22, 0, 0, 0, time.UTC)},
+	})
+
+	api := &fakeGitHubAPI{comments: map[string][]*github.IssueComment{"o/r#0": comments}}
+	e := NewMaintainerHoursEstimator(api, nil, &MaintainerHoursConfig{Enabled: true, WindowHours: 4, MinSamples: 5, LookbackDays: 30, CacheTTL: time.Hour})
+
+	window, known := e.Window(context.Background(), "o", "r")
+	if !known || window.StartHour != 9 || window.Samples != 6 {
+		t.Errorf("window = %+v, known = %v; want start 09:00 from 6 maintainer comments", window, known)
+	}
+}
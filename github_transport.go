@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/oauth2"
+)
+
+// Set at build time, see the Makefile.
+var (
+	Version   = "dev"
+	BuildTime = ""
+)
+
+const projectURL = "https://github.com/mehrdadbn9/github-issue-finder"
+
+// runID identifies this process in logs. Every GitHub request is logged with
+// it and a sequence number, so API calls can be matched to the run that made
+// them and to GitHub's own X-GitHub-Request-Id.
+var runID = newRunID()
+
+var apiRequestCount atomic.Int64
+
+func newRunID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano()&0xffffffff)
+	}
+	return hex.EncodeToString(b)
+}
+
+// userAgent follows GitHub's recommendation of naming the application and a
+// way to reach whoever runs it. GITHUB_USER_AGENT replaces it entirely.
+func userAgent() string {
+	if ua := os.Getenv("GITHUB_USER_AGENT"); ua != "" {
+		return ua
+	}
+	contact := projectURL
+	if c := os.Getenv("GITHUB_USER_AGENT_CONTACT"); c != "" {
+		contact = c
+	}
+	return fmt.Sprintf("github-issue-finder/%s (+%s)", Version, contact)
+}
+
+// annotatingTransport sets the User-Agent on every GitHub request and logs
+// each call with the run ID.
+type annotatingTransport struct {
+	base      http.RoundTripper
+	userAgent string
+	logCalls  bool
+}
+
+func (t *annotatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	seq := apiRequestCount.Add(1)
+
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	req.Header.Set("X-Request-Id", fmt.Sprintf("%s-%d", runID, seq))
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if !t.logCalls {
+		return resp, err
+	}
+
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		log.Printf("[GitHub] run=%s req=%d %s %s failed after %v: %v", runID, seq, req.Method, req.URL.Path, elapsed, err)
+		return resp, err
+	}
+
+	source := resp.Header.Get("X-GitHub-Request-Id")
+	if resp.Header.Get("X-From-Cache") != "" {
+		source = "cache"
+	}
+	log.Printf("[GitHub] run=%s req=%d %s %s -> %d in %v (github-request-id=%s)", runID, seq, req.Method, req.URL.Path, resp.StatusCode, elapsed, source)
+	return resp, err
+}
+
+// NewGitHubTransport layers the shared request handling over base: the
+// response cache for token (when db is set) and then the User-Agent and
+// logging.
+func NewGitHubTransport(base http.RoundTripper, db *sqlx.DB, token string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if db != nil {
+		base = NewCachingTransport(base, db, loadAPICacheConfigFromEnv(), token)
+	}
+	return &annotatingTransport{
+		base:      base,
+		userAgent: userAgent(),
+		logCalls:  getEnvBool("GITHUB_LOG_REQUESTS", true),
+	}
+}
+
+// newGitHubClient is the one place GitHub clients are built, so every
+// command gets the same authentication, caching, User-Agent and logging.
+func newGitHubClient(ctx context.Context, token string, db *sqlx.DB) GitHubAPI {
+	// An empty token sends no Authorization header at all, which lite mode
+	// relies on for unauthenticated runs.
+	tc := &http.Client{Transport: http.DefaultTransport}
+	if token != "" {
+		tc = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+	}
+	tc.Transport = NewGitHubTransport(tc.Transport, db, token)
+
+	client := github.NewClient(tc)
+	client.UserAgent = userAgent()
+	return NewGitHubAPI(client)
+}
+
+// logAPIUsage reports how many GitHub requests this run has made.
+func logAPIUsage(prefix string) {
+	log.Printf("[%s] Run %s made %d GitHub API requests", prefix, runID, apiRequestCount.Load())
+}
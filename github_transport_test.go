@@ -0,0 +1,55 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	t.Setenv("GITHUB_USER_AGENT", "")
+	t.Setenv("GITHUB_USER_AGENT_CONTACT", "ops@example.com")
+	if ua := userAgent(); !strings.HasPrefix(ua, "github-issue-finder/"+Version) || !strings.Contains(ua, "ops@example.com") {
+		t.Errorf("userAgent() = %q", ua)
+	}
+
+	t.Setenv("GITHUB_USER_AGENT", "custom-agent/1.0")
+	if ua := userAgent(); ua != "custom-agent/1.0" {
+		t.Errorf("override ignored, got %q", ua)
+	}
+}
+
+func TestAnnotatingTransport(t *testing.T) {
+	var gotUA, gotID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		gotID = r.Header.Get("X-Request-Id")
+		w.Header().Set("X-GitHub-Request-Id", "ABCD:1234")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &annotatingTransport{base: http.DefaultTransport, userAgent: "finder-test/1"}}
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/repos/o/r", nil)
+	req.Header.Set("User-Agent", "go-github")
+
+	before := apiRequestCount.Load()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if gotUA != "finder-test/1" {
+		t.Errorf("User-Agent = %q", gotUA)
+	}
+	if !strings.HasPrefix(gotID, runID+"-") {
+		t.Errorf("X-Request-Id = %q, want prefix %s-", gotID, runID)
+	}
+	if req.Header.Get("User-Agent") != "go-github" {
+		t.Error("the caller's request should not be modified")
+	}
+	if apiRequestCount.Load() != before+1 {
+		t.Error("request should be counted")
+	}
+}
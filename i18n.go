@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type Locale string
+
+const (
+	LocaleEnglish Locale = "en"
+	LocaleFarsi   Locale = "fa"
+	LocaleSpanish Locale = "es"
+)
+
+var activeLocale = LocaleEnglish
+
+// ConfigureLocale consumes --lang from args and returns the remaining
+// arguments. Without the flag, ISSUE_FINDER_LANG and then the usual POSIX
+// locale variables decide.
+func ConfigureLocale(args []string) []string {
+	locale := detectLocaleFromEnv()
+
+	remaining := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--lang" && i+1 < len(args):
+			locale = normalizeLocale(args[i+1])
+			i++
+		case strings.HasPrefix(arg, "--lang="):
+			locale = normalizeLocale(strings.TrimPrefix(arg, "--lang="))
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+
+	activeLocale = locale
+	return remaining
+}
+
+func detectLocaleFromEnv() Locale {
+	for _, key := range []string{"ISSUE_FINDER_LANG", "LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(key); value != "" {
+			return normalizeLocale(value)
+		}
+	}
+	return LocaleEnglish
+}
+
+// normalizeLocale maps values like "fa_IR.UTF-8" or "es-MX" to a supported
+// catalog, falling back to English.
+func normalizeLocale(raw string) Locale {
+	lang := strings.ToLower(raw)
+	if idx := strings.IndexAny(lang, "_-.@"); idx >= 0 {
+		lang = lang[:idx]
+	}
+
+	if _, ok := messageCatalog[Locale(lang)]; ok {
+		return Locale(lang)
+	}
+	return LocaleEnglish
+}
+
+func T(key string, args ...any) string {
+	return TL(activeLocale, key, args...)
+}
+
+func TL(locale Locale, key string, args ...any) string {
+	format, ok := messageCatalog[locale][key]
+	if !ok {
+		format, ok = messageCatalog[LocaleEnglish][key]
+	}
+	if !ok {
+		format = key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+func localeDirection() string {
+	if activeLocale == LocaleFarsi {
+		return "rtl"
+	}
+	return "ltr"
+}
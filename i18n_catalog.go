@@ -0,0 +1,809 @@
+package main
+
+// Keys missing from a locale fall back to English, so new strings only need
+// an English entry to ship.
+var messageCatalog = map[Locale]map[string]string{
+	LocaleEnglish: {
+		"usage.title":            "GitHub Issue Finder - Find Qualified Issues",
+		"usage.line":             "Usage: github-issue-finder <command> [options]",
+		"usage.commands":         "Commands:",
+		"usage.monitor_commands": "Monitor Commands:",
+		"usage.mcp_commands":     "MCP Server Commands:",
+		"usage.output_options":   "Output Options (any command):",
+		"usage.notify_options":   "Notify Options:",
+		"usage.smart_limits":     "Smart Limits Configuration:",
+		"usage.examples":         "Examples:",
+
+		"cmd.start":          "Start automated daily search",
+		"cmd.search":         "One-time search",
+		"cmd.preview":        "Preview what would be commented (dry-run)",
+		"cmd.commit":         "Actually post comments",
+		"cmd.limits":         "Show current smart limits status",
+		"cmd.comment":        "Comment on specific issue",
+		"cmd.status":         "Show today's status",
+		"cmd.config":         "Configure settings",
+		"cmd.enable":         "Enable auto mode",
+		"cmd.disable":        "Disable auto mode",
+		"cmd.repos":          "List managed repos",
+		"cmd.repos_add":      "Add repo",
+		"cmd.repos_remove":   "Remove repo",
+		"cmd.history":        "Show comment history",
+		"cmd.find":           "Find qualified issues (default)",
+		"cmd.bugs":           "Find qualified bug issues",
+		"cmd.features":       "Find qualified feature issues",
+		"cmd.notify":         "Find and send notifications for qualified issues",
+		"cmd.mine":           "Check your assigned issues",
+		"cmd.stats":          "Show statistics and the per-run and weekly pipeline funnel",
+		"cmd.digest":         "Show daily digest of issues",
+		"cmd.track":          "Track an issue you're working on",
+		"cmd.update":         "Update a tracked issue's status or notes",
+		"cmd.list":           "List tracked issues",
+		"cmd.email_test":     "Test email configuration",
+		"cmd.cleanup":        "Clean up old notification records",
+		"cmd.open_link":      "Handle a track/snooze/preview deep link from an alert",
+		"cmd.monitor_start":  "Start continuous monitoring daemon",
+		"cmd.monitor_stop":   "Stop monitoring daemon",
+		"cmd.monitor_status": "Show monitor status and configuration",
+		"cmd.monitor_check":  "Run a single monitoring check now",
+		"cmd.monitor_notify": "Test notification system",
+		"cmd.mcp":            "Run as MCP server (stdio mode for Claude Desktop, etc.)",
+		"cmd.mcp_http":       "Run as MCP HTTP server (for web integrations)",
+		"cmd.mcp_list_tools": "List all available MCP tools",
+		"cmd.mcp_test":       "Test MCP server functionality",
+		"cmd.extension_api":  "Run the localhost API used by the browser extension",
+
+		"opt.plain":         "Screen-reader friendly output: no emoji, ASCII only, no color",
+		"opt.no_emoji":      "Replace or drop emoji but keep the regular layout",
+		"opt.no_color":      "Disable colors (also honored via NO_COLOR)",
+		"opt.lang":          "Message language: en, fa, es (also ISSUE_FINDER_LANG)",
+		"opt.seed":          "Seed for sampling; the seed of every run is logged",
+		"opt.deterministic": "Fixed seed and stable ordering for tests and comparisons",
+		"opt.lite":          "Search API only, within LITE_MAX_REQUESTS requests",
+		"opt.email":         "Send email for high-scoring issues (>0.7)",
+		"opt.local":         "Send local/desktop notifications (default)",
+		"opt.no_local":      "Disable local notifications",
+		"opt.score_min":     "Minimum score threshold (default: 0.6)",
+
+		"usage.limit_base":     "Base daily limit: %d comments",
+		"usage.limit_max":      "Max daily limit: %d comments (with high-quality issues)",
+		"usage.limit_weekly":   "Weekly cap: %d comments",
+		"usage.limit_per_repo": "Max per repo per day: %d comment",
+
+		"find.searching":            "Finding issues...",
+		"find.searching_good_first": "Finding good first issues...",
+		"find.none_after_filter":    "No new issues found after filtering.",
+		"find.title_new":            "NEW ISSUES FOUND",
+		"find.title_good_first":     "GOOD FIRST ISSUES",
+		"find.none_good_first":      "No good first issues found.",
+
+		"print.total_found":       "Total Found: %d issues",
+		"actionable.title":        "ACTIONABLE ISSUES FROM TLS-ENABLED GO PROJECTS",
+		"actionable.subtitle":     "(Good First Issues, Bugs, Enhancements - No Go 1.26 Required)",
+		"actionable.none":         "No actionable issues found.",
+		"actionable.good_first":   "GOOD FIRST ISSUES (%d issues)",
+		"actionable.bugs":         "BUG ISSUES (%d issues)",
+		"actionable.enhancements": "ENHANCEMENT ISSUES (%d issues)",
+		"goupgrade.title":         "GO VERSION UPGRADE ISSUES IN TLS-ENABLED PROJECTS",
+		"goupgrade.none":          "No Go version upgrade issues found.",
+		"confirmed.title":         "GOOD FIRST ISSUES WITH CONFIRMED LABEL (Ready for Assignment)",
+		"confirmed.criteria":      "Criteria: good first issue + confirmed/triage/accepted, no assignee, no PR",
+		"confirmed.none":          "No matching issues found.",
+		"confirmed.eligible":      "ELIGIBLE FOR ASSIGNMENT (%d issues)",
+		"confirmed.ineligible":    "NOT ELIGIBLE (%d issues)",
+		"confirmed.has_assignee":  "has assignee",
+		"confirmed.has_pr":        "has linked PR",
+
+		"monitor.title":         "MONITOR STATUS",
+		"monitor.not_running":   "Running: No active daemon",
+		"monitor.start_hint":    "Note: Use 'monitor start' to begin monitoring",
+		"monitor.defaults":      "Default Configuration:",
+		"monitor.interval":      "Check Interval: %v",
+		"monitor.min_score":     "Min Score: %.2f",
+		"monitor.max_issues":    "Max Issues Per Check: %d",
+		"monitor.notifications": "Notifications: Local=%v, Email=%v",
+		"monitor.repos":         "Repositories: %d",
+		"monitor.by_category":   "Monitored Repositories (by category):",
+		"monitor.category":      "%s (%d repos):",
+
+		"track.tracking": "✅ Tracking issue: %s",
+		"track.status":   "   Status: %s",
+
+		"list.usage_hint": "Use --status <status>, --older-than <age>, --project <org/name>, --label <label> or --all to list issues",
+		"list.none":       "No tracked issues found.",
+		"list.title":      "Tracked Issues (%d total)",
+
+		"digest.none":       "No issues in today's digest.",
+		"digest.title":      "DAILY ISSUE DIGEST",
+		"digest.date":       "Date: %s",
+		"digest.empty":      "No issues found for today's digest.",
+		"digest.found":      "Found %d issues",
+		"digest.good_first": "🔥 Good First Issues (%d):",
+		"digest.other":      "📋 Other Issues (%d):",
+		"digest.more":       "   ... and %d more",
+		"digest.sending":    "Sending email digest...",
+		"digest.sent":       "Email digest sent successfully!",
+
+		"stats.title":         "📊 GitHub Issue Finder Statistics",
+		"stats.active":        "Active tracked issues: %d",
+		"stats.notifications": "Notification Stats:",
+		"stats.email":         "Email Stats:",
+
+		"stats.funnel":          "Pipeline Funnel:",
+		"stats.funnel_none":     "No runs recorded yet. Each daemon check, find run and AutoFinder run records one.",
+		"stats.funnel_runs":     "Last %d runs (score threshold %.2f):",
+		"stats.funnel_weeks":    "Last %d weeks (runs per week in parentheses):",
+		"stats.funnel_drop":     "Biggest drop: %s → %s (%.0f%% kept)",
+		"col.run":               "Run",
+		"col.week":              "Week",
+		"stage.fetched":         "Fetched",
+		"stage.passed":          "Passed",
+		"stage.above_threshold": "Scored",
+		"stage.notified":        "Notified",
+		"stage.tracked":         "Tracked",
+		"stage.commented":       "Commented",
+		"stage.assigned":        "Assigned",
+		"stage.merged":          "Merged",
+
+		"limits.title":             "📊 SMART COMMENTING LIMITS",
+		"limits.not_initialized":   "Smart limiter not initialized (requires database connection).",
+		"limits.not_available":     "Smart limiter not available.",
+		"limits.defaults":          "Default configuration",
+		"limits.daily":             "📅 Daily Limits",
+		"limits.weekly":            "📆 Weekly Limits",
+		"limits.quality":           "🎯 Quality Thresholds",
+		"limits.repos_today":       "📝 Repos commented today:",
+		"limits.tips":              "💡 Tips:",
+		"limits.comments_today":    "Comments today",
+		"limits.repos_commented":   "Repos commented",
+		"limits.base_limit":        "Base limit",
+		"limits.max_limit":         "Max limit (high quality)",
+		"limits.remaining_today":   "Remaining today",
+		"limits.comments_week":     "Comments this week",
+		"limits.weekly_cap":        "Weekly cap",
+		"limits.remaining_week":    "Remaining this week",
+		"limits.quality_threshold": "Quality threshold (great)",
+		"limits.min_score":         "Minimum score to comment",
+
+		"col.score":    "Score",
+		"col.grade":    "Grade",
+		"col.project":  "Project",
+		"col.comments": "Cmts",
+		"col.title":    "Title",
+		"col.url":      "URL",
+		"col.status":   "Status",
+		"col.notes":    "Notes",
+
+		"field.score":       "Score",
+		"field.project":     "Project",
+		"field.comments":    "Comments",
+		"field.thread":      "Thread",
+		"field.release":     "Release",
+		"field.sign_off":    "Sign-off",
+		"field.confidence":  "Confidence",
+		"confidence.high":   "high",
+		"confidence.medium": "medium",
+		"confidence.low":    "low",
+		"field.category":    "Category",
+		"field.url":         "URL",
+		"field.labels":      "Labels",
+		"field.created":     "Created",
+		"field.title":       "Title",
+		"field.stars":       "Stars",
+
+		"reading.minutes": "~%d min read",
+
+		"telegram.header":       "🚀 *New Learning Opportunities in Go DevOps Projects*",
+		"notify.desktop":        "🔔 DESKTOP NOTIFICATION",
+		"notify.qualified":      "🎯 Qualified Issue: %s",
+		"deeplink.track":        "Track",
+		"deeplink.snooze":       "Snooze",
+		"deeplink.preview":      "Preview",
+		"email.new_heading":     "%s New Issue Found",
+		"email.new_tagline":     "A great learning opportunity awaits!",
+		"email.new_text":        "New Issue Found!",
+		"email.view_issue":      "View Issue →",
+		"email.breakdown":       "Score Breakdown",
+		"email.total_score":     "Total Score",
+		"email.digest_subject":  "📰 Daily Issue Digest - %s (%d issues)",
+		"email.digest_text":     "Daily Issue Digest - %s",
+		"email.digest_count":    "%d issues found",
+		"email.digest_gfi":      "🔥 Good First Issues",
+		"email.digest_other":    "📋 Other Opportunities",
+		"email.digest_more_gfi": "... and %d more good first issues",
+		"email.digest_more":     "... and %d more issues",
+		"email.comments_count":  "%d comments",
+
+		"explore.searching":    "Loading recently discovered issues to sample from...",
+		"explore.none":         "No issues discovered in the last %d days. Run find or the daemon first.",
+		"explore.title":        "EXPLORE: %d issues sampled from %d",
+		"explore.seed":         "Seed %d - pass --seed %d to get the same sample again.",
+		"col.category":         "Category",
+		"col.band":             "Band",
+		"cmd.explore":          "Random sample across categories and score bands",
+		"morelike.title":       "MORE LIKE %s/%s#%d",
+		"morelike.fingerprint": "Matching on %s",
+		"morelike.searching":   "Searching open issues in %d repositories...",
+		"morelike.none":        "No similar open issues among %d candidates.",
+		"col.match":            "Match",
+		"col.shared":           "Shared",
+		"col.kind":             "Kind",
+		"col.stored":           "Stored",
+		"col.live":             "Live",
+		"col.fixed":            "Fixed",
+		"col.step":             "Step",
+		"col.detail":           "Detail",
+		"col.stars":            "Stars",
+		"col.source":           "Source",
+		"cmd.more_like":        "Open issues similar to one you completed",
+		"whynot.title":         "WHY NOT %s/%s#%d",
+		"whynot.rejected":      "Rejected at the %s stage on %s:",
+		"whynot.seen":          "Not rejected: the issue was already seen and notified.",
+		"whynot.would_reject":  "Not seen yet, but the %s filter would reject it now:",
+		"whynot.passes":        "Not rejected and not seen yet; it passes the current filters.",
+		"cmd.why_not":          "Explain why an issue was filtered out",
+		"migrate.seen_running": "Backfilling node IDs for previously seen issues...",
+		"migrate.seen_done":    "Updated %d seen issues, skipped %d.",
+		"cmd.migrate_seen":     "Backfill node IDs for previously seen issues",
+		"drift.title":          "DRIFT BETWEEN DATABASE AND GITHUB",
+		"drift.checked":        "Checked %d tracked issues and %d recorded comments.",
+		"drift.none":           "No drift found.",
+		"cmd.drift":            "Compare stored issue and comment state with GitHub",
+		"selftest.title":       "SELF-TEST AGAINST SANDBOX REPOSITORY",
+		"selftest.summary":     "%d passed, %d failed, %d skipped.",
+		"cmd.selftest":         "Check credentials and the full pipeline against your sandbox repo",
+		"export.written":       "Exported stats with %d issues to %s",
+		"cmd.stats_export":     "Export stats as JSON, with per-field redaction for sharing",
+		"cmd.regressions":      "Find regressions reported within days of a release in watched repos",
+		"regression.title":     "🔁 Regressions reported within %d days of a release",
+		"regression.none":      "No regressions found after recent releases.",
+		"regression.after":     "%s (%s), reported %d days later",
+		"cmd.init":             "Build a watchlist of Go projects from your stars or watched repos",
+		"init.scanned":         "Scanned %d repositories: %d not %s, %d under %d stars, %d archived, forked or excluded",
+		"init.dry_run":         "Would add %d repositories to %s (dry run)",
+		"init.written":         "Added %d repositories to %s (%d in the watchlist)",
+		"lite.summary":         "Lite mode: used %d of %d requests, searched %d of %d watched repositories.",
+		"lite.uncovered":       "%d repositories were not searched because the request budget ran out.",
+		"lite.truncated":       "%d queries had more matches than were fetched; older matches were skipped.",
+		"lite.skipped":         "Not covered in lite mode: %s.",
+		"lite.skip_labels":     "issues without the labels %s",
+		"lite.skip_age":        "issues opened more than %d days ago",
+		"lite.skip_epics":      "sub-issues of epics",
+		"lite.skip_drift":      "drift checks",
+	},
+
+	LocaleFarsi: {
+		"usage.title":            "یابندهٔ ایشوهای گیت‌هاب - یافتن ایشوهای مناسب",
+		"usage.line":             "استفاده: github-issue-finder <command> [options]",
+		"usage.commands":         "دستورها:",
+		"usage.monitor_commands": "دستورهای پایش:",
+		"usage.mcp_commands":     "دستورهای سرور MCP:",
+		"usage.output_options":   "گزینه‌های خروجی (برای همهٔ دستورها):",
+		"usage.notify_options":   "گزینه‌های اعلان:",
+		"usage.smart_limits":     "پیکربندی محدودیت‌های هوشمند:",
+		"usage.examples":         "نمونه‌ها:",
+
+		"cmd.start":          "شروع جست‌وجوی خودکار روزانه",
+		"cmd.search":         "جست‌وجوی یک‌باره",
+		"cmd.preview":        "پیش‌نمایش کامنت‌هایی که ارسال می‌شوند (بدون ارسال)",
+		"cmd.commit":         "ارسال واقعی کامنت‌ها",
+		"cmd.limits":         "نمایش وضعیت فعلی محدودیت‌های هوشمند",
+		"cmd.comment":        "کامنت روی یک ایشوی مشخص",
+		"cmd.status":         "نمایش وضعیت امروز",
+		"cmd.config":         "پیکربندی تنظیمات",
+		"cmd.enable":         "فعال‌سازی حالت خودکار",
+		"cmd.disable":        "غیرفعال‌سازی حالت خودکار",
+		"cmd.repos":          "فهرست مخزن‌های مدیریت‌شده",
+		"cmd.repos_add":      "افزودن مخزن",
+		"cmd.repos_remove":   "حذف مخزن",
+		"cmd.history":        "نمایش تاریخچهٔ کامنت‌ها",
+		"cmd.find":           "یافتن ایشوهای مناسب (پیش‌فرض)",
+		"cmd.bugs":           "یافتن ایشوهای باگ مناسب",
+		"cmd.features":       "یافتن ایشوهای قابلیت مناسب",
+		"cmd.notify":         "یافتن ایشوهای مناسب و ارسال اعلان",
+		"cmd.mine":           "بررسی ایشوهای واگذارشده به شما",
+		"cmd.stats":          "نمایش آمار و قیف پردازش برای هر اجرا و هر هفته",
+		"cmd.digest":         "نمایش خلاصهٔ روزانهٔ ایشوها",
+		"cmd.track":          "پیگیری ایشویی که روی آن کار می‌کنید",
+		"cmd.update":         "به‌روزرسانی وضعیت یا یادداشت ایشوی پیگیری‌شده",
+		"cmd.list":           "فهرست ایشوهای پیگیری‌شده",
+		"cmd.email_test":     "آزمایش پیکربندی ایمیل",
+		"cmd.cleanup":        "پاک‌سازی سوابق قدیمی اعلان‌ها",
+		"cmd.open_link":      "اجرای پیوند پیگیری/تعویق/پیش‌نمایش از یک هشدار",
+		"cmd.monitor_start":  "شروع سرویس پایش پیوسته",
+		"cmd.monitor_stop":   "توقف سرویس پایش",
+		"cmd.monitor_status": "نمایش وضعیت و پیکربندی پایش",
+		"cmd.monitor_check":  "اجرای یک بررسی پایش همین حالا",
+		"cmd.monitor_notify": "آزمایش سامانهٔ اعلان",
+		"cmd.mcp":            "اجرا به‌عنوان سرور MCP (حالت stdio برای Claude Desktop و غیره)",
+		"cmd.mcp_http":       "اجرا به‌عنوان سرور HTTP برای MCP (برای یکپارچه‌سازی وب)",
+		"cmd.mcp_list_tools": "فهرست همهٔ ابزارهای MCP",
+		"cmd.mcp_test":       "آزمایش عملکرد سرور MCP",
+		"cmd.extension_api":  "اجرای API محلی مورد استفادهٔ افزونهٔ مرورگر",
+
+		"opt.plain":         "خروجی مناسب صفحه‌خوان: بدون ایموجی، فقط ASCII، بدون رنگ",
+		"opt.no_emoji":      "جایگزینی یا حذف ایموجی با حفظ چیدمان معمول",
+		"opt.no_color":      "غیرفعال‌سازی رنگ‌ها (NO_COLOR هم پذیرفته می‌شود)",
+		"opt.lang":          "زبان پیام‌ها: en، fa، es (یا ISSUE_FINDER_LANG)",
+		"opt.seed":          "بذر نمونه‌گیری؛ بذر هر اجرا در لاگ ثبت می‌شود",
+		"opt.deterministic": "بذر ثابت و ترتیب پایدار برای تست و مقایسه",
+		"opt.lite":          "فقط Search API، در حد LITE_MAX_REQUESTS درخواست",
+		"opt.email":         "ارسال ایمیل برای ایشوهای با امتیاز بالا (>0.7)",
+		"opt.local":         "ارسال اعلان محلی/دسکتاپ (پیش‌فرض)",
+		"opt.no_local":      "غیرفعال‌سازی اعلان‌های محلی",
+		"opt.score_min":     "حداقل امتیاز (پیش‌فرض: 0.6)",
+
+		"usage.limit_base":     "سقف پایهٔ روزانه: %d کامنت",
+		"usage.limit_max":      "حداکثر روزانه: %d کامنت (با ایشوهای باکیفیت)",
+		"usage.limit_weekly":   "سقف هفتگی: %d کامنت",
+		"usage.limit_per_repo": "حداکثر برای هر مخزن در روز: %d کامنت",
+
+		"find.searching":            "در حال یافتن ایشوها...",
+		"find.searching_good_first": "در حال یافتن ایشوهای مناسب شروع...",
+		"find.none_after_filter":    "پس از فیلتر، ایشوی جدیدی پیدا نشد.",
+		"find.title_new":            "ایشوهای جدید یافت‌شده",
+		"find.title_good_first":     "ایشوهای مناسب شروع",
+		"find.none_good_first":      "ایشوی مناسب شروعی پیدا نشد.",
+
+		"print.total_found":       "مجموع یافته‌ها: %d ایشو",
+		"actionable.title":        "ایشوهای قابل اقدام در پروژه‌های Go با TLS",
+		"actionable.subtitle":     "(ایشوهای مناسب شروع، باگ‌ها، بهبودها - بدون نیاز به Go 1.26)",
+		"actionable.none":         "ایشوی قابل اقدامی پیدا نشد.",
+		"actionable.good_first":   "ایشوهای مناسب شروع (%d ایشو)",
+		"actionable.bugs":         "باگ‌ها (%d ایشو)",
+		"actionable.enhancements": "بهبودها (%d ایشو)",
+		"goupgrade.title":         "ایشوهای ارتقای نسخهٔ Go در پروژه‌های دارای TLS",
+		"goupgrade.none":          "ایشوی ارتقای نسخهٔ Go پیدا نشد.",
+		"confirmed.title":         "ایشوهای مناسب شروع با برچسب تأیید (آمادهٔ واگذاری)",
+		"confirmed.criteria":      "معیار: good first issue + confirmed/triage/accepted، بدون مسئول، بدون PR",
+		"confirmed.none":          "ایشوی منطبقی پیدا نشد.",
+		"confirmed.eligible":      "قابل واگذاری (%d ایشو)",
+		"confirmed.ineligible":    "غیرقابل واگذاری (%d ایشو)",
+		"confirmed.has_assignee":  "مسئول دارد",
+		"confirmed.has_pr":        "PR مرتبط دارد",
+
+		"monitor.title":         "وضعیت پایش",
+		"monitor.not_running":   "در حال اجرا: سرویس فعالی وجود ندارد",
+		"monitor.start_hint":    "نکته: برای شروع پایش از 'monitor start' استفاده کنید",
+		"monitor.defaults":      "پیکربندی پیش‌فرض:",
+		"monitor.interval":      "فاصلهٔ بررسی: %v",
+		"monitor.min_score":     "حداقل امتیاز: %.2f",
+		"monitor.max_issues":    "حداکثر ایشو در هر بررسی: %d",
+		"monitor.notifications": "اعلان‌ها: محلی=%v، ایمیل=%v",
+		"monitor.repos":         "مخزن‌ها: %d",
+		"monitor.by_category":   "مخزن‌های پایش‌شده (بر اساس دسته):",
+		"monitor.category":      "%s (%d مخزن):",
+
+		"track.tracking": "✅ در حال پیگیری ایشو: %s",
+		"track.status":   "   وضعیت: %s",
+
+		"list.usage_hint": "برای فهرست ایشوها از --status <status>، --older-than <age>، --project <org/name>، --label <label> یا --all استفاده کنید",
+		"list.none":       "هیچ ایشوی پیگیری‌شده‌ای پیدا نشد.",
+		"list.title":      "ایشوهای پیگیری‌شده (%d مورد)",
+
+		"digest.none":       "خلاصهٔ امروز ایشویی ندارد.",
+		"digest.title":      "خلاصهٔ روزانهٔ ایشوها",
+		"digest.date":       "تاریخ: %s",
+		"digest.empty":      "برای خلاصهٔ امروز ایشویی پیدا نشد.",
+		"digest.found":      "%d ایشو پیدا شد",
+		"digest.good_first": "🔥 ایشوهای مناسب شروع (%d):",
+		"digest.other":      "📋 ایشوهای دیگر (%d):",
+		"digest.more":       "   ... و %d مورد دیگر",
+		"digest.sending":    "در حال ارسال خلاصهٔ ایمیلی...",
+		"digest.sent":       "خلاصهٔ ایمیلی با موفقیت ارسال شد!",
+
+		"stats.title":         "📊 آمار یابندهٔ ایشوهای گیت‌هاب",
+		"stats.active":        "ایشوهای فعال در حال پیگیری: %d",
+		"stats.notifications": "آمار اعلان‌ها:",
+		"stats.email":         "آمار ایمیل:",
+
+		"stats.funnel":          "قیف پردازش:",
+		"stats.funnel_none":     "هنوز اجرایی ثبت نشده است. هر بررسی سرویس، هر اجرای find و هر اجرای AutoFinder یک اجرا ثبت می‌کند.",
+		"stats.funnel_runs":     "%d اجرای اخیر (آستانهٔ امتیاز %.2f):",
+		"stats.funnel_weeks":    "%d هفتهٔ اخیر (تعداد اجراها در پرانتز):",
+		"stats.funnel_drop":     "بیشترین ریزش: %s ← %s (%.0f%% باقی ماند)",
+		"col.run":               "اجرا",
+		"col.week":              "هفته",
+		"stage.fetched":         "دریافت",
+		"stage.passed":          "فیلتر",
+		"stage.above_threshold": "امتیاز",
+		"stage.notified":        "اعلان",
+		"stage.tracked":         "پیگیری",
+		"stage.commented":       "کامنت",
+		"stage.assigned":        "واگذاری",
+		"stage.merged":          "ادغام",
+
+		"limits.title":             "📊 محدودیت‌های هوشمند کامنت",
+		"limits.not_initialized":   "محدودکنندهٔ هوشمند راه‌اندازی نشده است (نیاز به اتصال پایگاه داده).",
+		"limits.not_available":     "محدودکنندهٔ هوشمند در دسترس نیست.",
+		"limits.defaults":          "پیکربندی پیش‌فرض",
+		"limits.daily":             "📅 محدودیت‌های روزانه",
+		"limits.weekly":            "📆 محدودیت‌های هفتگی",
+		"limits.quality":           "🎯 آستانه‌های کیفیت",
+		"limits.repos_today":       "📝 مخزن‌هایی که امروز کامنت گرفتند:",
+		"limits.tips":              "💡 نکته‌ها:",
+		"limits.comments_today":    "کامنت‌های امروز",
+		"limits.repos_commented":   "مخزن‌های کامنت‌شده",
+		"limits.base_limit":        "سقف پایه",
+		"limits.max_limit":         "سقف بیشینه (کیفیت بالا)",
+		"limits.remaining_today":   "باقی‌ماندهٔ امروز",
+		"limits.comments_week":     "کامنت‌های این هفته",
+		"limits.weekly_cap":        "سقف هفتگی",
+		"limits.remaining_week":    "باقی‌ماندهٔ این هفته",
+		"limits.quality_threshold": "آستانهٔ کیفیت (عالی)",
+		"limits.min_score":         "حداقل امتیاز برای کامنت",
+
+		"col.score":    "امتیاز",
+		"col.grade":    "رتبه",
+		"col.project":  "پروژه",
+		"col.comments": "کامنت",
+		"col.title":    "عنوان",
+		"col.url":      "نشانی",
+		"col.status":   "وضعیت",
+		"col.notes":    "یادداشت",
+
+		"field.score":       "امتیاز",
+		"field.project":     "پروژه",
+		"field.comments":    "کامنت‌ها",
+		"field.thread":      "گفت‌وگو",
+		"field.release":     "انتشار",
+		"field.sign_off":    "امضای مشارکت",
+		"field.confidence":  "اطمینان",
+		"confidence.high":   "بالا",
+		"confidence.medium": "متوسط",
+		"confidence.low":    "پایین",
+		"field.category":    "دسته",
+		"field.url":         "نشانی",
+		"field.labels":      "برچسب‌ها",
+		"field.created":     "ایجاد",
+		"field.title":       "عنوان",
+		"field.stars":       "ستاره‌ها",
+
+		"reading.minutes": "حدود %d دقیقه مطالعه",
+
+		"telegram.header":       "🚀 *فرصت‌های یادگیری جدید در پروژه‌های Go و DevOps*",
+		"notify.desktop":        "🔔 اعلان دسکتاپ",
+		"notify.qualified":      "🎯 ایشوی مناسب: %s",
+		"deeplink.track":        "پیگیری",
+		"deeplink.snooze":       "تعویق",
+		"deeplink.preview":      "پیش‌نمایش",
+		"email.new_heading":     "%s ایشوی جدید پیدا شد",
+		"email.new_tagline":     "یک فرصت یادگیری عالی در انتظار شماست!",
+		"email.new_text":        "ایشوی جدید پیدا شد!",
+		"email.view_issue":      "مشاهدهٔ ایشو ←",
+		"email.breakdown":       "جزئیات امتیاز",
+		"email.total_score":     "امتیاز کل",
+		"email.digest_subject":  "📰 خلاصهٔ روزانهٔ ایشوها - %s (%d ایشو)",
+		"email.digest_text":     "خلاصهٔ روزانهٔ ایشوها - %s",
+		"email.digest_count":    "%d ایشو پیدا شد",
+		"email.digest_gfi":      "🔥 ایشوهای مناسب شروع",
+		"email.digest_other":    "📋 فرصت‌های دیگر",
+		"email.digest_more_gfi": "... و %d ایشوی مناسب شروع دیگر",
+		"email.digest_more":     "... و %d ایشوی دیگر",
+		"email.comments_count":  "%d کامنت",
+
+		"explore.searching":    "در حال بارگذاری ایشوهای اخیراً کشف‌شده برای نمونه‌گیری...",
+		"explore.none":         "در %d روز اخیر ایشویی کشف نشده است. ابتدا find یا سرویس را اجرا کنید.",
+		"explore.title":        "کاوش: %d ایشو از میان %d نمونه‌گیری شد",
+		"explore.seed":         "بذر %d - برای دریافت همین نمونه از --seed %d استفاده کنید.",
+		"col.category":         "دسته",
+		"col.band":             "بازه",
+		"cmd.explore":          "نمونهٔ تصادفی از دسته‌ها و بازه‌های امتیاز",
+		"morelike.title":       "مشابه %s/%s#%d",
+		"morelike.fingerprint": "تطبیق بر اساس %s",
+		"morelike.searching":   "جست‌وجوی ایشوهای باز در %d مخزن...",
+		"morelike.none":        "در میان %d گزینه، ایشوی باز مشابهی پیدا نشد.",
+		"col.match":            "تطابق",
+		"col.shared":           "مشترک",
+		"col.kind":             "نوع",
+		"col.stored":           "ذخیره‌شده",
+		"col.live":             "زنده",
+		"col.fixed":            "اصلاح شد",
+		"col.step":             "مرحله",
+		"col.detail":           "جزئیات",
+		"col.stars":            "ستاره",
+		"col.source":           "منبع",
+		"cmd.more_like":        "ایشوهای باز مشابه ایشویی که تمام کرده‌اید",
+		"whynot.title":         "چرا نه %s/%s#%d",
+		"whynot.rejected":      "در مرحله %s در %s رد شد:",
+		"whynot.seen":          "رد نشده است: این ایشو قبلاً دیده و اعلان شده است.",
+		"whynot.would_reject":  "هنوز دیده نشده، اما فیلتر %s اکنون آن را رد می‌کند:",
+		"whynot.passes":        "رد نشده و هنوز دیده نشده است؛ از فیلترهای فعلی عبور می‌کند.",
+		"cmd.why_not":          "توضیح دلیل فیلتر شدن یک ایشو",
+		"migrate.seen_running": "در حال تکمیل شناسه‌های node برای ایشوهای دیده‌شده...",
+		"migrate.seen_done":    "%d ایشوی دیده‌شده به‌روزرسانی شد، %d رد شد.",
+		"cmd.migrate_seen":     "تکمیل شناسه‌های node برای ایشوهای دیده‌شده",
+		"drift.title":          "ناهمخوانی بین پایگاه داده و گیت‌هاب",
+		"drift.checked":        "%d ایشوی پیگیری‌شده و %d کامنت ثبت‌شده بررسی شد.",
+		"drift.none":           "ناهمخوانی‌ای پیدا نشد.",
+		"cmd.drift":            "مقایسه وضعیت ذخیره‌شده ایشوها و کامنت‌ها با گیت‌هاب",
+		"selftest.title":       "خودآزمایی روی مخزن آزمایشی",
+		"selftest.summary":     "%d موفق، %d ناموفق، %d ردشده.",
+		"cmd.selftest":         "بررسی اعتبارنامه و کل مسیر روی مخزن آزمایشی شما",
+		"export.written":       "آمار با %d ایشو در %s ذخیره شد",
+		"cmd.stats_export":     "خروجی JSON آمار، با پنهان‌سازی جداگانهٔ هر فیلد برای اشتراک",
+		"cmd.regressions":      "یافتن رگرسیون‌هایی که چند روز پس از انتشار در مخازن دنبال‌شده گزارش شده‌اند",
+		"regression.title":     "🔁 رگرسیون‌های گزارش‌شده تا %d روز پس از انتشار",
+		"regression.none":      "پس از انتشارهای اخیر رگرسیونی یافت نشد.",
+		"regression.after":     "%s (%s)، %d روز بعد گزارش شد",
+		"cmd.init":             "ساخت فهرست پروژه‌های Go از ستاره‌ها یا مخزن‌های دنبال‌شدهٔ شما",
+		"init.scanned":         "%d مخزن بررسی شد: %d غیر %s، %d زیر %d ستاره، %d بایگانی‌شده، فورک یا مستثنا",
+		"init.dry_run":         "%d مخزن به %s اضافه می‌شد (اجرای آزمایشی)",
+		"init.written":         "%d مخزن به %s اضافه شد (%d مورد در فهرست)",
+		"lite.summary":         "حالت سبک: %d از %d درخواست مصرف شد، %d از %d مخزن پایش‌شده جستجو شد.",
+		"lite.uncovered":       "%d مخزن به دلیل تمام شدن سهمیهٔ درخواست جستجو نشد.",
+		"lite.truncated":       "%d جستجو نتایج بیشتری از موارد دریافت‌شده داشت؛ نتایج قدیمی‌تر نادیده گرفته شد.",
+		"lite.skipped":         "در حالت سبک بررسی نشد: %s.",
+		"lite.skip_labels":     "ایشوهای بدون برچسب‌های %s",
+		"lite.skip_age":        "ایشوهای قدیمی‌تر از %d روز",
+		"lite.skip_epics":      "زیرایشوهای اپیک‌ها",
+		"lite.skip_drift":      "بررسی ناهمخوانی",
+	},
+
+	LocaleSpanish: {
+		"usage.title":            "GitHub Issue Finder - Encuentra issues adecuados",
+		"usage.line":             "Uso: github-issue-finder <comando> [opciones]",
+		"usage.commands":         "Comandos:",
+		"usage.monitor_commands": "Comandos de monitorización:",
+		"usage.mcp_commands":     "Comandos del servidor MCP:",
+		"usage.output_options":   "Opciones de salida (cualquier comando):",
+		"usage.notify_options":   "Opciones de notificación:",
+		"usage.smart_limits":     "Configuración de límites inteligentes:",
+		"usage.examples":         "Ejemplos:",
+
+		"cmd.start":          "Iniciar la búsqueda diaria automática",
+		"cmd.search":         "Búsqueda única",
+		"cmd.preview":        "Previsualizar los comentarios que se publicarían (simulación)",
+		"cmd.commit":         "Publicar los comentarios",
+		"cmd.limits":         "Mostrar el estado de los límites inteligentes",
+		"cmd.comment":        "Comentar en un issue concreto",
+		"cmd.status":         "Mostrar el estado de hoy",
+		"cmd.config":         "Configurar ajustes",
+		"cmd.enable":         "Activar el modo automático",
+		"cmd.disable":        "Desactivar el modo automático",
+		"cmd.repos":          "Listar los repositorios gestionados",
+		"cmd.repos_add":      "Añadir repositorio",
+		"cmd.repos_remove":   "Eliminar repositorio",
+		"cmd.history":        "Mostrar el historial de comentarios",
+		"cmd.find":           "Buscar issues adecuados (predeterminado)",
+		"cmd.bugs":           "Buscar issues de errores adecuados",
+		"cmd.features":       "Buscar issues de funcionalidades adecuados",
+		"cmd.notify":         "Buscar issues adecuados y enviar notificaciones",
+		"cmd.mine":           "Revisar tus issues asignados",
+		"cmd.stats":          "Mostrar estadísticas y el embudo del pipeline por ejecución y por semana",
+		"cmd.digest":         "Mostrar el resumen diario de issues",
+		"cmd.track":          "Seguir un issue en el que trabajas",
+		"cmd.update":         "Actualizar el estado o las notas de un issue seguido",
+		"cmd.list":           "Listar los issues seguidos",
+		"cmd.email_test":     "Probar la configuración de correo",
+		"cmd.cleanup":        "Limpiar registros antiguos de notificaciones",
+		"cmd.open_link":      "Abrir un enlace de seguir/posponer/previsualizar desde una alerta",
+		"cmd.monitor_start":  "Iniciar el servicio de monitorización continua",
+		"cmd.monitor_stop":   "Detener el servicio de monitorización",
+		"cmd.monitor_status": "Mostrar el estado y la configuración del monitor",
+		"cmd.monitor_check":  "Ejecutar ahora una comprobación de monitorización",
+		"cmd.monitor_notify": "Probar el sistema de notificaciones",
+		"cmd.mcp":            "Ejecutar como servidor MCP (modo stdio para Claude Desktop, etc.)",
+		"cmd.mcp_http":       "Ejecutar como servidor HTTP de MCP (para integraciones web)",
+		"cmd.mcp_list_tools": "Listar todas las herramientas MCP disponibles",
+		"cmd.mcp_test":       "Probar el funcionamiento del servidor MCP",
+		"cmd.extension_api":  "Ejecutar la API local que usa la extensión del navegador",
+
+		"opt.plain":         "Salida apta para lectores de pantalla: sin emoji, solo ASCII, sin color",
+		"opt.no_emoji":      "Reemplazar o quitar emoji manteniendo el formato habitual",
+		"opt.no_color":      "Desactivar colores (también vía NO_COLOR)",
+		"opt.lang":          "Idioma de los mensajes: en, fa, es (también ISSUE_FINDER_LANG)",
+		"opt.seed":          "Semilla para el muestreo; la semilla de cada ejecución queda en el log",
+		"opt.deterministic": "Semilla fija y orden estable para pruebas y comparaciones",
+		"opt.lite":          "Solo la API de búsqueda, dentro de LITE_MAX_REQUESTS solicitudes",
+		"opt.email":         "Enviar correo para issues con puntuación alta (>0.7)",
+		"opt.local":         "Enviar notificaciones locales/de escritorio (predeterminado)",
+		"opt.no_local":      "Desactivar notificaciones locales",
+		"opt.score_min":     "Puntuación mínima (predeterminado: 0.6)",
+
+		"usage.limit_base":     "Límite diario base: %d comentarios",
+		"usage.limit_max":      "Límite diario máximo: %d comentarios (con issues de alta calidad)",
+		"usage.limit_weekly":   "Tope semanal: %d comentarios",
+		"usage.limit_per_repo": "Máximo por repositorio al día: %d comentario",
+
+		"find.searching":            "Buscando issues...",
+		"find.searching_good_first": "Buscando good first issues...",
+		"find.none_after_filter":    "No se encontraron issues nuevos tras el filtrado.",
+		"find.title_new":            "NUEVOS ISSUES ENCONTRADOS",
+		"find.title_good_first":     "GOOD FIRST ISSUES",
+		"find.none_good_first":      "No se encontraron good first issues.",
+
+		"print.total_found":       "Total encontrado: %d issues",
+		"actionable.title":        "ISSUES ACCIONABLES DE PROYECTOS GO CON TLS",
+		"actionable.subtitle":     "(Good first issues, bugs, mejoras - sin requerir Go 1.26)",
+		"actionable.none":         "No se encontraron issues accionables.",
+		"actionable.good_first":   "GOOD FIRST ISSUES (%d issues)",
+		"actionable.bugs":         "BUGS (%d issues)",
+		"actionable.enhancements": "MEJORAS (%d issues)",
+		"goupgrade.title":         "ISSUES DE ACTUALIZACIÓN DE GO EN PROYECTOS CON TLS",
+		"goupgrade.none":          "No se encontraron issues de actualización de Go.",
+		"confirmed.title":         "GOOD FIRST ISSUES CON ETIQUETA DE CONFIRMACIÓN (listos para asignar)",
+		"confirmed.criteria":      "Criterio: good first issue + confirmed/triage/accepted, sin asignado, sin PR",
+		"confirmed.none":          "No se encontraron issues que coincidan.",
+		"confirmed.eligible":      "ASIGNABLES (%d issues)",
+		"confirmed.ineligible":    "NO ASIGNABLES (%d issues)",
+		"confirmed.has_assignee":  "tiene asignado",
+		"confirmed.has_pr":        "tiene un PR vinculado",
+
+		"monitor.title":         "ESTADO DEL MONITOR",
+		"monitor.not_running":   "En ejecución: ningún servicio activo",
+		"monitor.start_hint":    "Nota: usa 'monitor start' para empezar a monitorizar",
+		"monitor.defaults":      "Configuración predeterminada:",
+		"monitor.interval":      "Intervalo de revisión: %v",
+		"monitor.min_score":     "Puntuación mínima: %.2f",
+		"monitor.max_issues":    "Máximo de issues por revisión: %d",
+		"monitor.notifications": "Notificaciones: local=%v, correo=%v",
+		"monitor.repos":         "Repositorios: %d",
+		"monitor.by_category":   "Repositorios monitorizados (por categoría):",
+		"monitor.category":      "%s (%d repos):",
+
+		"track.tracking": "✅ Siguiendo el issue: %s",
+		"track.status":   "   Estado: %s",
+
+		"list.usage_hint": "Usa --status <estado>, --older-than <edad>, --project <org/nombre>, --label <etiqueta> o --all para listar issues",
+		"list.none":       "No hay issues seguidos.",
+		"list.title":      "Issues seguidos (%d en total)",
+
+		"digest.none":       "No hay issues en el resumen de hoy.",
+		"digest.title":      "RESUMEN DIARIO DE ISSUES",
+		"digest.date":       "Fecha: %s",
+		"digest.empty":      "No se encontraron issues para el resumen de hoy.",
+		"digest.found":      "Se encontraron %d issues",
+		"digest.good_first": "🔥 Good First Issues (%d):",
+		"digest.other":      "📋 Otros issues (%d):",
+		"digest.more":       "   ... y %d más",
+		"digest.sending":    "Enviando el resumen por correo...",
+		"digest.sent":       "¡Resumen por correo enviado correctamente!",
+
+		"stats.title":         "📊 Estadísticas de GitHub Issue Finder",
+		"stats.active":        "Issues activos en seguimiento: %d",
+		"stats.notifications": "Estadísticas de notificaciones:",
+		"stats.email":         "Estadísticas de correo:",
+
+		"stats.funnel":          "Embudo del pipeline:",
+		"stats.funnel_none":     "Aún no hay ejecuciones registradas. Cada revisión del servicio, ejecución de find y ejecución de AutoFinder registra una.",
+		"stats.funnel_runs":     "Últimas %d ejecuciones (umbral de puntuación %.2f):",
+		"stats.funnel_weeks":    "Últimas %d semanas (ejecuciones por semana entre paréntesis):",
+		"stats.funnel_drop":     "Mayor caída: %s → %s (se conserva el %.0f%%)",
+		"col.run":               "Ejecución",
+		"col.week":              "Semana",
+		"stage.fetched":         "Obtenidas",
+		"stage.passed":          "Filtradas",
+		"stage.above_threshold": "Puntuadas",
+		"stage.notified":        "Notificadas",
+		"stage.tracked":         "Seguidas",
+		"stage.commented":       "Comentadas",
+		"stage.assigned":        "Asignadas",
+		"stage.merged":          "Fusionadas",
+
+		"limits.title":             "📊 LÍMITES INTELIGENTES DE COMENTARIOS",
+		"limits.not_initialized":   "El limitador inteligente no está inicializado (requiere conexión a la base de datos).",
+		"limits.not_available":     "El limitador inteligente no está disponible.",
+		"limits.defaults":          "Configuración predeterminada",
+		"limits.daily":             "📅 Límites diarios",
+		"limits.weekly":            "📆 Límites semanales",
+		"limits.quality":           "🎯 Umbrales de calidad",
+		"limits.repos_today":       "📝 Repositorios comentados hoy:",
+		"limits.tips":              "💡 Consejos:",
+		"limits.comments_today":    "Comentarios hoy",
+		"limits.repos_commented":   "Repositorios comentados",
+		"limits.base_limit":        "Límite base",
+		"limits.max_limit":         "Límite máximo (alta calidad)",
+		"limits.remaining_today":   "Restantes hoy",
+		"limits.comments_week":     "Comentarios esta semana",
+		"limits.weekly_cap":        "Tope semanal",
+		"limits.remaining_week":    "Restantes esta semana",
+		"limits.quality_threshold": "Umbral de calidad (excelente)",
+		"limits.min_score":         "Puntuación mínima para comentar",
+
+		"col.score":    "Puntos",
+		"col.grade":    "Nota",
+		"col.project":  "Proyecto",
+		"col.comments": "Coms",
+		"col.title":    "Título",
+		"col.url":      "URL",
+		"col.status":   "Estado",
+		"col.notes":    "Notas",
+
+		"field.score":       "Puntuación",
+		"field.project":     "Proyecto",
+		"field.comments":    "Comentarios",
+		"field.thread":      "Hilo",
+		"field.release":     "Versión",
+		"field.sign_off":    "Firma",
+		"field.confidence":  "Confianza",
+		"confidence.high":   "alta",
+		"confidence.medium": "media",
+		"confidence.low":    "baja",
+		"field.category":    "Categoría",
+		"field.url":         "URL",
+		"field.labels":      "Etiquetas",
+		"field.created":     "Creado",
+		"field.title":       "Título",
+		"field.stars":       "Estrellas",
+
+		"reading.minutes": "~%d min de lectura",
+
+		"telegram.header":       "🚀 *Nuevas oportunidades de aprendizaje en proyectos Go DevOps*",
+		"notify.desktop":        "🔔 NOTIFICACIÓN DE ESCRITORIO",
+		"notify.qualified":      "🎯 Issue adecuado: %s",
+		"deeplink.track":        "Seguir",
+		"deeplink.snooze":       "Posponer",
+		"deeplink.preview":      "Previsualizar",
+		"email.new_heading":     "%s Nuevo issue encontrado",
+		"email.new_tagline":     "¡Te espera una gran oportunidad de aprendizaje!",
+		"email.new_text":        "¡Nuevo issue encontrado!",
+		"email.view_issue":      "Ver issue →",
+		"email.breakdown":       "Desglose de la puntuación",
+		"email.total_score":     "Puntuación total",
+		"email.digest_subject":  "📰 Resumen diario de issues - %s (%d issues)",
+		"email.digest_text":     "Resumen diario de issues - %s",
+		"email.digest_count":    "%d issues encontrados",
+		"email.digest_gfi":      "🔥 Good First Issues",
+		"email.digest_other":    "📋 Otras oportunidades",
+		"email.digest_more_gfi": "... y %d good first issues más",
+		"email.digest_more":     "... y %d issues más",
+		"email.comments_count":  "%d comentarios",
+
+		"explore.searching":    "Cargando issues descubiertos recientemente para muestrear...",
+		"explore.none":         "No se descubrieron issues en los últimos %d días. Ejecuta find o el servicio primero.",
+		"explore.title":        "EXPLORAR: %d issues muestreados de %d",
+		"explore.seed":         "Semilla %d - usa --seed %d para obtener la misma muestra.",
+		"col.category":         "Categoría",
+		"col.band":             "Franja",
+		"cmd.explore":          "Muestra aleatoria por categorías y franjas de puntuación",
+		"morelike.title":       "SIMILARES A %s/%s#%d",
+		"morelike.fingerprint": "Coincidencia por %s",
+		"morelike.searching":   "Buscando issues abiertos en %d repositorios...",
+		"morelike.none":        "No hay issues abiertos similares entre %d candidatos.",
+		"col.match":            "Similitud",
+		"col.shared":           "En común",
+		"col.kind":             "Tipo",
+		"col.stored":           "Guardado",
+		"col.live":             "Actual",
+		"col.fixed":            "Corregido",
+		"col.step":             "Paso",
+		"col.detail":           "Detalle",
+		"col.stars":            "Estrellas",
+		"col.source":           "Origen",
+		"cmd.more_like":        "Issues abiertos similares a uno que completaste",
+		"whynot.title":         "POR QUÉ NO %s/%s#%d",
+		"whynot.rejected":      "Rechazado en la etapa %s el %s:",
+		"whynot.seen":          "No rechazado: el issue ya fue visto y notificado.",
+		"whynot.would_reject":  "Aún no visto, pero el filtro %s lo rechazaría ahora:",
+		"whynot.passes":        "No rechazado y aún no visto; pasa los filtros actuales.",
+		"cmd.why_not":          "Explicar por qué se filtró un issue",
+		"migrate.seen_running": "Completando IDs de nodo de issues ya vistos...",
+		"migrate.seen_done":    "Se actualizaron %d issues vistos, se omitieron %d.",
+		"cmd.migrate_seen":     "Completar IDs de nodo de issues ya vistos",
+		"drift.title":          "DIFERENCIAS ENTRE LA BASE DE DATOS Y GITHUB",
+		"drift.checked":        "Se revisaron %d issues seguidos y %d comentarios registrados.",
+		"drift.none":           "No se encontraron diferencias.",
+		"cmd.drift":            "Comparar el estado guardado de issues y comentarios con GitHub",
+		"selftest.title":       "AUTOPRUEBA CONTRA EL REPOSITORIO DE PRUEBAS",
+		"selftest.summary":     "%d correctos, %d fallidos, %d omitidos.",
+		"cmd.selftest":         "Verificar credenciales y todo el flujo contra tu repositorio de pruebas",
+		"export.written":       "Estadísticas con %d issues exportadas a %s",
+		"cmd.stats_export":     "Exportar estadísticas en JSON, con ocultación por campo para compartir",
+		"cmd.regressions":      "Buscar regresiones reportadas días después de una versión en repos vigilados",
+		"regression.title":     "🔁 Regresiones reportadas hasta %d días después de una versión",
+		"regression.none":      "No se encontraron regresiones tras versiones recientes.",
+		"regression.after":     "%s (%s), reportada %d días después",
+		"cmd.init":             "Crear una lista de proyectos Go a partir de tus estrellas o repos seguidos",
+		"init.scanned":         "%d repositorios revisados: %d no son %s, %d con menos de %d estrellas, %d archivados, forks o excluidos",
+		"init.dry_run":         "Se añadirían %d repositorios a %s (simulación)",
+		"init.written":         "%d repositorios añadidos a %s (%d en la lista)",
+		"lite.summary":         "Modo ligero: se usaron %d de %d solicitudes y se buscaron %d de %d repositorios vigilados.",
+		"lite.uncovered":       "%d repositorios no se buscaron porque se agotó el presupuesto de solicitudes.",
+		"lite.truncated":       "%d consultas tenían más resultados de los obtenidos; se omitieron los más antiguos.",
+		"lite.skipped":         "No cubierto en modo ligero: %s.",
+		"lite.skip_labels":     "issues sin las etiquetas %s",
+		"lite.skip_age":        "issues abiertos hace más de %d días",
+		"lite.skip_epics":      "sub-issues de épicas",
+		"lite.skip_drift":      "comprobaciones de diferencias",
+	},
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Locale
+	}{
+		{"fa", LocaleFarsi},
+		{"fa_IR.UTF-8", LocaleFarsi},
+		{"es-MX", LocaleSpanish},
+		{"ES", LocaleSpanish},
+		{"en_US.UTF-8", LocaleEnglish},
+		{"C.UTF-8", LocaleEnglish},
+		{"de_DE", LocaleEnglish},
+		{"", LocaleEnglish},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := normalizeLocale(tt.input); got != tt.expected {
+				t.Errorf("normalizeLocale(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConfigureLocale(t *testing.T) {
+	defer func(locale Locale) { activeLocale = locale }(activeLocale)
+
+	tests := []struct {
+		name      string
+		args      []string
+		env       map[string]string
+		locale    Locale
+		remaining int
+	}{
+		{"default", []string{"find"}, nil, LocaleEnglish, 1},
+		{"flag", []string{"--lang", "fa", "list", "--all"}, nil, LocaleFarsi, 2},
+		{"flag with equals", []string{"stats", "--lang=es"}, nil, LocaleSpanish, 1},
+		{"env override", []string{"find"}, map[string]string{"ISSUE_FINDER_LANG": "es"}, LocaleSpanish, 1},
+		{"posix locale", []string{"find"}, map[string]string{"LANG": "fa_IR.UTF-8"}, LocaleFarsi, 1},
+		{"flag wins over env", []string{"--lang", "en"}, map[string]string{"ISSUE_FINDER_LANG": "fa"}, LocaleEnglish, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"ISSUE_FINDER_LANG", "LC_ALL", "LC_MESSAGES", "LANG"} {
+				t.Setenv(key, "")
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			remaining := ConfigureLocale(tt.args)
+			if activeLocale != tt.locale {
+				t.Errorf("activeLocale = %q, want %q", activeLocale, tt.locale)
+			}
+			if len(remaining) != tt.remaining {
+				t.Errorf("remaining args = %v, want %d args", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestTL_Fallback(t *testing.T) {
+	if got := TL(LocaleSpanish, "list.title", 3); got != "Issues seguidos (3 en total)" {
+		t.Errorf("TL(es) = %q", got)
+	}
+	if got := TL(Locale("de"), "list.title", 3); got != "Tracked Issues (3 total)" {
+		t.Errorf("TL(unknown locale) = %q, want English fallback", got)
+	}
+	if got := TL(LocaleFarsi, "no.such.key"); got != "no.such.key" {
+		t.Errorf("TL(missing key) = %q, want the key itself", got)
+	}
+}
+
+func TestMessageCatalogConsistency(t *testing.T) {
+	english := messageCatalog[LocaleEnglish]
+
+	for locale, messages := range messageCatalog {
+		if locale == LocaleEnglish {
+			continue
+		}
+		for key, message := range messages {
+			source, ok := english[key]
+			if !ok {
+				t.Errorf("%s: key %q has no English source", locale, key)
+				continue
+			}
+			if got, want := strings.Count(message, "%"), strings.Count(source, "%"); got != want {
+				t.Errorf("%s: key %q has %d format verbs, English has %d", locale, key, got, want)
+			}
+		}
+	}
+}
+
+func TestMessageCatalogComplete(t *testing.T) {
+	for locale, messages := range messageCatalog {
+		if locale == LocaleEnglish {
+			continue
+		}
+		for key := range messageCatalog[LocaleEnglish] {
+			if _, ok := messages[key]; !ok {
+				t.Errorf("%s: missing translation for %q", locale, key)
+			}
+		}
+	}
+}
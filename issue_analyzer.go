@@ -48,13 +48,13 @@ type IssueAnalysis struct {
 }
 
 type IssueAnalyzer struct {
-	client     *github.Client
+	client     GitHubAPI
 	commentMgr *CommentManager
 	username   string
 	avoidRepos map[string]bool
 }
 
-func NewIssueAnalyzer(client *github.Client, commentMgr *CommentManager, username string) *IssueAnalyzer {
+func NewIssueAnalyzer(client GitHubAPI, commentMgr *CommentManager, username string) *IssueAnalyzer {
 	avoidRepos := map[string]bool{
 		"golang/go":         true,
 		"grafana/grafana":   true,
@@ -75,7 +75,7 @@ func (a *IssueAnalyzer) ShouldSkipRepo(owner, repo string) bool {
 }
 
 func (a *IssueAnalyzer) AnalyzeIssue(ctx context.Context, owner, repo string, number int) (*IssueAnalysis, error) {
-	issue, _, err := a.client.Issues.Get(ctx, owner, repo, number)
+	issue, _, err := a.client.GetIssue(ctx, owner, repo, number)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
@@ -191,7 +191,7 @@ func (a *IssueAnalyzer) MeetsAssignmentCriteria(criteria IssueFilterCriteria) bo
 }
 
 func (a *IssueAnalyzer) hasUserCommented(ctx context.Context, owner, repo string, number int) (bool, error) {
-	comments, _, err := a.client.Issues.ListComments(ctx, owner, repo, number, nil)
+	comments, _, err := a.client.ListIssueComments(ctx, owner, repo, number, nil)
 	if err != nil {
 		return false, err
 	}
@@ -206,7 +206,7 @@ func (a *IssueAnalyzer) hasUserCommented(ctx context.Context, owner, repo string
 
 func (a *IssueAnalyzer) hasLinkedPR(ctx context.Context, owner, repo string, number int) bool {
 	query := fmt.Sprintf("repo:%s/%s is:pr %d in:body", owner, repo, number)
-	result, _, err := a.client.Search.Issues(ctx, query, nil)
+	result, _, err := a.client.SearchIssues(ctx, query, nil)
 	if err != nil {
 		return false
 	}
@@ -214,7 +214,7 @@ func (a *IssueAnalyzer) hasLinkedPR(ctx context.Context, owner, repo string, num
 }
 
 func (a *IssueAnalyzer) canUserSelfAssign(ctx context.Context, owner, repo string) bool {
-	repoInfo, _, err := a.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := a.client.GetRepository(ctx, owner, repo)
 	if err != nil {
 		return false
 	}
@@ -231,13 +231,13 @@ func (a *IssueAnalyzer) canBotAssign(ctx context.Context, owner, repo string) bo
 
 	for _, label := range botAssignLabels {
 		query := fmt.Sprintf("repo:%s/%s label:\"%s\"", owner, repo, label)
-		result, _, err := a.client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+		result, _, err := a.client.SearchIssues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
 		if err == nil && *result.Total > 0 {
 			return true
 		}
 	}
 
-	botMentions, _, err := a.client.Search.Issues(ctx,
+	botMentions, _, err := a.client.SearchIssues(ctx,
 		fmt.Sprintf("repo:%s/%s /assign in:comments", owner, repo), nil)
 	if err == nil && *botMentions.Total > 0 {
 		return true
@@ -319,7 +319,7 @@ type BatchAnalyzer struct {
 	analyzer *IssueAnalyzer
 }
 
-func NewBatchAnalyzer(client *github.Client, commentMgr *CommentManager, username string) *BatchAnalyzer {
+func NewBatchAnalyzer(client GitHubAPI, commentMgr *CommentManager, username string) *BatchAnalyzer {
 	return &BatchAnalyzer{
 		analyzer: NewIssueAnalyzer(client, commentMgr, username),
 	}
@@ -365,46 +365,46 @@ func (b *BatchAnalyzer) GroupByAction(analyses []*IssueAnalysis) map[IssueAction
 func (b *BatchAnalyzer) PrintReport(analyses []*IssueAnalysis) {
 	groups := b.GroupByAction(analyses)
 
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("ISSUE ANALYSIS REPORT")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n" + strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "ISSUE ANALYSIS REPORT")
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 
 	if issues, ok := groups[ActionComment]; ok && len(issues) > 0 {
-		fmt.Printf("\n✅ READY TO COMMENT (%d issues):\n", len(issues))
+		fmt.Fprintf(stdout, "\n✅ READY TO COMMENT (%d issues):\n", len(issues))
 		for _, a := range issues {
-			fmt.Printf("   • %s/%s#%d: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, truncate(a.Title, 50))
+			fmt.Fprintf(stdout, "   • %s/%s#%d: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, truncate(a.Title, 50))
 		}
 	}
 
 	if issues, ok := groups[ActionRequestAssign]; ok && len(issues) > 0 {
-		fmt.Printf("\n⏳ READY FOR ASSIGNMENT (%d issues):\n", len(issues))
+		fmt.Fprintf(stdout, "\n⏳ READY FOR ASSIGNMENT (%d issues):\n", len(issues))
 		for _, a := range issues {
-			fmt.Printf("   • %s/%s#%d - Use: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, a.SuggestedBody)
+			fmt.Fprintf(stdout, "   • %s/%s#%d - Use: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, a.SuggestedBody)
 		}
 	}
 
 	if issues, ok := groups[ActionSkipNeedsTriage]; ok && len(issues) > 0 {
-		fmt.Printf("\n⏸️ WAITING FOR TRIAGE (%d issues):\n", len(issues))
+		fmt.Fprintf(stdout, "\n⏸️ WAITING FOR TRIAGE (%d issues):\n", len(issues))
 		for _, a := range issues {
-			fmt.Printf("   • %s/%s#%d: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, truncate(a.Title, 50))
+			fmt.Fprintf(stdout, "   • %s/%s#%d: %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, truncate(a.Title, 50))
 		}
 	}
 
 	if issues, ok := groups[ActionSkipAssigned]; ok && len(issues) > 0 {
-		fmt.Printf("\n❌ ALREADY ASSIGNED (%d issues):\n", len(issues))
+		fmt.Fprintf(stdout, "\n❌ ALREADY ASSIGNED (%d issues):\n", len(issues))
 		for _, a := range issues {
-			fmt.Printf("   • %s/%s#%d → %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, strings.Join(a.Assignees, ", "))
+			fmt.Fprintf(stdout, "   • %s/%s#%d → %s\n", a.ProjectOwner, a.ProjectName, a.IssueNumber, strings.Join(a.Assignees, ", "))
 		}
 	}
 
 	if issues, ok := groups[ActionSkipHasPR]; ok && len(issues) > 0 {
-		fmt.Printf("\n🔀 HAS PR (%d issues):\n", len(issues))
+		fmt.Fprintf(stdout, "\n🔀 HAS PR (%d issues):\n", len(issues))
 		for _, a := range issues {
-			fmt.Printf("   • %s/%s#%d\n", a.ProjectOwner, a.ProjectName, a.IssueNumber)
+			fmt.Fprintf(stdout, "   • %s/%s#%d\n", a.ProjectOwner, a.ProjectName, a.IssueNumber)
 		}
 	}
 
-	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "\n" + strings.Repeat("=", 60))
 }
 
 func truncate(s string, maxLen int) string {
@@ -124,40 +124,29 @@ func (t *IssueTracker) AddIssue(issue *TrackedIssue) error {
 	return err
 }
 
-func (t *IssueTracker) UpdateStatus(issueURL string, status WorkStatus) error {
-	var query string
-	var args []interface{}
-
-	now := time.Now()
-
+// updateStatusQuery takes $1 status, $2 timestamp and $3 issue URL.
+func updateStatusQuery(status WorkStatus) string {
 	switch status {
 	case StatusInProgress:
-		query = `
+		return `
 		UPDATE tracked_issues 
 		SET status = $1, started_at = $2, updated_at = $2 
 		WHERE issue_url = $3`
-		args = []interface{}{status, now, issueURL}
 	case StatusCompleted:
-		query = `
+		return `
 		UPDATE tracked_issues 
 		SET status = $1, completed_at = $2, updated_at = $2 
 		WHERE issue_url = $3`
-		args = []interface{}{status, now, issueURL}
-	case StatusAbandoned:
-		query = `
-		UPDATE tracked_issues 
-		SET status = $1, updated_at = $2 
-		WHERE issue_url = $3`
-		args = []interface{}{status, now, issueURL}
 	default:
-		query = `
+		return `
 		UPDATE tracked_issues 
 		SET status = $1, updated_at = $2 
 		WHERE issue_url = $3`
-		args = []interface{}{status, now, issueURL}
 	}
+}
 
-	result, err := t.db.Exec(query, args...)
+func (t *IssueTracker) UpdateStatus(issueURL string, status WorkStatus) error {
+	result, err := t.db.Exec(updateStatusQuery(status), status, time.Now(), issueURL)
 	if err != nil {
 		return err
 	}
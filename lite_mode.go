@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// GitHub rejects search queries longer than 256 characters.
+const maxSearchQueryLength = 256
+
+// LiteOptions controls lite discovery, which replaces per-repo listing with a
+// handful of Search API queries so a run fits in a small request budget.
+type LiteOptions struct {
+	Enabled     bool
+	MaxRequests int
+	MaxAgeDays  int
+	Labels      []string
+}
+
+var liteOptions = LiteOptions{MaxRequests: 50, MaxAgeDays: 30, Labels: []string{"good first issue", "help wanted"}}
+
+// ConfigureLite consumes --lite from args and returns the remaining
+// arguments. LITE_MODE, LITE_MAX_REQUESTS, LITE_MAX_AGE_DAYS and LITE_LABELS
+// are honored as well.
+func ConfigureLite(args []string) []string {
+	opts := LiteOptions{
+		Enabled:     getEnvBool("LITE_MODE", false),
+		MaxRequests: getEnvInt("LITE_MAX_REQUESTS", 50),
+		MaxAgeDays:  getEnvInt("LITE_MAX_AGE_DAYS", 30),
+		Labels:      []string{"good first issue", "help wanted"},
+	}
+	if v, ok := os.LookupEnv("LITE_LABELS"); ok {
+		opts.Labels = nil
+		for _, label := range strings.Split(v, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				opts.Labels = append(opts.Labels, label)
+			}
+		}
+	}
+
+	remaining := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--lite" {
+			opts.Enabled = true
+			continue
+		}
+		remaining = append(remaining, arg)
+	}
+
+	liteOptions = opts
+	return remaining
+}
+
+// LiteQuery is one search request covering a group of watched repositories.
+type LiteQuery struct {
+	Query string
+	Repos []string
+}
+
+// BuildLiteQueries packs repo: qualifiers for the given projects into as few
+// queries as fit under GitHub's query length limit.
+func BuildLiteQueries(projects []Project, opts LiteOptions, now time.Time) []LiteQuery {
+	base := "is:issue is:open no:assignee archived:false"
+	if opts.MaxAgeDays > 0 {
+		base += " created:>=" + now.AddDate(0, 0, -opts.MaxAgeDays).Format("2006-01-02")
+	}
+	if len(opts.Labels) > 0 {
+		quoted := make([]string, len(opts.Labels))
+		for i, label := range opts.Labels {
+			quoted[i] = strconv.Quote(label)
+		}
+		base += " label:" + strings.Join(quoted, ",")
+	}
+	return packRepoQueries(base, projects)
+}
+
+// packRepoQueries appends repo: qualifiers to base, starting a new query
+// whenever the next one would exceed the length limit.
+func packRepoQueries(base string, projects []Project) []LiteQuery {
+	var queries []LiteQuery
+	current := LiteQuery{Query: base}
+	for _, p := range projects {
+		repo := p.Org + "/" + p.Name
+		qualifier := " repo:" + repo
+		if len(current.Repos) > 0 && len(current.Query)+len(qualifier) > maxSearchQueryLength {
+			queries = append(queries, current)
+			current = LiteQuery{Query: base}
+		}
+		current.Query += qualifier
+		current.Repos = append(current.Repos, repo)
+	}
+	if len(current.Repos) > 0 {
+		queries = append(queries, current)
+	}
+	return queries
+}
+
+// LiteCoverage describes what a lite run did and did not look at.
+type LiteCoverage struct {
+	Requests      int
+	Budget        int
+	ReposSearched int
+	ReposTotal    int
+	Truncated     int
+	Options       LiteOptions
+}
+
+func (c LiteCoverage) Print(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, colorize(colorYellow, T("lite.summary", c.Requests, c.Budget, c.ReposSearched, c.ReposTotal)))
+	if missed := c.ReposTotal - c.ReposSearched; missed > 0 {
+		fmt.Fprintln(w, "  "+T("lite.uncovered", missed))
+	}
+	if c.Truncated > 0 {
+		fmt.Fprintln(w, "  "+T("lite.truncated", c.Truncated))
+	}
+
+	skipped := []string{T("lite.skip_epics"), T("lite.skip_drift")}
+	if c.Options.MaxAgeDays > 0 {
+		skipped = append([]string{T("lite.skip_age", c.Options.MaxAgeDays)}, skipped...)
+	}
+	if len(c.Options.Labels) > 0 {
+		skipped = append([]string{T("lite.skip_labels", strings.Join(c.Options.Labels, ", "))}, skipped...)
+	}
+	fmt.Fprintln(w, "  "+T("lite.skipped", strings.Join(skipped, "; ")))
+}
+
+// liteSearchInterval keeps searches under the Search API's per-minute limit:
+// 30 requests authenticated, 10 without a token.
+func liteSearchInterval(authenticated bool) time.Duration {
+	if authenticated {
+		return 2 * time.Second
+	}
+	return 6 * time.Second
+}
+
+// findIssuesLite is FindIssues restricted to the Search API. Epics are not
+// expanded and results beyond the first page of each query are only fetched
+// while budget remains.
+func (f *IssueFinder) findIssuesLite(ctx context.Context) ([]Issue, error) {
+	opts := liteOptions
+	start := apiRequestCount.Load()
+	used := func() int { return int(apiRequestCount.Load() - start) }
+
+	projectsByRepo := make(map[string]Project, len(f.projects))
+	for _, p := range f.projects {
+		projectsByRepo[strings.ToLower(p.Org+"/"+p.Name)] = p
+	}
+
+	queries := BuildLiteQueries(f.projects, opts, time.Now())
+	coverage := LiteCoverage{Budget: opts.MaxRequests, ReposTotal: len(f.projects), Options: opts}
+	interval := liteSearchInterval(f.config.GitHubToken != "")
+
+	log.Printf("[Lite] Searching %d repositories with %d queries (budget %d requests)", len(f.projects), len(queries), opts.MaxRequests)
+
+	var allIssues []Issue
+	var truncated []int
+	search := func(q LiteQuery, page int) (*github.IssuesSearchResult, error) {
+		var result *github.IssuesSearchResult
+		err := f.rateLimiter.executeWithRetry(ctx, "lite search", func() (*github.Response, error) {
+			var resp *github.Response
+			var apiErr error
+			result, resp, apiErr = f.client.SearchIssues(ctx, q.Query, &github.SearchOptions{
+				Sort:        "created",
+				Order:       "desc",
+				ListOptions: github.ListOptions{Page: page, PerPage: 100},
+			})
+			return resp, apiErr
+		})
+		return result, err
+	}
+
+	collect := func(result *github.IssuesSearchResult) {
+		for _, issue := range result.Issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			pipelineFetched.Add(1)
+			if len(issue.Assignees) > 0 {
+				continue
+			}
+			parts := strings.Split(issue.GetRepositoryURL(), "/")
+			if len(parts) < 2 {
+				continue
+			}
+			p, ok := projectsByRepo[strings.ToLower(parts[len(parts)-2]+"/"+parts[len(parts)-1])]
+			if !ok {
+				continue
+			}
+
+			issueID := fmt.Sprintf("%s/%d", p.Name, issue.GetNumber())
+			if f.isIssueSeen(issueID, issue.GetNodeID()) {
+				f.rekeySeenIssue(issueID, issue.GetNodeID(), p.Name)
+				continue
+			}
+
+			if isEpic, numbers := f.epicExpansion.SubIssues(issue.GetBody(), p.Org, p.Name); isEpic {
+				reason := fmt.Sprintf("umbrella issue; %d open sub-issues not expanded in lite mode", len(numbers))
+				rejection := IssueRejection{IssueID: issueID, IssueURL: issue.GetHTMLURL(), ProjectName: p.Name, Stage: RejectionStageEpic, Reason: reason}
+				if err := recordRejection(f.db, rejection); err != nil {
+					log.Printf("Error recording rejection for %s: %v", issueID, err)
+				}
+				continue
+			}
+
+			if newIssue, ok := f.acceptIssue(ctx, p, issue, issueID); ok {
+				allIssues = append(allIssues, newIssue)
+			}
+		}
+	}
+
+	for i, q := range queries {
+		if used() >= opts.MaxRequests || ctx.Err() != nil {
+			break
+		}
+		if i > 0 {
+			time.Sleep(interval)
+		}
+
+		result, err := search(q, 1)
+		if err != nil {
+			log.Printf("[Lite] Search failed for %d repositories: %v", len(q.Repos), err)
+			continue
+		}
+		coverage.ReposSearched += len(q.Repos)
+		collect(result)
+		if result.GetTotal() > len(result.Issues) {
+			truncated = append(truncated, i)
+		}
+	}
+
+	// Spend what is left of the budget on second pages of truncated queries.
+	for _, i := range truncated {
+		if used() >= opts.MaxRequests || ctx.Err() != nil {
+			coverage.Truncated++
+			continue
+		}
+		time.Sleep(interval)
+
+		result, err := search(queries[i], 2)
+		if err != nil {
+			log.Printf("[Lite] Second page failed: %v", err)
+			coverage.Truncated++
+			continue
+		}
+		collect(result)
+		if result.GetTotal() > 100+len(result.Issues) {
+			coverage.Truncated++
+		}
+	}
+
+	coverage.Requests = used()
+	logAPIUsage("Lite")
+	coverage.Print(stdout)
+
+	SortIssues(allIssues)
+	return allIssues, nil
+}
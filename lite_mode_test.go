@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildLiteQueries(t *testing.T) {
+	now := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	var projects []Project
+	for i := 0; i < 20; i++ {
+		projects = append(projects, Project{Org: "kubernetes-sigs", Name: fmt.Sprintf("controller-runtime-%d", i)})
+	}
+
+	queries := BuildLiteQueries(projects, LiteOptions{MaxAgeDays: 30, Labels: []string{"good first issue", "help wanted"}}, now)
+	if len(queries) < 2 {
+		t.Fatalf("expected repos to be split across queries, got %d", len(queries))
+	}
+
+	seen := 0
+	for _, q := range queries {
+		if len(q.Query) > maxSearchQueryLength {
+			t.Errorf("query is %d characters, over the %d limit: %s", len(q.Query), maxSearchQueryLength, q.Query)
+		}
+		if !strings.Contains(q.Query, `label:"good first issue","help wanted"`) {
+			t.Errorf("query missing label clause: %s", q.Query)
+		}
+		if !strings.Contains(q.Query, "created:>=2026-05-01") {
+			t.Errorf("query missing age clause: %s", q.Query)
+		}
+		for _, repo := range q.Repos {
+			if !strings.Contains(q.Query, "repo:"+repo) {
+				t.Errorf("query missing repo:%s", repo)
+			}
+		}
+		seen += len(q.Repos)
+	}
+	if seen != len(projects) {
+		t.Errorf("queries cover %d repos, want %d", seen, len(projects))
+	}
+}
+
+func TestBuildLiteQueries_NoFilters(t *testing.T) {
+	queries := BuildLiteQueries([]Project{{Org: "golang", Name: "go"}}, LiteOptions{}, time.Now())
+	if len(queries) != 1 {
+		t.Fatalf("got %d queries, want 1", len(queries))
+	}
+	if want := "is:issue is:open no:assignee archived:false repo:golang/go"; queries[0].Query != want {
+		t.Errorf("Query = %q, want %q", queries[0].Query, want)
+	}
+	if got := BuildLiteQueries(nil, LiteOptions{}, time.Now()); len(got) != 0 {
+		t.Errorf("expected no queries without projects, got %d", len(got))
+	}
+}
+
+func TestConfigureLite(t *testing.T) {
+	saved := liteOptions
+	defer func() { liteOptions = saved }()
+
+	t.Setenv("LITE_MAX_REQUESTS", "20")
+	t.Setenv("LITE_LABELS", "bug, ,good first issue")
+
+	remaining := ConfigureLite([]string{"--lite", "find", "--seed", "1"})
+	if strings.Join(remaining, " ") != "find --seed 1" {
+		t.Errorf("remaining = %v", remaining)
+	}
+	if !liteOptions.Enabled || liteOptions.MaxRequests != 20 {
+		t.Errorf("liteOptions = %+v", liteOptions)
+	}
+	if strings.Join(liteOptions.Labels, "|") != "bug|good first issue" {
+		t.Errorf("Labels = %v", liteOptions.Labels)
+	}
+}
+
+func TestLiteCoverage_Print(t *testing.T) {
+	var buf bytes.Buffer
+	LiteCoverage{
+		Requests: 12, Budget: 50, ReposSearched: 30, ReposTotal: 45, Truncated: 2,
+		Options: LiteOptions{MaxAgeDays: 30, Labels: []string{"help wanted"}},
+	}.Print(&buf)
+
+	out := buf.String()
+	for _, want := range []string{"12 of 50", "30 of 45", "15 repositories", "2 queries", "help wanted", "30 days"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("coverage report missing %q:\n%s", want, out)
+		}
+	}
+}
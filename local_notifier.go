@@ -84,81 +84,58 @@ func (n *LocalNotifier) logToNotificationsFile(title, url string, score float64,
 	}
 }
 
-func (n *LocalNotifier) SendIssuesAlert(issues []Issue) error {
+// SendIssuesAlert logs the issues locally and emails them in instant mode.
+// It returns the emails sent when email is on and instant, otherwise the
+// issues logged.
+func (n *LocalNotifier) SendIssuesAlert(issues []Issue) (int, error) {
 	if len(issues) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	log.Printf("[Notifier] Processing %d issues for local logging", len(issues))
 	n.logToFile(fmt.Sprintf("Found %d new issues", len(issues)))
 
 	for _, issue := range issues {
-		priority := "Medium"
-		if issue.Score >= 0.8 {
-			priority = "High"
-		} else if issue.Score < 0.6 {
-			priority = "Low"
-		}
-
 		n.logToConsole(issue)
-		n.logToNotificationsFile(issue.Title, issue.URL, issue.Score, priority)
+		n.logToNotificationsFile(issue.Title, issue.URL, issue.Score, scorePriority(issue.Score))
 	}
 
 	if n.emailSender != nil && n.emailConfig != nil {
 		if n.emailConfig.Mode == "digest" {
 			log.Printf("[Notifier] Digest mode enabled - skipping instant email")
-			return nil
+			return len(issues), nil
 		}
 
-		if err := n.sendEmailAlert(issues); err != nil {
-			n.logToFile(fmt.Sprintf("Failed to send email: %v", err))
-			log.Printf("[Notifier] Failed to send email: %v", err)
-		}
+		return n.sendEmailAlert(issues), nil
 	}
 
-	return nil
+	return len(issues), nil
 }
 
 func (n *LocalNotifier) logToConsole(issue Issue) {
-	emoji := ""
-	if issue.Score >= 0.8 {
-		emoji = "🔥"
-	} else if issue.Score >= 0.6 {
-		emoji = "⭐"
-	} else {
-		emoji = "✨"
-	}
-
-	fmt.Printf("\n%s %s\n", emoji, issue.Project.Category)
-	fmt.Printf("Score: %.2f | Stars: %d | Comments: %d\n", issue.Score, issue.Project.Stars, issue.Comments)
-	fmt.Printf("Title: %s\n", issue.Title)
-	fmt.Printf("URL: %s\n", issue.URL)
-	if len(issue.Labels) > 0 {
-		fmt.Printf("Labels: %s\n", strings.Join(issue.Labels, ", "))
-	}
-	fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02"))
-	fmt.Println(strings.Repeat("-", 80))
+	out, _ := getNotificationTemplates().Render(ChannelLocal, AlertNewIssue, TemplatePartBody, NotificationData{Issue: issue})
+	fmt.Fprint(stdout, out)
 }
 
-func (n *LocalNotifier) sendEmailAlert(issues []Issue) error {
-	if len(issues) == 0 {
-		return nil
-	}
-
+// sendEmailAlert emails each issue and returns how many emails were sent.
+func (n *LocalNotifier) sendEmailAlert(issues []Issue) int {
+	sent := 0
 	for _, issue := range issues {
 		breakdown := &ScoreBreakdown{
 			TotalScore: issue.Score,
 		}
 
 		if err := n.emailSender.SendNewIssueEmail(issue, breakdown); err != nil {
+			n.logToFile(fmt.Sprintf("Failed to send email: %v", err))
 			log.Printf("[Notifier] Failed to send email for issue %s: %v", issue.URL, err)
 			continue
 		}
 
 		n.logToFile(fmt.Sprintf("Email sent for issue: %s", issue.URL))
+		sent++
 	}
 
-	return nil
+	return sent
 }
 
 func (n *LocalNotifier) SendDigestEmail(issues []Issue) error {
@@ -171,6 +148,9 @@ func (n *LocalNotifier) SendDigestEmail(issues []Issue) error {
 		return nil
 	}
 
+	out, _ := getNotificationTemplates().Render(ChannelLocal, AlertDigest, TemplatePartBody, NotificationData{Issues: issues})
+	fmt.Fprint(stdout, out)
+
 	if err := n.emailSender.SendDigestEmail(issues); err != nil {
 		return fmt.Errorf("failed to send digest email: %w", err)
 	}
@@ -181,6 +161,9 @@ func (n *LocalNotifier) SendDigestEmail(issues []Issue) error {
 }
 
 func (n *LocalNotifier) SendAssignmentConfirmation(issue Issue) error {
+	out, _ := getNotificationTemplates().Render(ChannelLocal, AlertAssignmentConfirmed, TemplatePartBody, NotificationData{Issue: issue})
+	fmt.Fprint(stdout, out)
+
 	if n.emailSender == nil {
 		return nil
 	}
@@ -194,6 +177,9 @@ func (n *LocalNotifier) SendAssignmentConfirmation(issue Issue) error {
 }
 
 func (n *LocalNotifier) SendAssignmentRequest(issue Issue) error {
+	out, _ := getNotificationTemplates().Render(ChannelLocal, AlertAssignmentRequest, TemplatePartBody, NotificationData{Issue: issue})
+	fmt.Fprint(stdout, out)
+
 	if n.emailSender == nil {
 		return nil
 	}
@@ -270,11 +256,9 @@ func (n *LocalNotifier) SendQualifiedIssueEmail(issue QualifiedIssue) error {
 }
 
 func (n *LocalNotifier) SendDesktopNotification(issue QualifiedIssue) error {
-	title := fmt.Sprintf("🎯 Qualified Issue: %s", issue.Project.Name)
-	message := fmt.Sprintf("%s\nScore: %.2f | %s", issue.Title, issue.QualifiedScore.TotalScore, issue.Type)
-
 	n.logToNotificationsFile(issue.Title, issue.URL, issue.QualifiedScore.TotalScore, "Desktop")
 
-	_, err := fmt.Printf("\n🔔 DESKTOP NOTIFICATION\n%s\n%s\n", title, message)
+	out, _ := getNotificationTemplates().Render(ChannelLocal, AlertQualified, TemplatePartBody, NotificationData{Issue: issue.Issue, Qualified: &issue})
+	_, err := fmt.Fprint(stdout, out)
 	return err
 }
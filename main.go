@@ -19,7 +19,6 @@ import (
 	"github.com/google/go-github/v58/github"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
-	"golang.org/x/oauth2"
 )
 
 type Project struct {
@@ -40,6 +39,12 @@ type Issue struct {
 	Labels      []string
 	Language    string
 	IsGoodFirst bool
+	ReadingTime ReadingEstimate
+	SignOff     SignOffRequirement
+	// KeywordScore is the part of Score from text heuristics, and
+	// Confidence the share that is not.
+	KeywordScore float64
+	Confidence   float64
 }
 
 type IssueFilter struct {
@@ -63,6 +68,7 @@ type IssueResult struct {
 
 type IssueScorer struct {
 	weights map[string]float64
+	scoring *ScoringConfig
 }
 
 type RateLimitStatus struct {
@@ -74,11 +80,11 @@ type RateLimitStatus struct {
 type RateLimiter struct {
 	mu           sync.Mutex
 	status       RateLimitStatus
-	client       *github.Client
+	client       GitHubAPI
 	minRemaining int
 }
 
-func NewRateLimiter(client *github.Client, minRemaining int) *RateLimiter {
+func NewRateLimiter(client GitHubAPI, minRemaining int) *RateLimiter {
 	return &RateLimiter{
 		client:       client,
 		minRemaining: minRemaining,
@@ -206,6 +212,9 @@ func (r *RateLimiter) executeWithRetry(ctx context.Context, operation string, fn
 	return fmt.Errorf("max retries exceeded for %s", operation)
 }
 
+// maxIssueScore is the ceiling IssueScorer clamps to.
+const maxIssueScore = 1.5
+
 func NewIssueScorer() *IssueScorer {
 	return &IssueScorer{
 		weights: map[string]float64{
@@ -215,26 +224,36 @@ func NewIssueScorer() *IssueScorer {
 			"labels_factor":     0.25,
 			"difficulty_factor": 0.15,
 		},
+		scoring: loadScoringConfigFromEnv(),
 	}
 }
 
 func (s *IssueScorer) ScoreIssue(issue *github.Issue, project Project) float64 {
-	var score float64
-
-	starsScore := s.normalizeStars(project.Stars)
-	score += starsScore * s.weights["stars_factor"]
-
-	commentsScore := s.normalizeComments(*issue.Comments)
-	score += commentsScore * s.weights["comments_factor"]
-
-	recencyScore := s.normalizeRecency(issue.CreatedAt.Time)
-	score += recencyScore * s.weights["recency_factor"]
-
-	labelsScore := s.normalizeLabels(issue.Labels)
-	score += labelsScore * s.weights["labels_factor"]
+	return s.ScoreIssueWithConfidence(issue, project).Score
+}
 
-	difficultyScore := s.normalizeDifficulty(issue.Labels, safeString(issue.Body))
-	score += difficultyScore * s.weights["difficulty_factor"]
+// ScoreIssueWithConfidence scores an issue and reports how much of the score
+// rests on keyword hits in the title and body. Label and project signals
+// count in full; keyword hits are capped per category with diminishing
+// returns, so no single phrase can swing the score far.
+func (s *IssueScorer) ScoreIssueWithConfidence(issue *github.Issue, project Project) ScoreResult {
+	var score float64
+	var signals scoreSignals
+
+	factors := map[string]float64{
+		"stars_factor":      s.normalizeStars(project.Stars) * s.weights["stars_factor"],
+		"comments_factor":   s.normalizeComments(*issue.Comments) * s.weights["comments_factor"],
+		"recency_factor":    s.normalizeRecency(issue.CreatedAt.Time) * s.weights["recency_factor"],
+		"labels_factor":     s.normalizeLabels(issue.Labels) * s.weights["labels_factor"],
+		"difficulty_factor": s.normalizeDifficulty(issue.Labels, safeString(issue.Body)) * s.weights["difficulty_factor"],
+	}
+	// Summed in a fixed order so scores are identical run to run
+	score += factors["stars_factor"]
+	score += factors["comments_factor"]
+	score += factors["recency_factor"]
+	score += factors["labels_factor"]
+	score += factors["difficulty_factor"]
+	signals.base = score
 
 	title := strings.ToLower(safeString(issue.Title))
 	body := strings.ToLower(safeString(issue.Body))
@@ -242,26 +261,30 @@ func (s *IssueScorer) ScoreIssue(issue *github.Issue, project Project) float64 {
 
 	// Go 1.26 related issues - high priority
 	if strings.Contains(combined, "go 1.26") || strings.Contains(combined, "go1.26") || strings.Contains(combined, "golang 1.26") {
-		score += 0.30
+		signals.addKeyword(KeywordTopic, 0.30)
 	}
 	if strings.Contains(combined, "upgrade") && (strings.Contains(combined, "go ") || strings.Contains(combined, "golang")) {
-		score += 0.15
+		signals.addKeyword(KeywordTopic, 0.15)
 	}
 
-	// Good labels
-	if strings.Contains(combined, "good first issue") || hasLabel(issue.Labels, "good first issue") {
-		score += 0.20
+	// Good labels, or the same words in the text
+	if hasLabel(issue.Labels, "good first issue") {
+		signals.addStrong(0.20)
+	} else if strings.Contains(combined, "good first issue") {
+		signals.addKeyword(KeywordFriendliness, 0.20)
 	}
-	if strings.Contains(combined, "help wanted") || hasLabel(issue.Labels, "help wanted") {
-		score += 0.15
+	if hasLabel(issue.Labels, "help wanted") {
+		signals.addStrong(0.15)
+	} else if strings.Contains(combined, "help wanted") {
+		signals.addKeyword(KeywordFriendliness, 0.15)
 	}
 
 	// TLS/Security - user preference
 	if strings.Contains(strings.ToLower(project.Category), "tls") || strings.Contains(strings.ToLower(project.Category), "security") {
-		score += 0.10
+		signals.addStrong(0.10)
 	}
 	if strings.Contains(combined, "tls") || strings.Contains(combined, "ssl") || strings.Contains(combined, "certificate") || strings.Contains(combined, "https") {
-		score += 0.10
+		signals.addKeyword(KeywordTopic, 0.10)
 	}
 
 	// CNCF projects bonus - expanded list
@@ -276,33 +299,34 @@ func (s *IssueScorer) ScoreIssue(issue *github.Issue, project Project) float64 {
 	if slices.ContainsFunc(cncfProjects, func(p string) bool {
 		return strings.Contains(strings.ToLower(project.Name), p)
 	}) {
-		score += 0.15
+		signals.addStrong(0.15)
 	}
 
 	// Learning-focused bonuses
 	// Good first issue - best for learning
 	if hasLabel(issue.Labels, "good first issue") || hasLabel(issue.Labels, "good-first-issue") {
-		score += 0.25
+		signals.addStrong(0.25)
 	}
 
 	// Help wanted - maintainers actively seeking contributors
 	if hasLabel(issue.Labels, "help wanted") || hasLabel(issue.Labels, "help-wanted") {
-		score += 0.20
+		signals.addStrong(0.20)
 	}
 
 	// Beginner-friendly labels
 	beginnerLabels := []string{"beginner", "starter", "easy", "newcomer", "first-timers-only"}
 	for _, label := range beginnerLabels {
 		if hasLabel(issue.Labels, label) {
-			score += 0.15
+			signals.addStrong(0.15)
 			break
 		}
 	}
 
 	// Documentation-only issues - easier to contribute
-	if strings.Contains(combined, "documentation") || strings.Contains(combined, "docs") ||
-		strings.Contains(title, "doc:") || hasLabel(issue.Labels, "documentation") {
-		score += 0.15
+	if hasLabel(issue.Labels, "documentation") {
+		signals.addStrong(0.15)
+	} else if strings.Contains(combined, "documentation") || strings.Contains(combined, "docs") || strings.Contains(title, "doc:") {
+		signals.addKeyword(KeywordFriendliness, 0.15)
 	}
 
 	// Clear scope indicators - issue mentions specific files/functions
@@ -314,27 +338,32 @@ func (s *IssueScorer) ScoreIssue(issue *github.Issue, project Project) float64 {
 		}
 	}
 	if clearCount >= 2 {
-		score += 0.10
+		signals.addKeyword(KeywordClarity, 0.10)
 	}
 
 	// Clear reproduction steps - issues with code blocks or steps
 	if strings.Contains(body, "```") || strings.Contains(body, "steps to reproduce") ||
 		strings.Contains(body, "reproduc") {
-		score += 0.10
+		signals.addKeyword(KeywordClarity, 0.10)
 	}
 
 	// Easy/quick fix indicators
 	easyKeywords := []string{"quick", "easy", "simple", "trivial", "small", "minor", "typo", "spelling"}
 	if containsAny(combined, easyKeywords) {
-		score += 0.05
+		signals.addKeyword(KeywordFriendliness, 0.05)
 	}
 
 	// Stale but available - issues open for a while with no activity (1-6 months)
 	age := time.Since(issue.CreatedAt.Time).Hours()
 	if age > 720 && age < 4320 && *issue.Comments <= 3 {
-		score += 0.10
+		signals.addStrong(0.10)
 	}
 
+	keywords := signals.keywordScore(s.scoring)
+	score += signals.strong + keywords
+
+	var penalty float64
+
 	// Cloud provider penalty - user uses bare metal
 	cloudKeywords := []string{
 		"gcp", "google cloud", "compute engine", "gke", "cloud sql", "bigquery", "pubsub",
@@ -342,43 +371,56 @@ func (s *IssueScorer) ScoreIssue(issue *github.Issue, project Project) float64 {
 		"azure", "microsoft azure", "aks", "azure functions", "azure storage",
 	}
 	if containsAny(combined, cloudKeywords) {
-		score -= 0.50
+		penalty += 0.50
 	}
 
 	if hasAnyLabel(issue.Labels, "provider:google", "provider:aws", "provider:azure", "area/gcp", "area/aws", "area/azure") {
-		score -= 0.50
+		penalty += 0.50
 	}
 
 	// Needs triage penalty - can't work on until triaged
 	if hasLabel(issue.Labels, "needs-triage") {
-		score -= 0.15
+		penalty += 0.15
 	}
 
 	// Blocked/waiting penalty
 	blockedKeywords := []string{"blocked", "waiting for", "needs approval", "on hold", "pending"}
 	if containsAny(combined, blockedKeywords) {
-		score -= 0.20
+		penalty += 0.20
 	}
 
 	// Wontfix/invalid penalty
 	if hasAnyLabel(issue.Labels, "wontfix", "invalid", "duplicate", "wont-fix") {
-		score -= 0.50
+		penalty += 0.50
 	}
 
 	// Needs info penalty - incomplete issue
 	if hasAnyLabel(issue.Labels, "needs info", "needs-information", "waitingforinfo") {
-		score -= 0.15
+		penalty += 0.15
 	}
 
+	// Long-thread penalty - off by default, since the comment factor already
+	// counts the thread and the estimate is mostly comment count
+	penalty += readingTimePenalty(EstimateReadingTime(issue), s.scoring)
+
+	score -= penalty
+
 	// Clamp score
-	if score > 1.5 {
-		score = 1.5
+	if score > maxIssueScore {
+		score = maxIssueScore
 	}
 	if score < 0 {
 		score = 0
 	}
 
-	return score
+	return ScoreResult{
+		Score:      score,
+		Keywords:   keywords,
+		Confidence: signals.confidence(keywords),
+		Factors:    factors,
+		Bonus:      signals.strong,
+		Penalty:    penalty,
+	}
 }
 
 func hasLabel(labels []*github.Label, target string) bool {
@@ -544,14 +586,16 @@ func (s *IssueScorer) normalizeDifficulty(labels []*github.Label, body string) f
 
 type IssueFinder struct {
 	config        *Config
-	client        *github.Client
+	client        GitHubAPI
 	rateLimiter   *RateLimiter
 	bot           *tgbotapi.BotAPI
 	notifier      *LocalNotifier
 	db            *sqlx.DB
 	scorer        *IssueScorer
 	projects      []Project
-	seenIssues    map[string]bool
+	seenIssues    map[string]bool // legacy "<repo>/<number>" keys
+	seenNodes     map[string]bool // GitHub node IDs, stable across transfers
+	pendingMoves  []RepoMove      // repos found moved during the current run
 	tracker       *IssueTracker
 	assignmentMgr *AssignmentManager
 	antiSpam      *NotificationSpamManager
@@ -559,15 +603,15 @@ type IssueFinder struct {
 	repoManager   *RepoManager
 	fileStore     *FileStorage
 	monitor       *IssueMonitor
+	titleFilter   *TitleFilterConfig
+	bodyFilter    *BodyFilterConfig
+	epicExpansion *EpicExpansionConfig
+	signOff       *SignOffDetector
 	mu            sync.RWMutex
 }
 
 func NewIssueFinder(config *Config, notifier *LocalNotifier) (*IssueFinder, error) {
 	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: config.GitHubToken},
-	)
-	tc := oauth2.NewClient(ctx, ts)
 
 	var bot *tgbotapi.BotAPI
 	if config.TelegramBotToken != "" {
@@ -584,7 +628,7 @@ func NewIssueFinder(config *Config, notifier *LocalNotifier) (*IssueFinder, erro
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	client := github.NewClient(tc)
+	client := newGitHubClient(ctx, config.GitHubToken, db)
 	rateLimiter := NewRateLimiter(client, 100)
 
 	log.Printf("Initializing rate limiter with 100 request buffer...")
@@ -593,15 +637,24 @@ func NewIssueFinder(config *Config, notifier *LocalNotifier) (*IssueFinder, erro
 	}
 
 	finder := &IssueFinder{
-		config:      config,
-		client:      client,
-		rateLimiter: rateLimiter,
-		bot:         bot,
-		notifier:    notifier,
-		db:          db,
-		scorer:      NewIssueScorer(),
-		seenIssues:  make(map[string]bool),
-	}
+		config:        config,
+		client:        client,
+		rateLimiter:   rateLimiter,
+		bot:           bot,
+		notifier:      notifier,
+		db:            db,
+		scorer:        NewIssueScorer(),
+		seenIssues:    make(map[string]bool),
+		seenNodes:     make(map[string]bool),
+		titleFilter:   loadTitleFilterConfigFromEnv(),
+		bodyFilter:    loadBodyFilterConfigFromEnv(),
+		epicExpansion: loadEpicExpansionConfigFromEnv(),
+	}
+
+	// Lite mode has no request budget to spare for reading CONTRIBUTING files.
+	signOffConfig := loadSignOffConfigFromEnv()
+	signOffConfig.Enabled = signOffConfig.Enabled && !liteOptions.Enabled
+	finder.signOff = NewSignOffDetector(client, db, signOffConfig)
 
 	if err := finder.initDB(); err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
@@ -627,7 +680,7 @@ func NewIssueFinder(config *Config, notifier *LocalNotifier) (*IssueFinder, erro
 
 	if config.Assignment != nil && config.Assignment.Enabled {
 		username := ""
-		user, _, err := client.Users.Get(ctx, "")
+		user, _, err := client.GetUser(ctx, "")
 		if err == nil && user != nil {
 			username = user.GetLogin()
 		}
@@ -668,6 +721,7 @@ func NewIssueFinder(config *Config, notifier *LocalNotifier) (*IssueFinder, erro
 	}
 
 	finder.initializeProjects()
+	finder.loadRecordedMoves()
 
 	return finder, nil
 }
@@ -702,13 +756,30 @@ func (f *IssueFinder) initDB() error {
 	CREATE INDEX IF NOT EXISTS idx_issue_history_created_at ON issue_history(created_at DESC);
 	`
 
-	_, err := f.db.Exec(schema)
-	return err
+	if _, err := f.db.Exec(schema); err != nil {
+		return err
+	}
+	if err := f.migrateSeenIssues(); err != nil {
+		return err
+	}
+	if err := initRepoMovesTable(f.db); err != nil {
+		return err
+	}
+	if err := initRejectionsTable(f.db); err != nil {
+		return err
+	}
+	if err := initRetentionTables(f.db); err != nil {
+		return err
+	}
+	return initPipelineTables(f.db)
 }
 
 func (f *IssueFinder) loadSeenIssues() error {
-	var issueIDs []string
-	err := f.db.Select(&issueIDs, "SELECT issue_id FROM seen_issues")
+	var rows []struct {
+		IssueID string `db:"issue_id"`
+		NodeID  string `db:"node_id"`
+	}
+	err := f.db.Select(&rows, "SELECT issue_id, COALESCE(node_id, '') AS node_id FROM seen_issues")
 	if err != nil {
 		return err
 	}
@@ -716,27 +787,16 @@ func (f *IssueFinder) loadSeenIssues() error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	for _, id := range issueIDs {
-		f.seenIssues[id] = true
+	for _, row := range rows {
+		f.seenIssues[row.IssueID] = true
+		if row.NodeID != "" {
+			f.seenNodes[row.NodeID] = true
+		}
 	}
 
 	return nil
 }
 
-func (f *IssueFinder) markIssueSeen(issueID, projectName string) error {
-	f.mu.Lock()
-	f.seenIssues[issueID] = true
-	f.mu.Unlock()
-
-	_, err := f.db.Exec(`
-		INSERT INTO seen_issues (issue_id, project_name, first_seen, last_notified)
-		VALUES ($1, $2, $3, $3)
-		ON CONFLICT (issue_id) DO UPDATE SET last_notified = $3
-	`, issueID, projectName, time.Now())
-
-	return err
-}
-
 func (f *IssueFinder) saveIssueHistory(issue Issue) error {
 	labelsJSON, _ := json.Marshal(issue.Labels)
 
@@ -1140,12 +1200,24 @@ func (f *IssueFinder) initializeProjects() {
 		{Org: "kopia", Name: "kopia", Category: "Backup", Stars: 8000},
 	}
 
+	if watchlist, err := LoadWatchlist(watchlistPath()); err != nil {
+		log.Printf("Warning: failed to load watchlist: %v", err)
+	} else {
+		f.projects = mergeWatchlistProjects(f.projects, watchlist)
+	}
+
 	sort.Slice(f.projects, func(i, j int) bool {
 		return f.projects[i].Stars > f.projects[j].Stars
 	})
 }
 
 func (f *IssueFinder) FindIssues(ctx context.Context) ([]Issue, error) {
+	logRunSeed("Finder")
+
+	if liteOptions.Enabled {
+		return f.findIssuesLite(ctx)
+	}
+
 	var allIssues []Issue
 	var mu sync.Mutex
 	var projectWg sync.WaitGroup
@@ -1188,22 +1260,35 @@ func (f *IssueFinder) FindIssues(ctx context.Context) ([]Issue, error) {
 				log.Printf("Checking issues for %s/%s (%d stars)", p.Org, p.Name, p.Stars)
 
 				var issues []*github.Issue
+				var listResp *github.Response
 				var err error
 
-				err = f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("fetch issues for %s/%s", p.Org, p.Name), func() (*github.Response, error) {
-					opts := &github.IssueListByRepoOptions{
-						State:     "open",
-						Sort:      "created",
-						Direction: "desc",
-						ListOptions: github.ListOptions{
-							PerPage: f.config.MaxIssuesPerRepo,
-						},
-					}
+				listIssues := func() error {
+					return f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("fetch issues for %s/%s", p.Org, p.Name), func() (*github.Response, error) {
+						opts := &github.IssueListByRepoOptions{
+							State:     "open",
+							Sort:      "created",
+							Direction: "desc",
+							ListOptions: github.ListOptions{
+								PerPage: f.config.MaxIssuesPerRepo,
+							},
+						}
 
-					var apiErr error
-					issues, _, apiErr = f.client.Issues.ListByRepo(ctx, p.Org, p.Name, opts)
-					return nil, apiErr
-				})
+						var apiErr error
+						issues, listResp, apiErr = f.client.ListRepoIssues(ctx, p.Org, p.Name, opts)
+						return nil, apiErr
+					})
+				}
+
+				err = listIssues()
+				if repoMaybeMoved(listResp, err) {
+					if moved, ok := f.followRepoMove(ctx, p); ok {
+						p = moved
+						if err != nil {
+							err = listIssues()
+						}
+					}
+				}
 
 				if err != nil {
 					log.Printf("Error fetching issues for %s/%s: %v", p.Org, p.Name, err)
@@ -1212,11 +1297,20 @@ func (f *IssueFinder) FindIssues(ctx context.Context) ([]Issue, error) {
 
 				log.Printf("Found %d issues for %s/%s", len(issues), p.Org, p.Name)
 
-				issuesAdded := 0
+				listed := make(map[int]bool, len(issues))
 				for _, issue := range issues {
+					listed[issue.GetNumber()] = true
+				}
+
+				issuesAdded := 0
+				// Sub-issues of epics are appended while iterating, so the
+				// loop re-reads len(issues).
+				for idx := 0; idx < len(issues); idx++ {
+					issue := issues[idx]
 					if issue.IsPullRequest() {
 						continue
 					}
+					pipelineFetched.Add(1)
 
 					if len(issue.Assignees) > 0 {
 						continue
@@ -1228,52 +1322,31 @@ func (f *IssueFinder) FindIssues(ctx context.Context) ([]Issue, error) {
 
 					issueID := fmt.Sprintf("%s/%d", p.Name, *issue.Number)
 
-					f.mu.RLock()
-					seen := f.seenIssues[issueID]
-					f.mu.RUnlock()
-
-					if seen {
+					if f.isIssueSeen(issueID, issue.GetNodeID()) {
+						f.rekeySeenIssue(issueID, issue.GetNodeID(), p.Name)
 						continue
 					}
 
-					score := f.scorer.ScoreIssue(issue, p)
-
-					labels := make([]string, 0, len(issue.Labels))
-					for _, label := range issue.Labels {
-						labels = append(labels, label.GetName())
-					}
+					if isEpic, numbers := f.epicExpansion.SubIssues(issue.GetBody(), p.Org, p.Name); isEpic {
+						if !epicExpandedSince(f.db, issueID, issue.GetUpdatedAt().Time) {
+							issues = append(issues, f.fetchSubIssues(ctx, p, f.subIssuesToFetch(p, numbers, listed))...)
+						}
 
-					isGoodFirst := false
-					for _, label := range issue.Labels {
-						if strings.Contains(strings.ToLower(label.GetName()), "good first issue") {
-							isGoodFirst = true
-							break
+						reason := fmt.Sprintf("umbrella issue; %d open sub-issues scored individually", len(numbers))
+						rejection := IssueRejection{IssueID: issueID, IssueURL: issue.GetHTMLURL(), ProjectName: p.Name, Stage: RejectionStageEpic, Reason: reason}
+						if err := recordRejection(f.db, rejection); err != nil {
+							log.Printf("Error recording rejection for %s: %v", issueID, err)
 						}
+						continue
 					}
 
-					newIssue := Issue{
-						Project:     p,
-						Title:       *issue.Title,
-						URL:         *issue.HTMLURL,
-						Number:      *issue.Number,
-						Score:       score,
-						CreatedAt:   issue.CreatedAt.Time,
-						Comments:    *issue.Comments,
-						Labels:      labels,
-						Language:    "Go",
-						IsGoodFirst: isGoodFirst,
+					newIssue, ok := f.acceptIssue(ctx, p, issue, issueID)
+					if !ok {
+						continue
 					}
 
 					issuesChan <- newIssue
 					issuesAdded++
-
-					if err := f.markIssueSeen(issueID, p.Name); err != nil {
-						log.Printf("Error marking issue %s as seen: %v", issueID, err)
-					}
-
-					if err := f.saveIssueHistory(newIssue); err != nil {
-						log.Printf("Error saving issue history: %v", err)
-					}
 				}
 				log.Printf("Added %d new issues from %s/%s", issuesAdded, p.Org, p.Name)
 			}(project)
@@ -1290,16 +1363,94 @@ func (f *IssueFinder) FindIssues(ctx context.Context) ([]Issue, error) {
 	close(issuesChan)
 	log.Printf("[Finder] Waiting for issue processors to finish...")
 	collectorWg.Wait()
+	f.applyPendingMoves()
+	logAPIUsage("Finder")
 	log.Printf("[Finder] Processed %d total issues, sorting by score...", len(allIssues))
 
-	sort.Slice(allIssues, func(i, j int) bool {
-		return allIssues[i].Score > allIssues[j].Score
-	})
+	SortIssues(allIssues)
 
 	log.Printf("[Finder] Returning %d sorted issues", len(allIssues))
 	return allIssues, nil
 }
 
+// scoreIssue is the score the find pipeline ranks by: the main scorer plus
+// the repo's sign-off adjustment. Other surfaces that show a score use it
+// too, so their numbers match the alerts.
+func (f *IssueFinder) scoreIssue(ctx context.Context, p Project, issue *github.Issue) (ScoreResult, SignOffRequirement) {
+	signOff := f.signOff.Requirement(ctx, p.Org, p.Name)
+	result := f.scorer.ScoreIssueWithConfidence(issue, p)
+	result.SignOff = f.signOff.Adjustment(signOff)
+	result.Score += result.SignOff
+	return result, signOff
+}
+
+// acceptIssue runs the title and body filters on an issue that passed the seen
+// and epic checks, then scores it, marks it seen and records its history.
+func (f *IssueFinder) acceptIssue(ctx context.Context, p Project, issue *github.Issue, issueID string) (Issue, bool) {
+	if ok, reason := f.titleFilter.Check(issue.GetTitle()); !ok {
+		rejection := IssueRejection{IssueID: issueID, IssueURL: issue.GetHTMLURL(), ProjectName: p.Name, Stage: RejectionStageTitle, Reason: reason}
+		if err := recordRejection(f.db, rejection); err != nil {
+			log.Printf("Error recording rejection for %s: %v", issueID, err)
+		}
+		return Issue{}, false
+	}
+
+	if ok, reason := f.bodyFilter.Check(issue.GetBody()); !ok {
+		rejection := IssueRejection{IssueID: issueID, IssueURL: issue.GetHTMLURL(), ProjectName: p.Name, Stage: RejectionStageBody, Reason: reason}
+		if err := recordRejection(f.db, rejection); err != nil {
+			log.Printf("Error recording rejection for %s: %v", issueID, err)
+		}
+		return Issue{}, false
+	}
+
+	result, signOff := f.scoreIssue(ctx, p, issue)
+
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		labels = append(labels, label.GetName())
+	}
+
+	isGoodFirst := false
+	for _, label := range issue.Labels {
+		if strings.Contains(strings.ToLower(label.GetName()), "good first issue") {
+			isGoodFirst = true
+			break
+		}
+	}
+
+	newIssue := Issue{
+		Project:      p,
+		Title:        *issue.Title,
+		URL:          *issue.HTMLURL,
+		Number:       *issue.Number,
+		Score:        result.Score,
+		CreatedAt:    issue.CreatedAt.Time,
+		Comments:     *issue.Comments,
+		Labels:       labels,
+		Language:     "Go",
+		IsGoodFirst:  isGoodFirst,
+		ReadingTime:  EstimateReadingTime(issue),
+		SignOff:      signOff,
+		KeywordScore: result.Keywords,
+		Confidence:   result.Confidence,
+	}
+
+	if err := f.markIssueSeen(issueID, issue.GetNodeID(), p.Name); err != nil {
+		log.Printf("Error marking issue %s as seen: %v", issueID, err)
+	}
+
+	if err := clearRejection(f.db, issueID); err != nil {
+		log.Printf("Error clearing rejection for %s: %v", issueID, err)
+	}
+
+	if err := f.saveIssueHistory(newIssue); err != nil {
+		log.Printf("Error saving issue history: %v", err)
+	}
+
+	pipelinePassed.Add(1)
+	return newIssue, true
+}
+
 func (f *IssueFinder) FindGoodFirstIssues(ctx context.Context, categories []string) ([]Issue, error) {
 	var allIssues []Issue
 	var mu sync.Mutex
@@ -1366,7 +1517,7 @@ func (f *IssueFinder) FindGoodFirstIssues(ctx context.Context, categories []stri
 					}
 
 					var apiErr error
-					issues, _, apiErr = f.client.Issues.ListByRepo(ctx, p.Org, p.Name, opts)
+					issues, _, apiErr = f.client.ListRepoIssues(ctx, p.Org, p.Name, opts)
 					return nil, apiErr
 				})
 
@@ -1394,11 +1545,12 @@ func (f *IssueFinder) FindGoodFirstIssues(ctx context.Context, categories []stri
 
 					issueID := fmt.Sprintf("%s/%d", p.Name, *issue.Number)
 
-					f.mu.RLock()
-					seen := f.seenIssues[issueID]
-					f.mu.RUnlock()
+					if f.isIssueSeen(issueID, issue.GetNodeID()) {
+						f.rekeySeenIssue(issueID, issue.GetNodeID(), p.Name)
+						continue
+					}
 
-					if seen {
+					if ok, _ := f.titleFilter.Check(issue.GetTitle()); !ok {
 						continue
 					}
 
@@ -1422,6 +1574,7 @@ func (f *IssueFinder) FindGoodFirstIssues(ctx context.Context, categories []stri
 						Labels:      labels,
 						Language:    "Go",
 						IsGoodFirst: isGoodFirst,
+						ReadingTime: EstimateReadingTime(issue),
 					}
 
 					issuesChan <- newIssue
@@ -1436,20 +1589,18 @@ func (f *IssueFinder) FindGoodFirstIssues(ctx context.Context, categories []stri
 	close(issuesChan)
 	collectorWg.Wait()
 
-	sort.Slice(allIssues, func(i, j int) bool {
-		return allIssues[i].Score > allIssues[j].Score
-	})
+	SortIssues(allIssues)
 
 	log.Printf("[Good First Issues] Found %d issues", len(allIssues))
 	return allIssues, nil
 }
 
 func PrintGoodFirstIssues(issues []Issue, title string) {
-	fmt.Printf("\n%s\n", title)
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n%s\n", title)
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	if len(issues) == 0 {
-		fmt.Println("No good first issues found.")
+		fmt.Fprintln(stdout, T("find.none_good_first"))
 		return
 	}
 
@@ -1466,15 +1617,27 @@ func PrintGoodFirstIssues(issues []Issue, title string) {
 			emoji = "✨"
 		}
 
-		fmt.Printf("\n%s [%d] %s\n", emoji, i+1, issue.Title)
-		fmt.Printf("   Score: %.2f | %s/%s (%d★) | Comments: %d\n", issue.Score, issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Comments)
-		fmt.Printf("   Category: %s\n", issue.Project.Category)
-		fmt.Printf("   URL: %s\n", issue.URL)
+		record := &Record{Title: fmt.Sprintf("\n%s [%d] %s", emoji, i+1, issue.Title), TitleColor: colorBold}
+		record.AddColored(T("field.score"), fmt.Sprintf("%.2f (%s)", issue.Score, scoreGrade(issue.Score)), scoreColor(issue.Score))
+		record.Add(T("field.project"), fmt.Sprintf("%s/%s (%d★)", issue.Project.Org, issue.Project.Name, issue.Project.Stars))
+		record.Add(T("field.comments"), strconv.Itoa(issue.Comments))
+		if issue.ReadingTime.Minutes > 0 {
+			record.Add(T("field.thread"), fmt.Sprintf("%s (%d words)", issue.ReadingTime, issue.ReadingTime.Words))
+		}
+		if confidence := formatConfidence(issue); confidence != "" {
+			record.Add(T("field.confidence"), confidence)
+		}
+		if badge := issue.SignOff.Badge(); badge != "" {
+			record.Add(T("field.sign_off"), badge)
+		}
+		record.Add(T("field.category"), issue.Project.Category)
+		record.Add(T("field.url"), issue.URL)
 		if len(issue.Labels) > 0 {
-			fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
+			record.Add(T("field.labels"), strings.Join(issue.Labels, ", "))
 		}
-		fmt.Printf("   Created: %s\n", issue.CreatedAt.Format("2006-01-02"))
-		fmt.Println(strings.Repeat("-", 80))
+		record.Add(T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+		record.Render(stdout, "   ")
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 	}
 }
 
@@ -1485,13 +1648,18 @@ func PrintIssuesByCategory(issues []Issue) {
 		categories[cat] = append(categories[cat], issue)
 	}
 
-	for cat, catIssues := range categories {
-		sort.Slice(catIssues, func(i, j int) bool {
-			return catIssues[i].Score > catIssues[j].Score
-		})
+	names := make([]string, 0, len(categories))
+	for cat := range categories {
+		names = append(names, cat)
+	}
+	sort.Strings(names)
+
+	for _, cat := range names {
+		catIssues := categories[cat]
+		SortIssues(catIssues)
 
-		fmt.Printf("\n\nCategory: %s (%d issues)\n", cat, len(catIssues))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n\nCategory: %s (%d issues)\n", cat, len(catIssues))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 		for i, issue := range catIssues {
 			if i >= 10 {
@@ -1506,8 +1674,8 @@ func PrintIssuesByCategory(issues []Issue) {
 				emoji = "✨"
 			}
 
-			fmt.Printf("%s [%d] %s (%.2f)\n", emoji, i+1, truncateString(issue.Title, 60), issue.Score)
-			fmt.Printf("    %s/%s | %s\n", issue.Project.Org, issue.Project.Name, issue.URL)
+			fmt.Fprintf(stdout, "%s [%d] %s (%.2f)\n", emoji, i+1, truncateString(issue.Title, 60), issue.Score)
+			fmt.Fprintf(stdout, "    %s/%s | %s\n", issue.Project.Org, issue.Project.Name, issue.URL)
 		}
 	}
 }
@@ -1606,7 +1774,7 @@ func (f *IssueFinder) FindActionableIssues(ctx context.Context) ([]Issue, error)
 					}
 
 					var apiErr error
-					issues, _, apiErr = f.client.Issues.ListByRepo(ctx, p.Org, p.Name, opts)
+					issues, _, apiErr = f.client.ListRepoIssues(ctx, p.Org, p.Name, opts)
 					return nil, apiErr
 				})
 
@@ -1643,6 +1811,10 @@ func (f *IssueFinder) FindActionableIssues(ctx context.Context) ([]Issue, error)
 						continue
 					}
 
+					if ok, _ := f.titleFilter.Check(issue.GetTitle()); !ok {
+						continue
+					}
+
 					labels := make([]string, 0, len(issue.Labels))
 					hasGoodFirst := false
 					hasHelpWanted := false
@@ -1672,11 +1844,8 @@ func (f *IssueFinder) FindActionableIssues(ctx context.Context) ([]Issue, error)
 
 					issueID := fmt.Sprintf("%s/%d", p.Name, *issue.Number)
 
-					f.mu.RLock()
-					seen := f.seenIssues[issueID]
-					f.mu.RUnlock()
-
-					if seen {
+					if f.isIssueSeen(issueID, issue.GetNodeID()) {
+						f.rekeySeenIssue(issueID, issue.GetNodeID(), p.Name)
 						continue
 					}
 
@@ -1720,6 +1889,7 @@ func (f *IssueFinder) FindActionableIssues(ctx context.Context) ([]Issue, error)
 						Labels:      labels,
 						Language:    "Go",
 						IsGoodFirst: hasGoodFirst,
+						ReadingTime: EstimateReadingTime(issue),
 					}
 
 					issuesChan <- newIssue
@@ -1734,26 +1904,24 @@ func (f *IssueFinder) FindActionableIssues(ctx context.Context) ([]Issue, error)
 	close(issuesChan)
 	collectorWg.Wait()
 
-	sort.Slice(allIssues, func(i, j int) bool {
-		return allIssues[i].Score > allIssues[j].Score
-	})
+	SortIssues(allIssues)
 
 	log.Printf("[Actionable] Found %d actionable issues", len(allIssues))
 	return allIssues, nil
 }
 
 func PrintActionableIssues(issues []Issue) {
-	fmt.Printf("\n%s\n", "ACTIONABLE ISSUES FROM TLS-ENABLED GO PROJECTS")
-	fmt.Println(strings.Repeat("=", 80))
-	fmt.Println("(Good First Issues, Bugs, Enhancements - No Go 1.26 Required)")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintf(stdout, "\n%s\n", T("actionable.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, T("actionable.subtitle"))
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 	if len(issues) == 0 {
-		fmt.Println("No actionable issues found.")
+		fmt.Fprintln(stdout, T("actionable.none"))
 		return
 	}
 
-	fmt.Printf("\nTotal Found: %d issues\n\n", len(issues))
+	fmt.Fprintf(stdout, "\n%s\n\n", T("print.total_found", len(issues)))
 
 	goodFirstIssues := []Issue{}
 	bugIssues := []Issue{}
@@ -1787,47 +1955,47 @@ func PrintActionableIssues(issues []Issue) {
 	}
 
 	if len(goodFirstIssues) > 0 {
-		fmt.Printf("\n🔥 GOOD FIRST ISSUES (%d issues)\n", len(goodFirstIssues))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n🔥 %s\n", T("actionable.good_first", len(goodFirstIssues)))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range goodFirstIssues {
 			if i >= 15 {
 				break
 			}
-			fmt.Printf("\n✅ [%d] %s (Score: %.2f)\n", i+1, issue.Title, issue.Score)
-			fmt.Printf("   Project: %s/%s (%d★) | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
-			fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("   URL: %s\n", issue.URL)
+			fmt.Fprintf(stdout, "\n✅ [%d] %s (%s: %.2f)\n", i+1, issue.Title, T("field.score"), issue.Score)
+			fmt.Fprintf(stdout, "   %s: %s/%s (%d★) | %s\n", T("field.project"), issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
+			fmt.Fprintf(stdout, "   %s: %d | %s: %s\n", T("field.comments"), issue.Comments, T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
 			if len(issue.Labels) > 0 {
-				fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
+				fmt.Fprintf(stdout, "   %s: %s\n", T("field.labels"), strings.Join(issue.Labels, ", "))
 			}
 		}
 	}
 
 	if len(bugIssues) > 0 {
-		fmt.Printf("\n\n🐛 BUG ISSUES (%d issues)\n", len(bugIssues))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n\n🐛 %s\n", T("actionable.bugs", len(bugIssues)))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range bugIssues {
 			if i >= 10 {
 				break
 			}
-			fmt.Printf("\n🔴 [%d] %s (Score: %.2f)\n", i+1, issue.Title, issue.Score)
-			fmt.Printf("   Project: %s/%s (%d★) | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
-			fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("   URL: %s\n", issue.URL)
+			fmt.Fprintf(stdout, "\n🔴 [%d] %s (%s: %.2f)\n", i+1, issue.Title, T("field.score"), issue.Score)
+			fmt.Fprintf(stdout, "   %s: %s/%s (%d★) | %s\n", T("field.project"), issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
+			fmt.Fprintf(stdout, "   %s: %d | %s: %s\n", T("field.comments"), issue.Comments, T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
 		}
 	}
 
 	if len(enhancementIssues) > 0 {
-		fmt.Printf("\n\n✨ ENHANCEMENT ISSUES (%d issues)\n", len(enhancementIssues))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n\n✨ %s\n", T("actionable.enhancements", len(enhancementIssues)))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range enhancementIssues {
 			if i >= 10 {
 				break
 			}
-			fmt.Printf("\n🟢 [%d] %s (Score: %.2f)\n", i+1, issue.Title, issue.Score)
-			fmt.Printf("   Project: %s/%s (%d★) | %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
-			fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("   URL: %s\n", issue.URL)
+			fmt.Fprintf(stdout, "\n🟢 [%d] %s (%s: %.2f)\n", i+1, issue.Title, T("field.score"), issue.Score)
+			fmt.Fprintf(stdout, "   %s: %s/%s (%d★) | %s\n", T("field.project"), issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
+			fmt.Fprintf(stdout, "   %s: %d | %s: %s\n", T("field.comments"), issue.Comments, T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
 		}
 	}
 }
@@ -1937,7 +2105,7 @@ func (f *IssueFinder) FindGoUpgradeIssues(ctx context.Context) ([]Issue, error)
 					}
 
 					var apiErr error
-					issues, _, apiErr = f.client.Issues.ListByRepo(ctx, p.Org, p.Name, opts)
+					issues, _, apiErr = f.client.ListRepoIssues(ctx, p.Org, p.Name, opts)
 					return nil, apiErr
 				})
 
@@ -1977,11 +2145,8 @@ func (f *IssueFinder) FindGoUpgradeIssues(ctx context.Context) ([]Issue, error)
 
 					issueID := fmt.Sprintf("%s/%d", p.Name, *issue.Number)
 
-					f.mu.RLock()
-					seen := f.seenIssues[issueID]
-					f.mu.RUnlock()
-
-					if seen {
+					if f.isIssueSeen(issueID, issue.GetNodeID()) {
+						f.rekeySeenIssue(issueID, issue.GetNodeID(), p.Name)
 						continue
 					}
 
@@ -2010,6 +2175,7 @@ func (f *IssueFinder) FindGoUpgradeIssues(ctx context.Context) ([]Issue, error)
 						Labels:      labels,
 						Language:    "Go",
 						IsGoodFirst: false,
+						ReadingTime: EstimateReadingTime(issue),
 					}
 
 					issuesChan <- newIssue
@@ -2024,25 +2190,23 @@ func (f *IssueFinder) FindGoUpgradeIssues(ctx context.Context) ([]Issue, error)
 	close(issuesChan)
 	collectorWg.Wait()
 
-	sort.Slice(allIssues, func(i, j int) bool {
-		return allIssues[i].Score > allIssues[j].Score
-	})
+	SortIssues(allIssues)
 
 	log.Printf("[Go Upgrade] Found %d Go version upgrade issues in TLS-enabled projects", len(allIssues))
 	return allIssues, nil
 }
 
 func PrintGoUpgradeIssues(issues []Issue) {
-	fmt.Printf("\n%s\n", "GO VERSION UPGRADE ISSUES IN TLS-ENABLED PROJECTS")
-	fmt.Println(strings.Repeat("=", 80))
+	fmt.Fprintf(stdout, "\n%s\n", T("goupgrade.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
 
 	if len(issues) == 0 {
-		fmt.Println("No Go version upgrade issues found.")
+		fmt.Fprintln(stdout, T("goupgrade.none"))
 		return
 	}
 
-	fmt.Printf("\nTotal Found: %d issues\n", len(issues))
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintf(stdout, "\n%s\n", T("print.total_found", len(issues)))
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 	for i, issue := range issues {
 		emoji := "🔥"
@@ -2053,14 +2217,14 @@ func PrintGoUpgradeIssues(issues []Issue) {
 			emoji = "✨"
 		}
 
-		fmt.Printf("\n%s [%d] %s (Score: %.2f)\n", emoji, i+1, issue.Title, issue.Score)
-		fmt.Printf("   Project: %s/%s (%d★) | Category: %s\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars, issue.Project.Category)
-		fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-		fmt.Printf("   URL: %s\n", issue.URL)
+		fmt.Fprintf(stdout, "\n%s [%d] %s (%s: %.2f)\n", emoji, i+1, issue.Title, T("field.score"), issue.Score)
+		fmt.Fprintf(stdout, "   %s: %s/%s (%d★) | %s: %s\n", T("field.project"), issue.Project.Org, issue.Project.Name, issue.Project.Stars, T("field.category"), issue.Project.Category)
+		fmt.Fprintf(stdout, "   %s: %d | %s: %s\n", T("field.comments"), issue.Comments, T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+		fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
 		if len(issue.Labels) > 0 {
-			fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.labels"), strings.Join(issue.Labels, ", "))
 		}
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 	}
 }
 
@@ -2126,7 +2290,7 @@ func (f *IssueFinder) FindConfirmedGoodFirstIssues(ctx context.Context, targetRe
 					}
 
 					var apiErr error
-					issues, _, apiErr = f.client.Issues.ListByRepo(ctx, p.Org, p.Name, opts)
+					issues, _, apiErr = f.client.ListRepoIssues(ctx, p.Org, p.Name, opts)
 					return nil, apiErr
 				})
 
@@ -2144,6 +2308,10 @@ func (f *IssueFinder) FindConfirmedGoodFirstIssues(ctx context.Context, targetRe
 						continue
 					}
 
+					if ok, _ := f.titleFilter.Check(issue.GetTitle()); !ok {
+						continue
+					}
+
 					labels := make([]string, 0, len(issue.Labels))
 					hasGoodFirst := hasGoodFirstIssueLabel(issue.Labels)
 					hasConfirmed := hasConfirmedLabel(issue.Labels)
@@ -2161,7 +2329,7 @@ func (f *IssueFinder) FindConfirmedGoodFirstIssues(ctx context.Context, targetRe
 
 					if !hasPR {
 						query := fmt.Sprintf("repo:%s/%s is:pr %d in:body", p.Org, p.Name, issue.GetNumber())
-						result, _, err := f.client.Search.Issues(ctx, query, nil)
+						result, _, err := f.client.SearchIssues(ctx, query, nil)
 						if err == nil && result != nil {
 							hasPR = *result.Total > 0
 						}
@@ -2201,6 +2369,7 @@ func (f *IssueFinder) FindConfirmedGoodFirstIssues(ctx context.Context, targetRe
 							Labels:      labels,
 							Language:    "Go",
 							IsGoodFirst: hasGoodFirst,
+							ReadingTime: EstimateReadingTime(issue),
 						},
 						HasConfirmedLabel: hasConfirmed,
 						HasGoodFirstLabel: hasGoodFirst,
@@ -2224,7 +2393,10 @@ func (f *IssueFinder) FindConfirmedGoodFirstIssues(ctx context.Context, targetRe
 		if allIssues[i].IsEligible != allIssues[j].IsEligible {
 			return allIssues[i].IsEligible
 		}
-		return allIssues[i].Score > allIssues[j].Score
+		if allIssues[i].Score != allIssues[j].Score {
+			return allIssues[i].Score > allIssues[j].Score
+		}
+		return issueRanksBefore(allIssues[i].Issue, allIssues[j].Issue)
 	})
 
 	log.Printf("[Confirmed GFI] Found %d issues (eligible: %d)", len(allIssues), countEligible(allIssues))
@@ -2242,13 +2414,13 @@ func countEligible(issues []ConfirmedGoodFirstIssue) int {
 }
 
 func PrintConfirmedGoodFirstIssues(issues []ConfirmedGoodFirstIssue) {
-	fmt.Printf("\n%s\n", "GOOD FIRST ISSUES WITH CONFIRMED LABEL (Ready for Assignment)")
-	fmt.Println(strings.Repeat("=", 80))
-	fmt.Println("Criteria: good first issue + confirmed/triage/accepted, no assignee, no PR")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintf(stdout, "\n%s\n", T("confirmed.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, T("confirmed.criteria"))
+	fmt.Fprintln(stdout, strings.Repeat("-", 80))
 
 	if len(issues) == 0 {
-		fmt.Println("No matching issues found.")
+		fmt.Fprintln(stdout, T("confirmed.none"))
 		return
 	}
 
@@ -2264,36 +2436,36 @@ func PrintConfirmedGoodFirstIssues(issues []ConfirmedGoodFirstIssue) {
 	}
 
 	if len(eligible) > 0 {
-		fmt.Printf("\n✅ ELIGIBLE FOR ASSIGNMENT (%d issues)\n", len(eligible))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n✅ %s\n", T("confirmed.eligible", len(eligible)))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range eligible {
 			if i >= 20 {
 				break
 			}
-			fmt.Printf("\n🔥 [%d] %s (Score: %.2f)\n", i+1, issue.Title, issue.Score)
-			fmt.Printf("   Project: %s/%s (%d★)\n", issue.Project.Org, issue.Project.Name, issue.Project.Stars)
-			fmt.Printf("   Comments: %d | Created: %s\n", issue.Comments, issue.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("   URL: %s\n", issue.URL)
-			fmt.Printf("   Labels: %s\n", strings.Join(issue.Labels, ", "))
-			fmt.Println(strings.Repeat("-", 80))
+			fmt.Fprintf(stdout, "\n🔥 [%d] %s (%s: %.2f)\n", i+1, issue.Title, T("field.score"), issue.Score)
+			fmt.Fprintf(stdout, "   %s: %s/%s (%d★)\n", T("field.project"), issue.Project.Org, issue.Project.Name, issue.Project.Stars)
+			fmt.Fprintf(stdout, "   %s: %d | %s: %s\n", T("field.comments"), issue.Comments, T("field.created"), issue.CreatedAt.Format("2006-01-02"))
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.labels"), strings.Join(issue.Labels, ", "))
+			fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		}
 	}
 
 	if len(ineligible) > 0 {
-		fmt.Printf("\n\n⚠️ NOT ELIGIBLE (%d issues)\n", len(ineligible))
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintf(stdout, "\n\n⚠️ %s\n", T("confirmed.ineligible", len(ineligible)))
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
 		for i, issue := range ineligible {
 			if i >= 10 {
 				break
 			}
 			reason := ""
 			if issue.HasAssignee {
-				reason = "has assignee"
+				reason = T("confirmed.has_assignee")
 			} else if issue.HasLinkedPR {
-				reason = "has linked PR"
+				reason = T("confirmed.has_pr")
 			}
-			fmt.Printf("\n[%d] %s (%s)\n", i+1, issue.Title, reason)
-			fmt.Printf("   URL: %s\n", issue.URL)
+			fmt.Fprintf(stdout, "\n[%d] %s (%s)\n", i+1, issue.Title, reason)
+			fmt.Fprintf(stdout, "   %s: %s\n", T("field.url"), issue.URL)
 		}
 	}
 }
@@ -2315,6 +2487,9 @@ func (f *IssueFinder) ProcessNewIssueNotifications(ctx context.Context, issues [
 			} else if alreadyNotified {
 				continue
 			}
+			if _, snoozed := f.antiSpam.snoozedUntil(issueURL); snoozed {
+				continue
+			}
 		}
 
 		if f.tracker != nil {
@@ -2363,17 +2538,19 @@ func (f *IssueFinder) ProcessNewIssueNotifications(ctx context.Context, issues [
 	return newIssues, nil
 }
 
-func (f *IssueFinder) SendTelegramAlert(issues []Issue) error {
+// SendTelegramAlert posts the header and one message per issue, at most 20,
+// and returns how many issue messages were sent.
+func (f *IssueFinder) SendTelegramAlert(issues []Issue) (int, error) {
 	if f.bot == nil {
-		return nil
+		return 0, nil
 	}
 	if len(issues) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	var messages []string
 
-	header := fmt.Sprintf("🚀 *New Learning Opportunities in Go DevOps Projects*\n\n")
+	header := T("telegram.header") + "\n\n"
 	messages = append(messages, header)
 
 	for i, issue := range issues {
@@ -2381,59 +2558,52 @@ func (f *IssueFinder) SendTelegramAlert(issues []Issue) error {
 			break
 		}
 
-		scoreEmoji := ""
-		if issue.Score >= 0.8 {
-			scoreEmoji = "🔥"
-		} else if issue.Score >= 0.6 {
-			scoreEmoji = "⭐"
-		} else {
-			scoreEmoji = "✨"
+		// Telegram only renders http(s) links, so action links are limited to web mode
+		var links *DeepLinks
+		if linkConfig := getDeepLinkConfig(); linkConfig.IsWebMode() {
+			links = linkConfig.LinksFor(issue.URL)
 		}
 
-		labelsText := ""
-		if len(issue.Labels) > 0 {
-			labelsText = fmt.Sprintf("\nLabels: %s", strings.Join(issue.Labels, ", "))
+		msg, ok := getNotificationTemplates().Render(ChannelTelegram, AlertNewIssue, TemplatePartBody, NotificationData{Issue: issue, Links: links})
+		if !ok {
+			continue
 		}
 
-		msg := fmt.Sprintf(
-			"%s *%s* (%.2f)\n%s\n%s/%s (%d★)%s\n\n",
-			scoreEmoji,
-			truncateString(issue.Title, 80),
-			issue.Score,
-			issue.URL,
-			issue.Project.Org,
-			issue.Project.Name,
-			issue.Project.Stars,
-			labelsText,
-		)
-
 		messages = append(messages, msg)
 	}
 
-	for _, msg := range messages {
+	sent := 0
+	for i, msg := range messages {
 		tgMsg := tgbotapi.NewMessage(f.config.TelegramChatID, msg)
 		tgMsg.ParseMode = "Markdown"
 
 		_, err := f.bot.Send(tgMsg)
 		if err != nil {
 			log.Printf("Error sending Telegram message: %v", err)
-			return err
+			return sent, err
+		}
+		if i > 0 {
+			sent++
 		}
 
 		time.Sleep(1 * time.Second)
 	}
 
-	return nil
+	return sent, nil
 }
 
-func (f *IssueFinder) SendLocalAlert(issues []Issue) error {
+// SendLocalAlert hands the issues to the local notifier and returns how many
+// it delivered.
+func (f *IssueFinder) SendLocalAlert(issues []Issue) (int, error) {
 	if f.notifier == nil {
-		return nil
+		return 0, nil
 	}
 
 	return f.notifier.SendIssuesAlert(issues)
 }
 
+// GetTopIssues reads issue_history, so with retention on it only covers the
+// retention window; rolled-up months have no per-issue rows.
 func (f *IssueFinder) GetTopIssues(limit int) ([]Issue, error) {
 	var issues []Issue
 
@@ -2852,27 +3022,27 @@ func min(a, b int) int {
 }
 
 func runMonitorStatusOnly() error {
-	fmt.Println("\n📊 MONITOR STATUS")
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Println("   Running: No active daemon")
-	fmt.Println("   Note: Use 'monitor start' to begin monitoring")
-	fmt.Println("\n📋 Default Configuration:")
+	fmt.Fprintln(stdout, "\n📊 "+T("monitor.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, "   "+T("monitor.not_running"))
+	fmt.Fprintln(stdout, "   "+T("monitor.start_hint"))
+	fmt.Fprintln(stdout, "\n📋 "+T("monitor.defaults"))
 	config := DefaultMonitorConfig()
-	fmt.Printf("   Check Interval: %v\n", config.CheckInterval)
-	fmt.Printf("   Min Score: %.2f\n", config.MinScore)
-	fmt.Printf("   Max Issues Per Check: %d\n", config.MaxIssuesPerCheck)
-	fmt.Printf("   Notifications: Local=%v, Email=%v\n", config.NotifyLocal, config.NotifyEmail)
-	fmt.Printf("   Repositories: %d\n", len(config.Repos))
+	fmt.Fprintln(stdout, "   "+T("monitor.interval", config.CheckInterval))
+	fmt.Fprintln(stdout, "   "+T("monitor.min_score", config.MinScore))
+	fmt.Fprintln(stdout, "   "+T("monitor.max_issues", config.MaxIssuesPerCheck))
+	fmt.Fprintln(stdout, "   "+T("monitor.notifications", config.NotifyLocal, config.NotifyEmail))
+	fmt.Fprintln(stdout, "   "+T("monitor.repos", len(config.Repos)))
 
-	fmt.Println("\n📁 Monitored Repositories (by category):")
+	fmt.Fprintln(stdout, "\n📁 "+T("monitor.by_category"))
 	categories := make(map[string][]RepoConfig)
 	for _, repo := range config.Repos {
 		categories[repo.Category] = append(categories[repo.Category], repo)
 	}
 	for cat, repos := range categories {
-		fmt.Printf("\n   %s (%d repos):\n", strings.Title(cat), len(repos))
+		fmt.Fprintf(stdout, "\n   %s\n", T("monitor.category", strings.Title(cat), len(repos)))
 		for _, r := range repos {
-			fmt.Printf("      - %s/%s (priority: %d)\n", r.Owner, r.Name, r.Priority)
+			fmt.Fprintf(stdout, "      - %s/%s (priority: %d)\n", r.Owner, r.Name, r.Priority)
 		}
 	}
 
@@ -2910,6 +3080,104 @@ func main() {
 		return
 	}
 
+	if cmd == CmdExtensionAPI {
+		if err := runExtensionAPICommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdExplore {
+		if err := runExploreCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdMoreLike {
+		if err := runMoreLikeCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdWhyNot {
+		if err := runWhyNotCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdMigrateSeen {
+		if err := runMigrateSeenCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdDrift {
+		if err := runDriftCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdList {
+		if err := runWithTracker(runListCommand, args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdUpdate {
+		if err := runWithTracker(runUpdateCommand, args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdStats {
+		if err := runStatsCLI(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdStatsExport {
+		if err := runStatsExportCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdRegressions {
+		if err := runRegressionsCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdInit {
+		if err := runInitCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdSelfTest {
+		if err := runSelfTestCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	if cmd == CmdOpenLink {
+		if err := runOpenLinkCommand(args); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
 	if cmd == CmdLimits {
 		if err := runLimitsCommand(nil); err != nil {
 			log.Printf("Error: %v", err)
@@ -2928,22 +3196,18 @@ func main() {
 		case "status":
 			runMonitorStatusOnly()
 		case "start":
-			fmt.Println("Start command requires a running service. Use 'monitor check' for one-time check.")
+			fmt.Fprintln(stdout, "Start command requires a running service. Use 'monitor check' for one-time check.")
 		case "stop":
-			fmt.Println("Stop command requires a running service.")
+			fmt.Fprintln(stdout, "Stop command requires a running service.")
 		case "check":
 			token := os.Getenv("GITHUB_TOKEN")
 			if token == "" {
-				fmt.Println("This command requires GITHUB_TOKEN to be set.")
-				fmt.Println("Set GITHUB_TOKEN and try again.")
+				fmt.Fprintln(stdout, "This command requires GITHUB_TOKEN to be set.")
+				fmt.Fprintln(stdout, "Set GITHUB_TOKEN and try again.")
 				return
 			}
 
-			ts := oauth2.StaticTokenSource(
-				&oauth2.Token{AccessToken: token},
-			)
-			tc := oauth2.NewClient(context.Background(), ts)
-			client := github.NewClient(tc)
+			client := newGitHubClient(context.Background(), token, nil)
 
 			storage, err := NewFileStorage("")
 			if err != nil {
@@ -2973,12 +3237,12 @@ func main() {
 			}
 
 			if len(issues) == 0 {
-				fmt.Println("No new issues found matching criteria.")
+				fmt.Fprintln(stdout, "No new issues found matching criteria.")
 			} else {
-				fmt.Printf("\nFound %d new issues!\n\n", len(issues))
+				fmt.Fprintf(stdout, "\nFound %d new issues!\n\n", len(issues))
 				for i, issue := range issues {
-					fmt.Printf("%d. [%s] #%d - %s\n", i+1, issue.Repo, issue.IssueNumber, issue.Title)
-					fmt.Printf("   Score: %.2f | %s\n\n", issue.Score, issue.URL)
+					fmt.Fprintf(stdout, "%d. [%s] #%d - %s\n", i+1, issue.Repo, issue.IssueNumber, issue.Title)
+					fmt.Fprintf(stdout, "   Score: %.2f | %s\n\n", issue.Score, issue.URL)
 				}
 
 				monitor.NotifyLocal(issues)
@@ -2997,9 +3261,7 @@ func main() {
 			if token == "" {
 				token = "dummy"
 			}
-			ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-			tc := oauth2.NewClient(context.Background(), ts)
-			client := github.NewClient(tc)
+			client := newGitHubClient(context.Background(), token, nil)
 
 			config := &MonitorConfig{NotifyLocal: true}
 			monitor, err := NewIssueMonitor(config, client, nil, storage)
@@ -3008,9 +3270,9 @@ func main() {
 				return
 			}
 			monitor.NotifyLocal(testIssues)
-			fmt.Println("Test notification sent!")
+			fmt.Fprintln(stdout, "Test notification sent!")
 		default:
-			fmt.Printf("Unknown monitor subcommand: %s\n", subCmd)
+			fmt.Fprintf(stdout, "Unknown monitor subcommand: %s\n", subCmd)
 			PrintMonitorUsage()
 		}
 		return
@@ -3068,7 +3330,10 @@ func main() {
 	}
 
 	if config.GitHubToken == "" {
-		log.Fatal("GITHUB_TOKEN environment variable is required")
+		if !liteOptions.Enabled {
+			log.Fatal("GITHUB_TOKEN environment variable is required")
+		}
+		log.Printf("GITHUB_TOKEN not set, running lite mode unauthenticated")
 	}
 
 	if config.TelegramBotToken == "" {
@@ -3104,15 +3369,24 @@ func main() {
 
 	runCheck := func() {
 		log.Printf("Running issue check...")
+		defer finder.checkDrift(ctx)
+		if finder.autoFinder != nil {
+			defer finder.autoFinder.PostDueComments(ctx)
+		}
+		defer maybeApplyRetention(finder.db)
 		if err := finder.rateLimiter.checkRateLimit(ctx); err != nil {
 			log.Printf("Warning: failed to check rate limit: %v", err)
 		}
+		pipeline := startPipelineRun()
 		issues, err := finder.FindIssues(ctx)
 		if err != nil {
 			log.Printf("Error finding issues: %v", err)
 			return
 		}
 
+		notified := 0
+		defer func() { pipeline.Finish(finder.db, issues, notified) }()
+
 		log.Printf("Found %d new issues", len(issues))
 
 		if len(issues) == 0 {
@@ -3122,20 +3396,25 @@ func main() {
 
 		log.Printf("Sending alerts for %d issues...", len(issues))
 
-		if err := finder.SendTelegramAlert(issues); err != nil {
+		telegramSent, err := finder.SendTelegramAlert(issues)
+		if err != nil {
 			log.Printf("Error sending Telegram alert: %v", err)
 		} else if finder.bot != nil {
-			log.Printf("Successfully sent Telegram alert for %d issues", len(issues))
+			log.Printf("Successfully sent Telegram alert for %d issues", telegramSent)
 		}
 
 		log.Printf("Sending local/email alerts...")
-		if err := finder.SendLocalAlert(issues); err != nil {
+		localSent, err := finder.SendLocalAlert(issues)
+		if err != nil {
 			log.Printf("Error processing local/email alert: %v", err)
-		} else if config.Email != nil {
-			log.Printf("Email/local alert delivered for %d issues", len(issues))
 		} else {
-			log.Printf("Logged %d issues locally (email disabled)", len(issues))
+			if config.Email != nil {
+				log.Printf("Email/local alert delivered for %d issues", localSent)
+			} else {
+				log.Printf("Logged %d issues locally (email disabled)", len(issues))
+			}
 		}
+		notified = max(telegramSent, localSent)
 		log.Printf("Alert processing complete")
 	}
 
@@ -3228,16 +3507,17 @@ func main() {
 		}
 
 		assignedIssues := []Issue{}
-		assignedIssuesResp, _, err := finder.client.Search.Issues(ctx, "assignee:mehrdadbn9 state:open", nil)
+		assignedIssuesResp, _, err := finder.client.SearchIssues(ctx, "assignee:mehrdadbn9 state:open", nil)
 		if err == nil && assignedIssuesResp != nil {
 			for _, ghIssue := range assignedIssuesResp.Issues {
 				issue := Issue{
-					Title:     *ghIssue.Title,
-					URL:       *ghIssue.HTMLURL,
-					Number:    *ghIssue.Number,
-					CreatedAt: ghIssue.CreatedAt.Time,
-					Comments:  *ghIssue.Comments,
-					Score:     0.0,
+					Title:       *ghIssue.Title,
+					URL:         *ghIssue.HTMLURL,
+					Number:      *ghIssue.Number,
+					CreatedAt:   ghIssue.CreatedAt.Time,
+					Comments:    *ghIssue.Comments,
+					Score:       0.0,
+					ReadingTime: EstimateReadingTime(ghIssue),
 				}
 				if ghIssue.Labels != nil {
 					for _, label := range ghIssue.Labels {
@@ -3274,6 +3554,24 @@ func main() {
 		return
 	}
 
+	if mode == "regression" {
+		log.Printf("\n=== FINDING REGRESSIONS REPORTED AFTER RECENT RELEASES ===")
+		config := loadRegressionConfigFromEnv()
+		regressions, err := finder.FindRegressionIssues(ctx, config)
+		if err != nil {
+			log.Printf("Error finding regressions: %v", err)
+			return
+		}
+		PrintRegressionIssues(regressions, config.WindowDays)
+		if notifier != nil {
+			notifier.logToFile(fmt.Sprintf("Found %d regressions after recent releases", len(regressions)))
+			for _, issue := range regressions {
+				notifier.logToNotificationsFile(issue.Title, issue.URL, issue.Score, "Regression")
+			}
+		}
+		return
+	}
+
 	if mode == "confirmed" {
 		log.Printf("\n=== FINDING CONFIRMED GOOD FIRST ISSUES ===")
 		log.Printf("Searching for issues with 'good first issue' + 'confirmed/triage/accepted' labels...")
@@ -3298,11 +3596,11 @@ func main() {
 
 		if len(newIssues) > 0 && finder.notifier != nil {
 			log.Printf("Sending notifications for %d new issues...", len(newIssues))
-			if err := finder.SendLocalAlert(newIssues); err != nil {
+			if _, err := finder.SendLocalAlert(newIssues); err != nil {
 				log.Printf("Error sending local alert: %v", err)
 			}
 			if finder.bot != nil {
-				if err := finder.SendTelegramAlert(newIssues); err != nil {
+				if _, err := finder.SendTelegramAlert(newIssues); err != nil {
 					log.Printf("Error sending Telegram alert: %v", err)
 				}
 			}
@@ -3358,8 +3656,8 @@ func main() {
 
 	if mode == "both" {
 		runCheck()
-		fmt.Println()
-		fmt.Println()
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout)
 		runGoodFirstIssues()
 		return
 	}
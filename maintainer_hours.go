@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaintainerHoursConfig controls how a repository's maintainer-active window
+// is estimated and whether comments are held back until it opens.
+type MaintainerHoursConfig struct {
+	Enabled      bool
+	WindowHours  int
+	MinSamples   int
+	LookbackDays int
+	CacheTTL     time.Duration
+}
+
+// ActivityWindow is the span of UTC hours in which most maintainer comments
+// on a repository were posted.
+type ActivityWindow struct {
+	StartHour int
+	Hours     int
+	Samples   int
+	Coverage  float64 // share of maintainer comments inside the window
+}
+
+type MaintainerHoursEstimator struct {
+	client GitHubAPI
+	db     *sqlx.DB
+	config *MaintainerHoursConfig
+	mu     sync.Mutex
+	cache  map[string]cachedActivityWindow
+}
+
+type cachedActivityWindow struct {
+	window     ActivityWindow
+	known      bool
+	computedAt time.Time
+}
+
+func loadMaintainerHoursConfigFromEnv() *MaintainerHoursConfig {
+	config := &MaintainerHoursConfig{
+		Enabled:      getEnvBool("MAINTAINER_HOURS_ENABLED", false),
+		WindowHours:  8,
+		MinSamples:   10,
+		LookbackDays: 30,
+		CacheTTL:     24 * time.Hour,
+	}
+
+	if hours := getEnvInt("MAINTAINER_HOURS_WINDOW", 8); hours > 0 && hours < 24 {
+		config.WindowHours = hours
+	}
+	if samples := getEnvInt("MAINTAINER_HOURS_MIN_SAMPLES", 10); samples > 0 {
+		config.MinSamples = samples
+	}
+	if days := getEnvInt("MAINTAINER_HOURS_LOOKBACK_DAYS", 30); days > 0 {
+		config.LookbackDays = days
+	}
+
+	return config
+}
+
+// EstimateActivityWindow buckets timestamps by UTC hour and returns the
+// contiguous window (wrapping past midnight) holding the most of them. It
+// reports false when there are too few samples to say anything.
+func EstimateActivityWindow(timestamps []time.Time, windowHours, minSamples int) (ActivityWindow, bool) {
+	if windowHours <= 0 || windowHours >= 24 || len(timestamps) < minSamples || len(timestamps) == 0 {
+		return ActivityWindow{}, false
+	}
+
+	var histogram [24]int
+	for _, ts := range timestamps {
+		histogram[ts.UTC().Hour()]++
+	}
+
+	bestStart, bestCount := 0, -1
+	for start := 0; start < 24; start++ {
+		count := 0
+		for h := 0; h < windowHours; h++ {
+			count += histogram[(start+h)%24]
+		}
+		if count > bestCount {
+			bestStart, bestCount = start, count
+		}
+	}
+
+	return ActivityWindow{
+		StartHour: bestStart,
+		Hours:     windowHours,
+		Samples:   len(timestamps),
+		Coverage:  float64(bestCount) / float64(len(timestamps)),
+	}, true
+}
+
+func (w ActivityWindow) Contains(t time.Time) bool {
+	offset := (t.UTC().Hour() - w.StartHour + 24) % 24
+	return offset < w.Hours
+}
+
+// NextStart returns t itself when it already falls inside the window,
+// otherwise the next time the window opens.
+func (w ActivityWindow) NextStart(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+	utc := t.UTC()
+	start := time.Date(utc.Year(), utc.Month(), utc.Day(), w.StartHour, 0, 0, 0, time.UTC)
+	if !start.After(utc) {
+		start = start.Add(24 * time.Hour)
+	}
+	return start
+}
+
+func (w ActivityWindow) String() string {
+	end := (w.StartHour + w.Hours) % 24
+	return fmt.Sprintf("%02d:00-%02d:00 UTC (%.0f%% of %d maintainer comments)", w.StartHour, end, w.Coverage*100, w.Samples)
+}
+
+func isMaintainerAssociation(association string) bool {
+	switch association {
+	case "OWNER", "MEMBER", "COLLABORATOR":
+		return true
+	}
+	return false
+}
+
+func NewMaintainerHoursEstimator(client GitHubAPI, db *sqlx.DB, config *MaintainerHoursConfig) *MaintainerHoursEstimator {
+	if config == nil {
+		config = loadMaintainerHoursConfigFromEnv()
+	}
+
+	e := &MaintainerHoursEstimator{
+		client: client,
+		db:     db,
+		config: config,
+		cache:  make(map[string]cachedActivityWindow),
+	}
+
+	if db != nil {
+		if err := e.initDB(); err != nil {
+			log.Printf("Warning: failed to initialize maintainer activity table: %v", err)
+		}
+	}
+
+	return e
+}
+
+func (e *MaintainerHoursEstimator) initDB() error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS maintainer_activity (
+		repo VARCHAR(255) PRIMARY KEY,
+		start_hour INT NOT NULL,
+		window_hours INT NOT NULL,
+		samples INT NOT NULL DEFAULT 0,
+		coverage FLOAT NOT NULL DEFAULT 0,
+		known BOOLEAN NOT NULL DEFAULT false,
+		computed_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+	`
+	_, err := e.db.Exec(schema)
+	return err
+}
+
+// Window returns the maintainer-active window for a repository, computing it
+// from recent comments at most once per CacheTTL. The boolean is false when
+// there is not enough maintainer activity to estimate one.
+func (e *MaintainerHoursEstimator) Window(ctx context.Context, owner, repo string) (ActivityWindow, bool) {
+	key := owner + "/" + repo
+
+	e.mu.Lock()
+	cached, ok := e.cache[key]
+	e.mu.Unlock()
+	if ok && time.Since(cached.computedAt) < e.config.CacheTTL {
+		return cached.window, cached.known
+	}
+
+	if stored, ok := e.loadWindow(key); ok {
+		e.storeInCache(key, stored)
+		return stored.window, stored.known
+	}
+
+	window, known := e.computeWindow(ctx, owner, repo)
+	entry := cachedActivityWindow{window: window, known: known, computedAt: time.Now()}
+	e.storeInCache(key, entry)
+	e.saveWindow(key, entry)
+	return window, known
+}
+
+func (e *MaintainerHoursEstimator) storeInCache(key string, entry cachedActivityWindow) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache[key] = entry
+}
+
+func (e *MaintainerHoursEstimator) loadWindow(key string) (cachedActivityWindow, bool) {
+	if e.db == nil {
+		return cachedActivityWindow{}, false
+	}
+
+	var row struct {
+		StartHour   int       `db:"start_hour"`
+		WindowHours int       `db:"window_hours"`
+		Samples     int       `db:"samples"`
+		Coverage    float64   `db:"coverage"`
+		Known       bool      `db:"known"`
+		ComputedAt  time.Time `db:"computed_at"`
+	}
+	err := e.db.Get(&row, `
+		SELECT start_hour, window_hours, samples, coverage, known, computed_at
+		FROM maintainer_activity WHERE repo = $1
+	`, key)
+	if err != nil || time.Since(row.ComputedAt) >= e.config.CacheTTL {
+		return cachedActivityWindow{}, false
+	}
+
+	return cachedActivityWindow{
+		window:     ActivityWindow{StartHour: row.StartHour, Hours: row.WindowHours, Samples: row.Samples, Coverage: row.Coverage},
+		known:      row.Known,
+		computedAt: row.ComputedAt,
+	}, true
+}
+
+func (e *MaintainerHoursEstimator) saveWindow(key string, entry cachedActivityWindow) {
+	if e.db == nil {
+		return
+	}
+
+	_, err := e.db.Exec(`
+		INSERT INTO maintainer_activity (repo, start_hour, window_hours, samples, coverage, known, computed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (repo) DO UPDATE SET
+			start_hour = $2, window_hours = $3, samples = $4, coverage = $5, known = $6, computed_at = $7
+	`, key, entry.window.StartHour, e.config.WindowHours, entry.window.Samples, entry.window.Coverage, entry.known, entry.computedAt)
+	if err != nil {
+		log.Printf("[MaintainerHours] Failed to save window for %s: %v", key, err)
+	}
+}
+
+func (e *MaintainerHoursEstimator) computeWindow(ctx context.Context, owner, repo string) (ActivityWindow, bool) {
+	if e.client == nil {
+		return ActivityWindow{}, false
+	}
+
+	since := time.Now().AddDate(0, 0, -e.config.LookbackDays)
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.String("created"),
+		Direction:   github.String("desc"),
+		Since:       &since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	// Issue number 0 lists comments across the whole repository.
+	comments, _, err := e.client.ListIssueComments(ctx, owner, repo, 0, opts)
+	if err != nil {
+		log.Printf("[MaintainerHours] Failed to list comments for %s/%s: %v", owner, repo, err)
+		return ActivityWindow{}, false
+	}
+
+	var timestamps []time.Time
+	for _, comment := range comments {
+		if isMaintainerAssociation(comment.GetAuthorAssociation()) {
+			timestamps = append(timestamps, comment.GetCreatedAt().Time)
+		}
+	}
+
+	window, known := EstimateActivityWindow(timestamps, e.config.WindowHours, e.config.MinSamples)
+	if known {
+		log.Printf("[MaintainerHours] %s/%s maintainers are most active %s", owner, repo, window)
+	}
+	return window, known
+}
+
+// NextCommentTime returns when a comment on the repository should be posted:
+// now if maintainers are active or their hours are unknown, otherwise the
+// start of their next active window.
+func (e *MaintainerHoursEstimator) NextCommentTime(ctx context.Context, owner, repo string, now time.Time) (time.Time, ActivityWindow, bool) {
+	if e == nil || !e.config.Enabled {
+		return now, ActivityWindow{}, false
+	}
+
+	window, known := e.Window(ctx, owner, repo)
+	if !known {
+		return now, ActivityWindow{}, false
+	}
+	return window.NextStart(now), window, true
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func hoursAt(hours ...int) []time.Time {
+	base := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	var timestamps []time.Time
+	for _, h := range hours {
+		timestamps = append(timestamps, base.Add(time.Duration(h)*time.Hour))
+	}
+	return timestamps
+}
+
+func TestEstimateActivityWindow(t *testing.T) {
+	timestamps := hoursAt(14, 15, 15, 16, 17, 18, 19, 20, 21, 3)
+
+	window, ok := EstimateActivityWindow(timestamps, 8, 5)
+	if !ok {
+		t.Fatal("expected a window to be estimated")
+	}
+	if window.StartHour != 14 || window.Hours != 8 {
+		t.Errorf("window = %02d:00 for %dh, want 14:00 for 8h", window.StartHour, window.Hours)
+	}
+	if window.Coverage != 0.9 {
+		t.Errorf("coverage = %.2f, want 0.90", window.Coverage)
+	}
+
+	if _, ok := EstimateActivityWindow(timestamps[:3], 8, 5); ok {
+		t.Error("expected no window with fewer samples than the minimum")
+	}
+}
+
+func TestEstimateActivityWindow_WrapsMidnight(t *testing.T) {
+	window, ok := EstimateActivityWindow(hoursAt(22, 23, 23, 0, 1, 1, 2), 6, 5)
+	if !ok {
+		t.Fatal("expected a window to be estimated")
+	}
+	if !window.Contains(time.Date(2026, 3, 2, 23, 30, 0, 0, time.UTC)) || !window.Contains(time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("window starting %02d:00 should span midnight", window.StartHour)
+	}
+}
+
+func TestActivityWindow_NextStart(t *testing.T) {
+	window := ActivityWindow{StartHour: 14, Hours: 8}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{"inside", time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC), time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)},
+		{"before today", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC)},
+		{"after today", time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC), time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := window.NextStart(tt.now); !got.Equal(tt.expected) {
+				t.Errorf("NextStart(%v) = %v, want %v", tt.now, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaintainerHoursEstimator_Disabled(t *testing.T) {
+	e := NewMaintainerHoursEstimator(nil, nil, &MaintainerHoursConfig{Enabled: false, WindowHours: 8})
+	now := time.Now()
+
+	postAt, _, ok := e.NextCommentTime(context.Background(), "owner", "repo", now)
+	if ok || !postAt.Equal(now) {
+		t.Errorf("disabled estimator should post immediately, got %v (known=%v)", postAt, ok)
+	}
+
+	var nilEstimator *MaintainerHoursEstimator
+	if _, _, ok := nilEstimator.NextCommentTime(context.Background(), "owner", "repo", now); ok {
+		t.Error("nil estimator should not report a window")
+	}
+}
+
+func TestPostDueComments(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	gh := &fakeGitHubAPI{issues: map[string]*github.Issue{
+		"kubernetes/kubectl#5": {Number: github.Int(5), State: github.String("open")},
+		"helm/helm#6":          {Number: github.Int(6), State: github.String("closed")},
+	}}
+	af := &AutoFinder{
+		config:       DefaultAutoFinderConfig(),
+		db:           db,
+		useDB:        true,
+		githubClient: gh,
+		smartLimiter: NewSmartLimiter(DefaultSmartLimitsConfig(), nil, nil, true),
+		timing:       NewMaintainerHoursEstimator(gh, nil, &MaintainerHoursConfig{Enabled: false}),
+	}
+
+	postAt := time.Now().Add(-time.Minute)
+	fake.expect("FROM deferred_comments").returns(
+		[]string{"issue_url", "owner", "repo", "issue_number", "score", "body", "post_at"},
+		[]driver.Value{"https://github.com/kubernetes/kubectl/issues/5", "kubernetes", "kubectl", int64(5), 0.9, "I'd like to work on this.", postAt},
+		[]driver.Value{"https://github.com/helm/helm/issues/6", "helm", "helm", int64(6), 0.9, "I'd like to work on this.", postAt},
+	)
+
+	// The open issue is posted, recorded and removed from the queue.
+	fake.expect("SELECT comments_count FROM daily_limits")
+	fake.expect("SELECT repos_commented FROM daily_limits")
+	fake.expect("INSERT INTO comment_history").affects(1)
+	fake.expect("INSERT INTO daily_limits").affects(1)
+	fake.expect("INSERT INTO found_issues").affects(1)
+	fake.expect("DELETE FROM deferred_comments", "https://github.com/kubernetes/kubectl/issues/5").affects(1)
+
+	// The closed one is dropped without a comment.
+	fake.expect("SELECT comments_count FROM daily_limits")
+	fake.expect("SELECT repos_commented FROM daily_limits")
+	fake.expect("DELETE FROM deferred_comments", "https://github.com/helm/helm/issues/6").affects(1)
+
+	if posted := af.PostDueComments(context.Background()); posted != 1 {
+		t.Errorf("posted %d comments, want 1", posted)
+	}
+	if !slices.Equal(gh.created, []string{"kubernetes/kubectl#5"}) {
+		t.Errorf("created comments = %v", gh.created)
+	}
+}
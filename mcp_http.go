@@ -3,14 +3,29 @@ package main
 import (
 	"context"
 	"fmt"
+	"html/template"
 	"log"
+	"net"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-func RunMCPHTTPServer(port int) error {
+// isLoopbackHost reports whether a listen host only accepts local
+// connections. An empty host listens on every interface.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// RunMCPHTTPServer listens on host, which defaults to loopback: the deep
+// link and extension routes act on the local database.
+func RunMCPHTTPServer(host string, port int) error {
 	mcpServer, err := NewMCPServer()
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
@@ -28,6 +43,9 @@ func RunMCPHTTPServer(port int) error {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+	mcpServer.RegisterDeepLinkRoutes(mux)
+	mcpServer.RegisterExtensionRoutes(mux, loadExtensionAPIConfigFromEnv())
+	mcpServer.RegisterScoreRoutes(mux, host)
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/" {
 			w.WriteHeader(http.StatusOK)
@@ -36,6 +54,10 @@ func RunMCPHTTPServer(port int) error {
 Endpoints:
   /mcp     - MCP protocol endpoint (POST requests)
   /health  - Health check endpoint
+  /actions/{track,snooze,preview}?url=<issue-url>&sig=<token> - Deep link actions from alerts
+  /api/extension/issue?url=<issue-url>  - Score and seen/tracked status (browser extension)
+  /api/extension/track                  - Track an issue (POST {"url": ...})
+  /score   - Score breakdown (POST {"url": ...} or GitHub issue JSON)
 
 Available MCP Tools:
   - find_issues: Find issues based on various criteria
@@ -57,16 +79,16 @@ Available MCP Tools:
 	})
 
 	server := &http.Server{
-		Addr:         fmt.Sprintf(":%d", port),
+		Addr:         net.JoinHostPort(host, strconv.Itoa(port)),
 		Handler:      mux,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
 
-	log.Printf("MCP HTTP server starting on port %d", port)
-	log.Printf("MCP endpoint: http://localhost:%d/mcp", port)
-	log.Printf("Health check: http://localhost:%d/health", port)
+	log.Printf("MCP HTTP server starting on %s", server.Addr)
+	log.Printf("MCP endpoint: http://%s/mcp", server.Addr)
+	log.Printf("Health check: http://%s/health", server.Addr)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -84,3 +106,66 @@ Available MCP Tools:
 
 	return server.Shutdown(shutdownCtx)
 }
+
+var deepLinkConfirmPage = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>{{.Action}} issue</title></head>
+<body style="font-family:sans-serif;max-width:640px;margin:40px auto;">
+<p>{{.Action}} <a href="{{.URL}}">{{.URL}}</a>?</p>
+<form method="POST" action="/actions/{{.Action}}">
+<input type="hidden" name="url" value="{{.URL}}">
+<input type="hidden" name="sig" value="{{.Sig}}">
+<button type="submit">Confirm {{.Action}}</button>
+</form>
+</body></html>
+`))
+
+// RegisterDeepLinkRoutes serves the web-mode alert links. Every request must
+// carry the link's signature. Preview runs on GET; track and snooze change
+// state, so GET only shows a confirm page that POSTs back.
+func (s *MCPServer) RegisterDeepLinkRoutes(mux *http.ServeMux) {
+	handler := s.newDeepLinkActionHandler()
+
+	for _, action := range []DeepLinkAction{DeepLinkTrack, DeepLinkSnooze, DeepLinkPreview} {
+		action := action
+		mux.HandleFunc("/actions/"+string(action), func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodPost {
+				w.Header().Set("Allow", "GET, POST")
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			issueURL, sig := r.URL.Query().Get("url"), r.URL.Query().Get("sig")
+			if r.Method == http.MethodPost {
+				issueURL, sig = r.PostFormValue("url"), r.PostFormValue("sig")
+			}
+			if issueURL == "" {
+				http.Error(w, "missing url parameter", http.StatusBadRequest)
+				return
+			}
+			if !handler.config.Verify(action, issueURL, sig) {
+				http.Error(w, "invalid or missing link signature", http.StatusForbidden)
+				return
+			}
+
+			if r.Method == http.MethodGet && action != DeepLinkPreview {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				deepLinkConfirmPage.Execute(w, struct {
+					Action DeepLinkAction
+					URL    string
+					Sig    string
+				}{action, issueURL, sig})
+				return
+			}
+
+			result, err := handler.Execute(r.Context(), action, issueURL)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, result)
+		})
+	}
+}
@@ -233,12 +233,12 @@ func (s *MCPServer) handleIssueResource(ctx context.Context, req *mcp.ReadResour
 		return nil, fmt.Errorf("invalid issue number: %s", issueNumberStr)
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
-	repoInfo, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := s.client.GetRepository(ctx, owner, repo)
 	var stars int
 	var language string
 	if err == nil && repoInfo != nil {
@@ -309,7 +309,7 @@ func (s *MCPServer) handleRepoResource(ctx context.Context, req *mcp.ReadResourc
 	owner := parts[0]
 	repo := parts[1]
 
-	repoInfo, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := s.client.GetRepository(ctx, owner, repo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repository: %w", err)
 	}
@@ -354,7 +354,7 @@ func (s *MCPServer) handleRepoResource(ctx context.Context, req *mcp.ReadResourc
 		result["config"] = nil
 	}
 
-	issues, _, err := s.client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{
+	issues, _, err := s.client.ListRepoIssues(ctx, owner, repo, &github.IssueListByRepoOptions{
 		State:  "open",
 		Labels: []string{"good first issue"},
 		ListOptions: github.ListOptions{
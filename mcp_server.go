@@ -12,7 +12,6 @@ import (
 	"github.com/google/go-github/v58/github"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
-	"golang.org/x/oauth2"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -28,7 +27,7 @@ type MCPServer struct {
 	tracker     *IssueTracker
 	commentGen  *SmartCommentGenerator
 	repoManager *RepoManager
-	client      *github.Client
+	client      GitHubAPI
 	db          *sqlx.DB
 	config      *Config
 }
@@ -43,18 +42,13 @@ func NewMCPServer() (*MCPServer, error) {
 		return nil, fmt.Errorf("config validation failed: %w", err)
 	}
 
-	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: config.GitHubToken},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	client := github.NewClient(tc)
-
 	db, err := sqlx.Connect("postgres", config.DBConnectionString)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	client := newGitHubClient(context.Background(), config.GitHubToken, db)
+
 	notifier, err := NewLocalNotifier(config.Email)
 	if err != nil {
 		log.Printf("Warning: failed to create local notifier: %v", err)
@@ -72,17 +66,33 @@ func NewMCPServer() (*MCPServer, error) {
 
 	commentGen := NewSmartCommentGenerator()
 
+	repoManager := NewRepoManager()
+	if moves, err := loadRepoMoves(db); err != nil {
+		log.Printf("Warning: failed to load repository moves: %v", err)
+	} else {
+		repoManager.ApplyRepoMoves(moves)
+	}
+
 	return &MCPServer{
 		finder:      finder,
 		tracker:     tracker,
 		commentGen:  commentGen,
-		repoManager: NewRepoManager(),
+		repoManager: repoManager,
 		client:      client,
 		db:          db,
 		config:      config,
 	}, nil
 }
 
+// pipelineScore scores an issue the way find does, so HTTP surfaces agree
+// with the alerts.
+func (s *MCPServer) pipelineScore(ctx context.Context, p Project, issue *github.Issue) (ScoreResult, SignOffRequirement) {
+	if finder, ok := s.finder.(*IssueFinder); ok {
+		return finder.scoreIssue(ctx, p, issue)
+	}
+	return NewIssueScorer().ScoreIssueWithConfidence(issue, p), SignOffUnknown
+}
+
 func (s *MCPServer) CreateServer() *mcp.Server {
 	srv := mcp.NewServer(&mcp.Implementation{
 		Name:    "github-issue-finder",
@@ -408,7 +418,7 @@ func (s *MCPServer) handleGetIssueScore(ctx context.Context, req *mcp.CallToolRe
 		return nil, nil, fmt.Errorf("owner, repo, and issue_number are required")
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
@@ -418,7 +428,7 @@ func (s *MCPServer) handleGetIssueScore(ctx context.Context, req *mcp.CallToolRe
 		Name: repo,
 	}
 
-	repoInfo, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := s.client.GetRepository(ctx, owner, repo)
 	if err == nil && repoInfo != nil {
 		project.Stars = repoInfo.GetStargazersCount()
 		project.Category = repoInfo.GetLanguage()
@@ -479,7 +489,7 @@ func (s *MCPServer) handleTrackIssue(ctx context.Context, req *mcp.CallToolReque
 		return nil, nil, fmt.Errorf("owner, repo, and issue_number are required")
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
@@ -630,7 +640,7 @@ func (s *MCPServer) handleGenerateComment(ctx context.Context, req *mcp.CallTool
 		return nil, nil, fmt.Errorf("owner, repo, and issue_number are required")
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
@@ -823,12 +833,12 @@ func (s *MCPServer) handleGetIssueDetails(ctx context.Context, req *mcp.CallTool
 		return nil, nil, fmt.Errorf("owner, repo, and issue_number are required")
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
-	repoInfo, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := s.client.GetRepository(ctx, owner, repo)
 	var stars int
 	var language string
 	if err == nil && repoInfo != nil {
@@ -894,12 +904,12 @@ func (s *MCPServer) handleAnalyzeIssue(ctx context.Context, req *mcp.CallToolReq
 		return nil, nil, fmt.Errorf("owner, repo, and issue_number are required")
 	}
 
-	issue, _, err := s.client.Issues.Get(ctx, owner, repo, issueNumber)
+	issue, _, err := s.client.GetIssue(ctx, owner, repo, issueNumber)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
-	repoInfo, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	repoInfo, _, err := s.client.GetRepository(ctx, owner, repo)
 	var stars int
 	var language string
 	if err == nil && repoInfo != nil {
@@ -1085,8 +1095,8 @@ func RunMCPStdioServer() error {
 
 func RunMCPCommand() {
 	if len(os.Args) < 2 {
-		fmt.Println("Usage: go run . mcp [stdio]")
-		fmt.Println("  stdio - Run with stdio transport (for CLI usage)")
+		fmt.Fprintln(stdout, "Usage: go run . mcp [stdio]")
+		fmt.Fprintln(stdout, "  stdio - Run with stdio transport (for CLI usage)")
 		os.Exit(1)
 	}
 
@@ -1098,8 +1108,8 @@ func RunMCPCommand() {
 			log.Fatalf("Server error: %v", err)
 		}
 	default:
-		fmt.Printf("Unknown mode: %s\n", mode)
-		fmt.Println("Use 'stdio'")
+		fmt.Fprintf(stdout, "Unknown mode: %s\n", mode)
+		fmt.Fprintln(stdout, "Use 'stdio'")
 		os.Exit(1)
 	}
 }
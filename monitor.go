@@ -16,7 +16,7 @@ import (
 
 type IssueMonitor struct {
 	config    *MonitorConfig
-	client    *github.Client
+	client    GitHubAPI
 	notifier  *LocalNotifier
 	storage   *MonitorStorage
 	limiter   *SmartLimiter
@@ -262,7 +262,7 @@ func (s *MonitorStorage) ClearOld(maxAge time.Duration) {
 	s.save()
 }
 
-func NewIssueMonitor(config *MonitorConfig, client *github.Client, notifier *LocalNotifier, fileStore *FileStorage) (*IssueMonitor, error) {
+func NewIssueMonitor(config *MonitorConfig, client GitHubAPI, notifier *LocalNotifier, fileStore *FileStorage) (*IssueMonitor, error) {
 	if config == nil {
 		config = DefaultMonitorConfig()
 	}
@@ -410,7 +410,7 @@ func (m *IssueMonitor) fetchIssues(ctx context.Context, repo RepoConfig) []*gith
 	}
 
 	for {
-		issues, resp, err := m.client.Issues.ListByRepo(ctx, repo.Owner, repo.Name, opts)
+		issues, resp, err := m.client.ListRepoIssues(ctx, repo.Owner, repo.Name, opts)
 		if err != nil {
 			log.Printf("[Monitor] Error fetching issues for %s/%s: %v", repo.Owner, repo.Name, err)
 			break
@@ -481,21 +481,21 @@ func (m *IssueMonitor) notifyLocal(issues []FoundIssue) {
 		log.Printf("[Monitor] Failed to send desktop notification: %v", err)
 	}
 
-	fmt.Printf("\n%s\n", title)
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintf(stdout, "\n%s\n", title)
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 	for i, issue := range issues {
 		emoji := "fire"
 		if issue.Score < 0.85 {
 			emoji = "star"
 		}
-		fmt.Printf("\n[%s] %d. %s\n", emoji, i+1, issue.Title)
-		fmt.Printf("    Repo: %s | Score: %.2f\n", issue.Repo, issue.Score)
-		fmt.Printf("    URL: %s\n", issue.URL)
+		fmt.Fprintf(stdout, "\n[%s] %d. %s\n", emoji, i+1, issue.Title)
+		fmt.Fprintf(stdout, "    Repo: %s | Score: %.2f\n", issue.Repo, issue.Score)
+		fmt.Fprintf(stdout, "    URL: %s\n", issue.URL)
 		if len(issue.Labels) > 0 {
-			fmt.Printf("    Labels: %s\n", strings.Join(issue.Labels, ", "))
+			fmt.Fprintf(stdout, "    Labels: %s\n", strings.Join(issue.Labels, ", "))
 		}
 	}
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(stdout, strings.Repeat("=", 60))
 }
 
 func (m *IssueMonitor) notifyEmail(issues []FoundIssue) error {
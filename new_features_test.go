@@ -11,10 +11,11 @@ func TestEnhancedScorer_DescriptionQuality(t *testing.T) {
 	scorer := NewEnhancedScorer()
 
 	tests := []struct {
-		name     string
-		title    string
-		body     string
-		minScore float64
+		name         string
+		title        string
+		body         string
+		minScore     float64
+		wantKeywords bool // scope and criteria hits go to the capped keyword pool
 	}{
 		{
 			name:     "empty body",
@@ -29,22 +30,23 @@ func TestEnhancedScorer_DescriptionQuality(t *testing.T) {
 			minScore: 0.0,
 		},
 		{
-			name:     "good description with code",
-			title:    "Fix bug in parser",
-			body:     "This is a bug that needs fixing.\n\nSteps to reproduce:\n1. Run the code\n2. See error\n\nExpected: success\nActual: failure\n\n```go\nfunc main() {}\n```",
-			minScore: 0.4,
+			name:         "good description with code",
+			title:        "Fix bug in parser",
+			body:         "This is a bug that needs fixing.\n\nSteps to reproduce:\n1. Run the code\n2. See error\n\nExpected: success\nActual: failure\n\n```go\nfunc main() {}\n```",
+			minScore:     0.4,
+			wantKeywords: true,
 		},
 		{
-			name:     "description with acceptance criteria",
-			title:    "Add new feature",
-			body:     "Add support for X.\n\nAcceptance criteria:\n- [ ] Implement feature\n- [ ] Add tests\n- [ ] Update docs",
-			minScore: 0.1,
+			name:         "description with acceptance criteria",
+			title:        "Add new feature",
+			body:         "Add support for X.\n\nAcceptance criteria:\n- [ ] Implement feature\n- [ ] Add tests\n- [ ] Update docs",
+			wantKeywords: true,
 		},
 		{
-			name:     "clear scope",
-			title:    "Fix bug in pkg/parser",
-			body:     "File: pkg/parser/parse.go\nFunc: parseInput\nNeed to handle edge case",
-			minScore: 0.1,
+			name:         "clear scope",
+			title:        "Fix bug in pkg/parser",
+			body:         "File: pkg/parser/parse.go\nFunc: parseInput\nNeed to handle edge case",
+			wantKeywords: true,
 		},
 	}
 
@@ -55,10 +57,14 @@ func TestEnhancedScorer_DescriptionQuality(t *testing.T) {
 				Body:  github.String(tt.body),
 			}
 
-			score := scorer.scoreDescriptionQuality(issue)
+			var signals scoreSignals
+			score := scorer.scoreDescriptionQuality(issue, &signals)
 			if score < tt.minScore {
 				t.Errorf("scoreDescriptionQuality() = %v, want at least %v", score, tt.minScore)
 			}
+			if got := len(signals.keywords[KeywordClarity]) > 0; got != tt.wantKeywords {
+				t.Errorf("clarity keyword hits = %v, want %v", signals.keywords[KeywordClarity], tt.wantKeywords)
+			}
 		})
 	}
 }
@@ -209,7 +215,8 @@ func TestEnhancedScorer_BonusModifiers(t *testing.T) {
 				Comments:  github.Int(0),
 			}
 
-			bonus := scorer.applyBonusModifiers(issue, project)
+			var signals scoreSignals
+			bonus := scorer.applyBonusModifiers(issue, project, &signals)
 			if bonus < tt.minBonus {
 				t.Errorf("applyBonusModifiers() = %v, want at least %v", bonus, tt.minBonus)
 			}
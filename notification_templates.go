@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+type NotificationChannel string
+
+const (
+	ChannelTelegram NotificationChannel = "telegram"
+	ChannelEmail    NotificationChannel = "email"
+	ChannelLocal    NotificationChannel = "local"
+)
+
+type AlertType string
+
+const (
+	AlertNewIssue            AlertType = "new_issue"
+	AlertDigest              AlertType = "digest"
+	AlertQualified           AlertType = "qualified"
+	AlertAssignmentConfirmed AlertType = "assignment_confirmed"
+	AlertAssignmentRequest   AlertType = "assignment_request"
+)
+
+// Template parts. Telegram and local alerts have a single body; email alerts
+// are split into subject, plain-text and HTML parts.
+const (
+	TemplatePartBody    = ""
+	TemplatePartSubject = "subject"
+	TemplatePartText    = "text"
+	TemplatePartHTML    = "html"
+)
+
+// NotificationData is what every notification template is executed against.
+type NotificationData struct {
+	Channel   NotificationChannel
+	Alert     AlertType
+	Issue     Issue
+	Issues    []Issue
+	Qualified *QualifiedIssue
+	Breakdown *ScoreBreakdown
+	Links     *DeepLinks
+	Now       time.Time
+}
+
+type NotificationTemplates struct {
+	Dir string
+}
+
+var (
+	activeNotificationTemplates *NotificationTemplates
+	notificationTemplatesOnce   sync.Once
+)
+
+var defaultNotificationTemplates = map[string]string{
+	"telegram/new_issue": `{{scoreEmoji .Issue.Score}} *{{truncate .Issue.Title 80}}* ({{printf "%.2f" .Issue.Score}})
+{{.Issue.URL}}
+{{.Issue.Project.Org}}/{{.Issue.Project.Name}} ({{.Issue.Project.Stars}}★)` +
+		`{{if gt .Issue.ReadingTime.Minutes 0}} · {{.Issue.ReadingTime.String}}{{end}}` +
+		`{{with .Issue.SignOff.Badge}} · {{.}}{{end}}` +
+		`{{if .Issue.Labels}}
+Labels: {{join .Issue.Labels ", "}}{{end}}` +
+		`{{with .Links}}
+{{.MarkdownRow}}{{end}}
+
+`,
+
+	"local/new_issue": `
+{{scoreEmoji .Issue.Score}} {{.Issue.Project.Category}}
+{{T "field.score"}}: {{printf "%.2f" .Issue.Score}} | {{T "field.stars"}}: {{.Issue.Project.Stars}} | {{T "field.comments"}}: {{.Issue.Comments}}
+{{T "field.title"}}: {{.Issue.Title}}
+{{T "field.url"}}: {{.Issue.URL}}
+{{if .Issue.Labels}}{{T "field.labels"}}: {{join .Issue.Labels ", "}}
+{{end}}{{T "field.created"}}: {{date .Issue.CreatedAt "2006-01-02"}}
+{{repeat "-" 80}}
+`,
+
+	"telegram/digest": `📰 *{{T "email.digest_text" (date .Now "January 2, 2006")}}*
+{{range first .Issues 20}}{{scoreEmoji .Score}} [{{truncate .Title 80}}]({{.URL}}) ({{printf "%.2f" .Score}})
+{{end}}{{with more .Issues 20}}{{T "email.digest_more" .}}
+{{end}}`,
+
+	"telegram/qualified": `{{scoreEmoji .Qualified.QualifiedScore.TotalScore}} *{{truncate .Qualified.Title 80}}* ({{printf "%.2f" .Qualified.QualifiedScore.TotalScore}})
+{{.Qualified.URL}}
+{{.Qualified.Project.Org}}/{{.Qualified.Project.Name}} ({{stars .Qualified.Project.Stars}}★) · {{title (print .Qualified.Type)}}
+
+`,
+
+	"telegram/assignment_confirmed": `✅ *Assignment Confirmed*
+{{truncate .Issue.Title 80}}
+{{.Issue.URL}}
+`,
+
+	"telegram/assignment_request": `📤 *Assignment Request Sent*
+{{truncate .Issue.Title 80}}
+{{.Issue.URL}}
+`,
+
+	"local/digest": `
+{{T "email.digest_text" (date .Now "2006-01-02")}}
+{{T "email.digest_count" (len .Issues)}}
+{{range .Issues}}- [{{printf "%.2f" .Score}}] {{.Title}}
+  {{.URL}}
+{{end}}{{repeat "-" 80}}
+`,
+
+	"local/qualified": `
+{{T "notify.desktop"}}
+{{T "notify.qualified" .Qualified.Project.Name}}
+{{.Qualified.Title}}
+Score: {{printf "%.2f" .Qualified.QualifiedScore.TotalScore}} | {{.Qualified.Type}}
+`,
+
+	"local/assignment_confirmed": `
+✅ Assignment Confirmed: {{.Issue.Title}}
+{{T "field.url"}}: {{.Issue.URL}}
+`,
+
+	"local/assignment_request": `
+📤 Assignment Request Sent: {{.Issue.Title}}
+{{T "field.url"}}: {{.Issue.URL}}
+`,
+}
+
+func loadNotificationTemplatesFromEnv() *NotificationTemplates {
+	return &NotificationTemplates{
+		Dir: strings.TrimSpace(os.Getenv("NOTIFICATION_TEMPLATES_DIR")),
+	}
+}
+
+func getNotificationTemplates() *NotificationTemplates {
+	notificationTemplatesOnce.Do(func() {
+		activeNotificationTemplates = loadNotificationTemplatesFromEnv()
+	})
+	return activeNotificationTemplates
+}
+
+func notificationTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"scoreEmoji": getScoreEmoji,
+		"scoreLabel": getScoreLabel,
+		"priority":   scorePriority,
+		"truncate":   func(s string, n int) string { return truncateString(s, n) },
+		"join":       strings.Join,
+		"repeat":     strings.Repeat,
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"date":       func(t time.Time, layout string) string { return t.Format(layout) },
+		"T":          T,
+
+		"dir":            localeDirection,
+		"stars":          formatStars,
+		"title":          strings.Title,
+		"indent":         func(s, prefix string) string { return strings.ReplaceAll(s, "\n", "\n"+prefix) },
+		"relevantLabels": filterRelevantLabels,
+		"linksFor":       func(url string) *DeepLinks { return getDeepLinkConfig().LinksFor(url) },
+		"htmlLinks":      func(l *DeepLinks) htmltemplate.HTML { return htmltemplate.HTML(l.HTMLRow()) },
+		"goodFirst":      func(issues []Issue) []Issue { return filterGoodFirst(issues, true) },
+		"notGoodFirst":   func(issues []Issue) []Issue { return filterGoodFirst(issues, false) },
+		"first":          func(issues []Issue, n int) []Issue { return issues[:min(n, len(issues))] },
+		"more":           func(issues []Issue, n int) int { return max(len(issues)-n, 0) },
+	}
+}
+
+func filterGoodFirst(issues []Issue, goodFirst bool) []Issue {
+	var out []Issue
+	for _, issue := range issues {
+		if issue.IsGoodFirst == goodFirst {
+			out = append(out, issue)
+		}
+	}
+	return out
+}
+
+func scorePriority(score float64) string {
+	if score >= 0.8 {
+		return "High"
+	} else if score < 0.6 {
+		return "Low"
+	}
+	return "Medium"
+}
+
+func notificationTemplateName(channel NotificationChannel, alert AlertType, part string) string {
+	name := string(channel) + "/" + string(alert)
+	if part != TemplatePartBody {
+		name += "." + part
+	}
+	return name
+}
+
+// overridePath is where a user-supplied template for the given slot lives,
+// e.g. <dir>/telegram/new_issue.tmpl or <dir>/email/digest.html.tmpl.
+func (t *NotificationTemplates) overridePath(channel NotificationChannel, alert AlertType, part string) string {
+	if t == nil || t.Dir == "" {
+		return ""
+	}
+	file := string(alert)
+	if part != TemplatePartBody {
+		file += "." + part
+	}
+	return filepath.Join(t.Dir, string(channel), file+".tmpl")
+}
+
+// Render executes the template for a channel/alert slot. An override file wins
+// over the built-in default; a broken override is logged and the default is
+// used instead. ok is false when neither exists.
+func (t *NotificationTemplates) Render(channel NotificationChannel, alert AlertType, part string, data NotificationData) (string, bool) {
+	data.Channel = channel
+	data.Alert = alert
+	if data.Now.IsZero() {
+		data.Now = time.Now()
+	}
+
+	name := notificationTemplateName(channel, alert, part)
+
+	if path := t.overridePath(channel, alert, part); path != "" {
+		if src, err := os.ReadFile(path); err == nil {
+			out, err := executeNotificationTemplate(name, string(src), part == TemplatePartHTML, data)
+			if err == nil {
+				return out, true
+			}
+			log.Printf("[Templates] Override %s failed, using default: %v", path, err)
+		} else if !os.IsNotExist(err) {
+			log.Printf("[Templates] Failed to read %s: %v", path, err)
+		}
+	}
+
+	src, ok := defaultNotificationTemplates[name]
+	if !ok {
+		src, ok = defaultEmailTemplates[name]
+	}
+	if !ok {
+		return "", false
+	}
+	out, err := executeNotificationTemplate(name, src, part == TemplatePartHTML, data)
+	if err != nil {
+		log.Printf("[Templates] Default template %s failed: %v", name, err)
+		return "", false
+	}
+	return out, true
+}
+
+func executeNotificationTemplate(name, src string, html bool, data NotificationData) (string, error) {
+	var exec interface {
+		Execute(io.Writer, any) error
+	}
+
+	if html {
+		tmpl, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(notificationTemplateFuncs())).Parse(src)
+		if err != nil {
+			return "", fmt.Errorf("parse %s: %w", name, err)
+		}
+		exec = tmpl
+	} else {
+		tmpl, err := template.New(name).Funcs(notificationTemplateFuncs()).Parse(src)
+		if err != nil {
+			return "", fmt.Errorf("parse %s: %w", name, err)
+		}
+		exec = tmpl
+	}
+
+	var sb strings.Builder
+	if err := exec.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("execute %s: %w", name, err)
+	}
+	return sb.String(), nil
+}
+
+// RenderEmail renders the subject, plain-text and HTML parts of an email
+// alert, each from its override file when there is one.
+func (t *NotificationTemplates) RenderEmail(alert AlertType, data NotificationData) *EmailTemplate {
+	subject, _ := t.Render(ChannelEmail, alert, TemplatePartSubject, data)
+	text, _ := t.Render(ChannelEmail, alert, TemplatePartText, data)
+	html, _ := t.Render(ChannelEmail, alert, TemplatePartHTML, data)
+	return &EmailTemplate{
+		Subject:  strings.TrimSpace(subject),
+		TextBody: text,
+		HTMLBody: html,
+	}
+}
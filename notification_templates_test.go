@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func templateTestIssue() Issue {
+	return Issue{
+		Title:       "Fix panic in config loader",
+		URL:         "https://github.com/golang/go/issues/77519",
+		Score:       0.85,
+		Comments:    2,
+		Labels:      []string{"good first issue", "bug"},
+		CreatedAt:   time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		ReadingTime: ReadingEstimate{Words: 400, Minutes: 2},
+		Project:     Project{Org: "golang", Name: "go", Stars: 120000, Category: "Language"},
+	}
+}
+
+func TestNotificationTemplates_TelegramDefault(t *testing.T) {
+	issue := templateTestIssue()
+	links := (&DeepLinkConfig{Enabled: true, Mode: "web", BaseURL: "http://localhost:8080"}).LinksFor(issue.URL)
+
+	got, ok := (&NotificationTemplates{}).Render(ChannelTelegram, AlertNewIssue, TemplatePartBody, NotificationData{Issue: issue, Links: links})
+	if !ok {
+		t.Fatal("expected built-in telegram template")
+	}
+
+	want := fmt.Sprintf("🔥 *%s* (0.85)\n%s\ngolang/go (120000★) · %s\nLabels: good first issue, bug\n%s\n\n",
+		issue.Title, issue.URL, issue.ReadingTime.String(), links.MarkdownRow())
+	if got != want {
+		t.Errorf("Render() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestNotificationTemplates_LocalDefault(t *testing.T) {
+	issue := templateTestIssue()
+	issue.Labels = nil
+
+	got, ok := (&NotificationTemplates{}).Render(ChannelLocal, AlertNewIssue, TemplatePartBody, NotificationData{Issue: issue})
+	if !ok {
+		t.Fatal("expected built-in local template")
+	}
+
+	for _, want := range []string{"\n🔥 Language\n", issue.URL, "2026-03-04", strings.Repeat("-", 80)} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, T("field.labels")) {
+		t.Errorf("Render() should omit labels line when there are none:\n%s", got)
+	}
+}
+
+func TestNotificationTemplates_Overrides(t *testing.T) {
+	dir := t.TempDir()
+	write := func(rel, body string) {
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("telegram/new_issue.tmpl", `{{.Channel}}:{{.Alert}} {{truncate .Issue.Title 10}} {{priority .Issue.Score}}`)
+	write("local/new_issue.tmpl", `{{.Issue.Missing}}`)
+	write("email/new_issue.subject.tmpl", "  [{{scoreLabel .Issue.Score}}] {{.Issue.Title}}\n")
+	write("email/new_issue.html.tmpl", `<b>{{.Issue.Title}}</b>`)
+
+	templates := &NotificationTemplates{Dir: dir}
+	issue := templateTestIssue()
+	issue.Title = "Escape <script> in titles"
+
+	tests := []struct {
+		name    string
+		channel NotificationChannel
+		want    string
+	}{
+		{name: "override wins", channel: ChannelTelegram, want: "telegram:new_issue Escape ... High"},
+		{name: "broken override falls back", channel: ChannelLocal, want: "\n🔥 Language\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := templates.Render(tt.channel, AlertNewIssue, TemplatePartBody, NotificationData{Issue: issue})
+			if !ok {
+				t.Fatal("expected a rendered template")
+			}
+			if !strings.HasPrefix(got, tt.want) {
+				t.Errorf("Render() = %q, want prefix %q", got, tt.want)
+			}
+		})
+	}
+
+	email := templates.RenderEmail(AlertNewIssue, NotificationData{Issue: issue})
+	if email.Subject != "[(Excellent)] Escape <script> in titles" {
+		t.Errorf("Subject = %q", email.Subject)
+	}
+	if email.HTMLBody != "<b>Escape &lt;script&gt; in titles</b>" {
+		t.Errorf("HTMLBody = %q, want escaped title", email.HTMLBody)
+	}
+	if !strings.Contains(email.TextBody, "Escape <script> in titles (Score: 0.85)") {
+		t.Errorf("TextBody = %q, want the default text part", email.TextBody)
+	}
+}
+
+func TestNotificationTemplates_DefaultsForEveryAlert(t *testing.T) {
+	issue := templateTestIssue()
+	gfi := issue
+	gfi.IsGoodFirst = true
+	qualified := QualifiedIssue{Issue: issue, Type: "bug"}
+	qualified.QualifiedScore.TotalScore = 0.9
+	data := NotificationData{
+		Issue:     issue,
+		Issues:    []Issue{gfi, issue},
+		Qualified: &qualified,
+		Breakdown: &ScoreBreakdown{TotalScore: issue.Score},
+	}
+
+	templates := &NotificationTemplates{}
+	alerts := []AlertType{AlertNewIssue, AlertDigest, AlertQualified, AlertAssignmentConfirmed, AlertAssignmentRequest}
+	for _, alert := range alerts {
+		for _, channel := range []NotificationChannel{ChannelTelegram, ChannelLocal} {
+			out, ok := templates.Render(channel, alert, TemplatePartBody, data)
+			if !ok || !strings.Contains(out, issue.Title) {
+				t.Errorf("%s/%s: ok=%v, output missing issue title:\n%s", channel, alert, ok, out)
+			}
+		}
+
+		email := templates.RenderEmail(alert, data)
+		if email.Subject == "" || !strings.Contains(email.TextBody, issue.URL) || !strings.Contains(email.HTMLBody, issue.URL) {
+			t.Errorf("email/%s: incomplete email %+v", alert, email)
+		}
+	}
+}
+
+func TestEmailTemplates_EscapeIssueText(t *testing.T) {
+	issue := templateTestIssue()
+	issue.Title = "Escape <script> in titles"
+	issue.Labels = []string{"<b>bug</b>"}
+
+	email := NewIssueEmailTemplate(issue, nil)
+	for _, raw := range []string{"<script>", "<b>bug</b>"} {
+		if strings.Contains(email.HTMLBody, raw) {
+			t.Errorf("HTMLBody contains unescaped %q", raw)
+		}
+	}
+	if !strings.Contains(email.HTMLBody, "Escape &lt;script&gt; in titles") {
+		t.Error("HTMLBody missing escaped title")
+	}
+
+	digest := DigestEmailTemplate([]Issue{issue})
+	if !strings.Contains(digest.HTMLBody, "<!DOCTYPE html>") || strings.Contains(digest.HTMLBody, "<script>") {
+		t.Errorf("digest HTMLBody not a complete, escaped page:\n%s", digest.HTMLBody)
+	}
+}
+
+func TestNotificationTemplates_NoSlot(t *testing.T) {
+	if _, ok := (&NotificationTemplates{}).Render(ChannelEmail, AlertType("unknown"), TemplatePartHTML, NotificationData{}); ok {
+		t.Error("expected no template for an unknown alert")
+	}
+}
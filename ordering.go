@@ -0,0 +1,132 @@
+package main
+
+import (
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultDeterministicSeed is used when deterministic mode is on but no seed
+// was given, so two plain `--deterministic` runs agree with each other.
+const defaultDeterministicSeed int64 = 1
+
+type RunOptions struct {
+	Seed          int64
+	Deterministic bool
+}
+
+var runOptions = RunOptions{Seed: time.Now().UnixNano()}
+
+// ConfigureRun consumes --seed and --deterministic from args and returns the
+// remaining arguments. ISSUE_FINDER_SEED and ISSUE_FINDER_DETERMINISTIC are
+// honored as well.
+func ConfigureRun(args []string) []string {
+	opts := RunOptions{Seed: time.Now().UnixNano()}
+	seedSet := false
+
+	if v := os.Getenv("ISSUE_FINDER_DETERMINISTIC"); v != "" {
+		opts.Deterministic = v == "true" || v == "1"
+	}
+	if v := os.Getenv("ISSUE_FINDER_SEED"); v != "" {
+		if seed, err := strconv.ParseInt(v, 10, 64); err == nil {
+			opts.Seed = seed
+			seedSet = true
+		}
+	}
+
+	remaining := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		value := ""
+		switch {
+		case arg == "--deterministic":
+			opts.Deterministic = true
+			continue
+		case arg == "--seed" && i+1 < len(args):
+			value = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--seed="):
+			value = strings.TrimPrefix(arg, "--seed=")
+		default:
+			remaining = append(remaining, arg)
+			continue
+		}
+
+		if seed, err := strconv.ParseInt(value, 10, 64); err == nil {
+			opts.Seed = seed
+			seedSet = true
+		} else {
+			log.Printf("[Run] Ignoring invalid seed %q", value)
+		}
+	}
+
+	if opts.Deterministic && !seedSet {
+		opts.Seed = defaultDeterministicSeed
+	}
+
+	runOptions = opts
+	return remaining
+}
+
+// runRand returns a source seeded from the run seed. Anything that samples or
+// shuffles must use it so that a logged seed reproduces the run.
+func runRand() *rand.Rand {
+	return rand.New(rand.NewSource(runOptions.Seed))
+}
+
+func logRunSeed(prefix string) {
+	if runOptions.Deterministic {
+		log.Printf("[%s] Run %s, deterministic mode, seed %d", prefix, runID, runOptions.Seed)
+	} else {
+		log.Printf("[%s] Run %s, seed %d (pass --seed %d to reproduce)", prefix, runID, runOptions.Seed, runOptions.Seed)
+	}
+}
+
+// issueRanksBefore breaks score ties so that results collected concurrently
+// always come out in the same order: newer issues first, then by repository
+// and issue number.
+func issueRanksBefore(a, b Issue) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	repoA := a.Project.Org + "/" + a.Project.Name
+	repoB := b.Project.Org + "/" + b.Project.Name
+	if repoA != repoB {
+		return repoA < repoB
+	}
+	if a.Number != b.Number {
+		return a.Number < b.Number
+	}
+	return a.URL < b.URL
+}
+
+func SortIssues(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Score != issues[j].Score {
+			return issues[i].Score > issues[j].Score
+		}
+		return issueRanksBefore(issues[i], issues[j])
+	})
+}
+
+func SortQualifiedIssues(issues []QualifiedIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].QualifiedScore.TotalScore != issues[j].QualifiedScore.TotalScore {
+			return issues[i].QualifiedScore.TotalScore > issues[j].QualifiedScore.TotalScore
+		}
+		return issueRanksBefore(issues[i].Issue, issues[j].Issue)
+	})
+}
+
+func SortScoredIssues(issues []ScoredIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Score.Total != issues[j].Score.Total {
+			return issues[i].Score.Total > issues[j].Score.Total
+		}
+		return issueRanksBefore(issues[i].IssueData, issues[j].IssueData)
+	})
+}
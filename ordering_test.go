@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortIssues_TieBreaking(t *testing.T) {
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+
+	issues := []Issue{
+		{URL: "b/2", Number: 2, Score: 0.8, CreatedAt: older, Project: Project{Org: "b", Name: "repo"}},
+		{URL: "a/9", Number: 9, Score: 0.8, CreatedAt: older, Project: Project{Org: "a", Name: "repo"}},
+		{URL: "low", Number: 1, Score: 0.5, CreatedAt: newer, Project: Project{Org: "a", Name: "repo"}},
+		{URL: "a/3", Number: 3, Score: 0.8, CreatedAt: older, Project: Project{Org: "a", Name: "repo"}},
+		{URL: "new", Number: 7, Score: 0.8, CreatedAt: newer, Project: Project{Org: "z", Name: "repo"}},
+		{URL: "top", Number: 5, Score: 0.9, CreatedAt: older, Project: Project{Org: "z", Name: "repo"}},
+	}
+
+	expected := []string{"top", "new", "a/3", "a/9", "b/2", "low"}
+
+	// Every input permutation must produce the same order.
+	for shift := 0; shift < len(issues); shift++ {
+		rotated := append(append([]Issue{}, issues[shift:]...), issues[:shift]...)
+		SortIssues(rotated)
+
+		for i, issue := range rotated {
+			if issue.URL != expected[i] {
+				t.Fatalf("rotation %d: position %d = %q, want %q", shift, i, issue.URL, expected[i])
+			}
+		}
+	}
+}
+
+func TestSortScoredIssues_TieBreaking(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := []ScoredIssue{
+		{Score: IssueScore{Total: 0.7}, IssueData: Issue{Number: 20, CreatedAt: created, Project: Project{Org: "o", Name: "r"}}},
+		{Score: IssueScore{Total: 0.7}, IssueData: Issue{Number: 10, CreatedAt: created, Project: Project{Org: "o", Name: "r"}}},
+	}
+
+	SortScoredIssues(issues)
+	if issues[0].IssueData.Number != 10 {
+		t.Errorf("first issue = #%d, want #10", issues[0].IssueData.Number)
+	}
+}
+
+func TestConfigureRun(t *testing.T) {
+	defer func(opts RunOptions) { runOptions = opts }(runOptions)
+
+	tests := []struct {
+		name          string
+		args          []string
+		env           map[string]string
+		deterministic bool
+		seed          int64
+		remaining     int
+	}{
+		{"seed flag", []string{"--seed", "42", "find"}, nil, false, 42, 1},
+		{"seed with equals", []string{"find", "--seed=7"}, nil, false, 7, 1},
+		{"deterministic default seed", []string{"--deterministic", "find"}, nil, true, defaultDeterministicSeed, 1},
+		{"deterministic keeps seed", []string{"--deterministic", "--seed", "9"}, nil, true, 9, 0},
+		{"env", []string{"find"}, map[string]string{"ISSUE_FINDER_DETERMINISTIC": "true", "ISSUE_FINDER_SEED": "3"}, true, 3, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ISSUE_FINDER_DETERMINISTIC", "")
+			t.Setenv("ISSUE_FINDER_SEED", "")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			remaining := ConfigureRun(tt.args)
+			if runOptions.Deterministic != tt.deterministic {
+				t.Errorf("Deterministic = %v, want %v", runOptions.Deterministic, tt.deterministic)
+			}
+			if runOptions.Seed != tt.seed {
+				t.Errorf("Seed = %d, want %d", runOptions.Seed, tt.seed)
+			}
+			if len(remaining) != tt.remaining {
+				t.Errorf("remaining args = %v, want %d args", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestRunRand_Reproducible(t *testing.T) {
+	defer func(opts RunOptions) { runOptions = opts }(runOptions)
+
+	runOptions = RunOptions{Seed: 99}
+	first := runRand().Int63()
+	if second := runRand().Int63(); first != second {
+		t.Errorf("runRand() with the same seed gave %d and %d", first, second)
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+type OutputMode string
+
+const (
+	OutputNormal  OutputMode = "normal"
+	OutputNoEmoji OutputMode = "no-emoji"
+	OutputPlain   OutputMode = "plain"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorBold   = "\033[1m"
+	colorDim    = "\033[2m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorBlue   = "\033[34m"
+	colorCyan   = "\033[36m"
+)
+
+// stdout is what every CLI print goes through so that --plain/--no-emoji
+// apply uniformly without each display function knowing about them.
+var (
+	stdout       io.Writer = os.Stdout
+	outputMode             = OutputNormal
+	colorEnabled           = false
+)
+
+// Emoji that carry meaning get a text label instead of being dropped.
+var emojiLabelReplacer = strings.NewReplacer(
+	"✅", "[ok]",
+	"❌", "[x]",
+	"⚠️", "[!]",
+	"⚠", "[!]",
+	"★", " stars",
+)
+
+var plainTextReplacer = strings.NewReplacer(
+	"═", "=",
+	"━", "=",
+	"─", "-",
+	"│", "|",
+	"•", "-",
+	"·", "-",
+	"→", "->",
+	"←", "<-",
+	"…", "...",
+	"—", "-",
+	"–", "-",
+)
+
+type outputFilter struct {
+	w    io.Writer
+	mode OutputMode
+}
+
+func (f *outputFilter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(f.w, filterOutput(string(p), f.mode)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func filterOutput(text string, mode OutputMode) string {
+	switch mode {
+	case OutputNoEmoji:
+		return stripEmoji(emojiLabelReplacer.Replace(text))
+	case OutputPlain:
+		return plainTextReplacer.Replace(stripEmoji(emojiLabelReplacer.Replace(text)))
+	default:
+		return text
+	}
+}
+
+// stripEmoji removes emoji and the separator space that followed them, so
+// "🔥 [1] Title" becomes "[1] Title" rather than " [1] Title".
+func stripEmoji(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	dropSpace := false
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+
+		if isEmojiRune(r) {
+			last := sb.String()
+			dropSpace = last == "" || strings.HasSuffix(last, " ") || strings.HasSuffix(last, "\n") || dropSpace
+			continue
+		}
+		if r == ' ' && dropSpace {
+			dropSpace = false
+			continue
+		}
+		dropSpace = false
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF:
+		return true
+	case r >= 0x2600 && r <= 0x27BF:
+		return true
+	case r >= 0x2300 && r <= 0x23FF:
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	case r == 0xFE0F || r == 0x200D || r == 0x20E3:
+		return true
+	}
+	return false
+}
+
+// ConfigureOutput consumes the global output flags from args and returns the
+// remaining arguments. OUTPUT_MODE and NO_COLOR are honored as well.
+func ConfigureOutput(args []string) []string {
+	mode := OutputNormal
+	switch OutputMode(strings.ToLower(os.Getenv("OUTPUT_MODE"))) {
+	case OutputPlain:
+		mode = OutputPlain
+	case OutputNoEmoji:
+		mode = OutputNoEmoji
+	}
+	noColor := os.Getenv("NO_COLOR") != ""
+
+	remaining := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg {
+		case "--plain":
+			mode = OutputPlain
+		case "--no-emoji":
+			if mode != OutputPlain {
+				mode = OutputNoEmoji
+			}
+		case "--no-color":
+			noColor = true
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+
+	outputMode = mode
+	colorEnabled = !noColor && mode != OutputPlain && isTerminal(os.Stdout) && os.Getenv("TERM") != "dumb"
+	if mode == OutputNormal {
+		stdout = os.Stdout
+	} else {
+		stdout = &outputFilter{w: os.Stdout, mode: mode}
+	}
+
+	return remaining
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func colorize(color, text string) string {
+	if !colorEnabled || color == "" {
+		return text
+	}
+	return color + text + colorReset
+}
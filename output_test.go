@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestFilterOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     OutputMode
+		input    string
+		expected string
+	}{
+		{"normal keeps emoji", OutputNormal, "🔥 [1] Fix bug", "🔥 [1] Fix bug"},
+		{"no-emoji strips leading emoji", OutputNoEmoji, "🔥 [1] Fix bug\n", "[1] Fix bug\n"},
+		{"no-emoji labels status emoji", OutputNoEmoji, "✅ Tracking issue", "[ok] Tracking issue"},
+		{"no-emoji keeps indentation", OutputNoEmoji, "   🔧 Working", "   Working"},
+		{"no-emoji stars", OutputNoEmoji, "kubernetes/kubernetes (105000★)", "kubernetes/kubernetes (105000 stars)"},
+		{"no-emoji variation selector", OutputNoEmoji, "⏸️ WAITING", "WAITING"},
+		{"no-emoji keeps box drawing", OutputNoEmoji, "═══", "═══"},
+		{"plain converts box drawing", OutputPlain, "═══ a • b → c", "=== a - b -> c"},
+		{"plain warning", OutputPlain, "⚠️  GitHub API issue", "[!]  GitHub API issue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterOutput(tt.input, tt.mode)
+			if result != tt.expected {
+				t.Errorf("filterOutput(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOutputFilter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	w := &outputFilter{w: &buf, mode: OutputPlain}
+
+	input := "📊 Stats → done\n"
+	n, err := fmt.Fprint(w, input)
+	if err != nil {
+		t.Fatalf("Fprint() returned error: %v", err)
+	}
+	if n != len(input) {
+		t.Errorf("Fprint() wrote %d bytes, want %d", n, len(input))
+	}
+	if buf.String() != "Stats -> done\n" {
+		t.Errorf("output = %q, want %q", buf.String(), "Stats -> done\n")
+	}
+}
+
+func TestConfigureOutput(t *testing.T) {
+	defer ConfigureOutput(nil)
+
+	tests := []struct {
+		name      string
+		args      []string
+		env       map[string]string
+		mode      OutputMode
+		remaining int
+	}{
+		{"default", []string{"find"}, nil, OutputNormal, 1},
+		{"plain flag", []string{"--plain", "list", "--all"}, nil, OutputPlain, 2},
+		{"no-emoji flag", []string{"stats", "--no-emoji"}, nil, OutputNoEmoji, 1},
+		{"plain wins over no-emoji", []string{"--plain", "--no-emoji"}, nil, OutputPlain, 0},
+		{"env mode", []string{"find"}, map[string]string{"OUTPUT_MODE": "no-emoji"}, OutputNoEmoji, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OUTPUT_MODE", "")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			remaining := ConfigureOutput(tt.args)
+			if outputMode != tt.mode {
+				t.Errorf("outputMode = %v, want %v", outputMode, tt.mode)
+			}
+			if len(remaining) != tt.remaining {
+				t.Errorf("remaining args = %v, want %d args", remaining, tt.remaining)
+			}
+			if colorEnabled && tt.mode == OutputPlain {
+				t.Error("colors should be disabled in plain mode")
+			}
+		})
+	}
+}
+
+func TestColorize(t *testing.T) {
+	defer func(enabled bool) { colorEnabled = enabled }(colorEnabled)
+
+	colorEnabled = false
+	if got := colorize(colorRed, "text"); got != "text" {
+		t.Errorf("colorize() with colors disabled = %q, want %q", got, "text")
+	}
+
+	colorEnabled = true
+	if got := colorize(colorRed, "text"); got != colorRed+"text"+colorReset {
+		t.Errorf("colorize() with colors enabled = %q", got)
+	}
+}
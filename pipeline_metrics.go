@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Issues fetched from GitHub and issues that passed the seen, epic, title
+// and body filters, counted across the process. A run records the change.
+var (
+	pipelineFetched atomic.Int64
+	pipelinePassed  atomic.Int64
+)
+
+// PipelineFunnel counts issues at each stage from fetch to merge. The first
+// four stages are counted during the run; the rest are looked up later for
+// the issues the run discovered.
+type PipelineFunnel struct {
+	Fetched        int `db:"fetched"`
+	PassedFilters  int `db:"passed_filters"`
+	AboveThreshold int `db:"above_threshold"`
+	Notified       int `db:"notified"`
+	Tracked        int `db:"tracked"`
+	Commented      int `db:"commented"`
+	Assigned       int `db:"assigned"`
+	Merged         int `db:"merged"`
+}
+
+// pipelineStageKeys name the stages in funnel order.
+var pipelineStageKeys = []string{
+	"stage.fetched", "stage.passed", "stage.above_threshold", "stage.notified",
+	"stage.tracked", "stage.commented", "stage.assigned", "stage.merged",
+}
+
+func (f PipelineFunnel) Stages() []int {
+	return []int{f.Fetched, f.PassedFilters, f.AboveThreshold, f.Notified, f.Tracked, f.Commented, f.Assigned, f.Merged}
+}
+
+func (f *PipelineFunnel) add(other PipelineFunnel) {
+	f.Fetched += other.Fetched
+	f.PassedFilters += other.PassedFilters
+	f.AboveThreshold += other.AboveThreshold
+	f.Notified += other.Notified
+	f.Tracked += other.Tracked
+	f.Commented += other.Commented
+	f.Assigned += other.Assigned
+	f.Merged += other.Merged
+}
+
+// BiggestDrop finds the step that keeps the smallest share of the previous
+// stage. Steps from an empty stage are skipped; ok is false when no step has
+// data.
+func (f PipelineFunnel) BiggestDrop() (from, to int, kept float64, ok bool) {
+	stages := f.Stages()
+	kept = 2
+	for i := 1; i < len(stages); i++ {
+		if stages[i-1] == 0 {
+			continue
+		}
+		ratio := float64(stages[i]) / float64(stages[i-1])
+		if ratio < kept {
+			from, to, kept = i-1, i, ratio
+		}
+	}
+	return from, to, kept, kept <= 1
+}
+
+type PipelineRun struct {
+	StartedAt  time.Time `db:"started_at"`
+	FinishedAt time.Time `db:"finished_at"`
+	Threshold  float64   `db:"threshold"`
+	PipelineFunnel
+}
+
+type PipelineWeek struct {
+	Start time.Time
+	Runs  int
+	PipelineFunnel
+}
+
+func pipelineScoreThreshold() float64 {
+	return getEnvFloat("PIPELINE_SCORE_THRESHOLD", 0.6)
+}
+
+func initPipelineTables(db *sqlx.DB) error {
+	_, err := db.Exec(`
+	CREATE TABLE IF NOT EXISTS pipeline_runs (
+		id SERIAL PRIMARY KEY,
+		started_at TIMESTAMP NOT NULL,
+		finished_at TIMESTAMP NOT NULL,
+		threshold FLOAT NOT NULL,
+		fetched INTEGER NOT NULL DEFAULT 0,
+		passed_filters INTEGER NOT NULL DEFAULT 0,
+		above_threshold INTEGER NOT NULL DEFAULT 0,
+		notified INTEGER NOT NULL DEFAULT 0
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_pipeline_runs_started_at ON pipeline_runs(started_at);
+	`)
+	return err
+}
+
+// pipelineRecorder measures one daemon check, find command or AutoFinder
+// run from its start.
+type pipelineRecorder struct {
+	started time.Time
+	fetched int64
+	passed  int64
+}
+
+func startPipelineRun() *pipelineRecorder {
+	return &pipelineRecorder{
+		started: time.Now(),
+		fetched: pipelineFetched.Load(),
+		passed:  pipelinePassed.Load(),
+	}
+}
+
+// Finish saves the run. issues are the accepted issues and notified the most
+// of them any one channel delivered.
+func (r *pipelineRecorder) Finish(db *sqlx.DB, issues []Issue, notified int) {
+	if db == nil {
+		return
+	}
+
+	threshold := pipelineScoreThreshold()
+	above := 0
+	for _, issue := range issues {
+		if issue.Score >= threshold {
+			above++
+		}
+	}
+
+	_, err := db.Exec(`
+		INSERT INTO pipeline_runs (started_at, finished_at, threshold, fetched, passed_filters, above_threshold, notified)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, r.started, time.Now(), threshold,
+		pipelineFetched.Load()-r.fetched, pipelinePassed.Load()-r.passed, above, notified)
+	if err != nil {
+		log.Printf("[Pipeline] Failed to record run: %v", err)
+	}
+}
+
+// loadPipelineRuns returns runs started since the given time, oldest first.
+// Later stages count the issues each run added to issue_history: tracked at
+// all, commented on, assigned (assigned or any later status) and merged
+// (completed). They join issue_history by URL, so once retention trims a
+// run's rows its later stages read 0; only the retention window is exact.
+func loadPipelineRuns(db *sqlx.DB, since time.Time) ([]PipelineRun, error) {
+	if ok, err := tableExists(db, "pipeline_runs"); err != nil || !ok {
+		return nil, err
+	}
+
+	// Comments retention has folded away still count through commented_issues.
+	var commentSources []string
+	for _, source := range []string{"comment_history", "commented_issues"} {
+		ok, err := tableExists(db, source)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			commentSources = append(commentSources, "SELECT issue_url FROM "+source)
+		}
+	}
+	commented := "0"
+	if len(commentSources) > 0 {
+		commented = `(SELECT COUNT(DISTINCT c.issue_url) FROM (` + strings.Join(commentSources, " UNION ") + `) c
+			JOIN issue_history ih ON ih.issue_url = c.issue_url
+			WHERE ih.discovered_at BETWEEN r.started_at AND r.finished_at)`
+	}
+
+	tracked := func(condition string) string {
+		return `(SELECT COUNT(DISTINCT t.issue_url) FROM tracked_issues t
+			JOIN issue_history ih ON ih.issue_url = t.issue_url
+			WHERE ih.discovered_at BETWEEN r.started_at AND r.finished_at` + condition + `)`
+	}
+
+	query := fmt.Sprintf(`
+		SELECT r.started_at, r.finished_at, r.threshold, r.fetched, r.passed_filters, r.above_threshold, r.notified,
+			%s AS tracked,
+			%s AS commented,
+			%s AS assigned,
+			%s AS merged
+		FROM pipeline_runs r
+		WHERE r.started_at >= $1
+		ORDER BY r.started_at`,
+		tracked(""),
+		commented,
+		tracked(` AND t.status IN ('assigned', 'in_progress', 'pr_submitted', 'completed')`),
+		tracked(` AND t.status = 'completed'`),
+	)
+
+	var runs []PipelineRun
+	err := db.Select(&runs, query, since)
+	return runs, err
+}
+
+// weekStart is the Monday that starts t's week.
+func weekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// weeklyFunnels sums runs by week, oldest first.
+func weeklyFunnels(runs []PipelineRun) []PipelineWeek {
+	var weeks []PipelineWeek
+	for _, run := range runs {
+		start := weekStart(run.StartedAt)
+		if len(weeks) == 0 || !weeks[len(weeks)-1].Start.Equal(start) {
+			weeks = append(weeks, PipelineWeek{Start: start})
+		}
+		week := &weeks[len(weeks)-1]
+		week.Runs++
+		week.add(run.PipelineFunnel)
+	}
+	return weeks
+}
+
+func pipelineTable(firstHeader string) *Table {
+	columns := []TableColumn{{Header: firstHeader, Flex: true}}
+	for _, key := range pipelineStageKeys {
+		columns = append(columns, TableColumn{Header: T(key), Align: AlignRight})
+	}
+	return NewTable(columns...)
+}
+
+func funnelCells(first string, funnel PipelineFunnel) []TableCell {
+	cells := []TableCell{{Text: first}}
+	for _, n := range funnel.Stages() {
+		cells = append(cells, TableCell{Text: strconv.Itoa(n)})
+	}
+	return cells
+}
+
+// printPipelineFunnel shows the last runs and the per-week totals, then
+// names the step that loses the most issues over the whole period.
+func printPipelineFunnel(w io.Writer, runs []PipelineRun, maxRuns int) {
+	fmt.Fprintln(w, "\n"+T("stats.funnel"))
+	if len(runs) == 0 {
+		fmt.Fprintln(w, "  "+T("stats.funnel_none"))
+		return
+	}
+
+	recent := runs
+	if len(recent) > maxRuns {
+		recent = recent[len(recent)-maxRuns:]
+	}
+	table := pipelineTable(T("col.run"))
+	for i := len(recent) - 1; i >= 0; i-- {
+		table.AddCells(funnelCells(recent[i].StartedAt.Format("2006-01-02 15:04"), recent[i].PipelineFunnel)...)
+	}
+	fmt.Fprintln(w, T("stats.funnel_runs", len(recent), recent[len(recent)-1].Threshold))
+	table.Render(w)
+
+	var total PipelineFunnel
+	weeks := weeklyFunnels(runs)
+	table = pipelineTable(T("col.week"))
+	for i := len(weeks) - 1; i >= 0; i-- {
+		table.AddCells(funnelCells(fmt.Sprintf("%s (%d)", weeks[i].Start.Format("2006-01-02"), weeks[i].Runs), weeks[i].PipelineFunnel)...)
+		total.add(weeks[i].PipelineFunnel)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, T("stats.funnel_weeks", len(weeks)))
+	table.Render(w)
+
+	if from, to, kept, ok := total.BiggestDrop(); ok {
+		fmt.Fprintln(w, T("stats.funnel_drop", strings.ToLower(T(pipelineStageKeys[from])), strings.ToLower(T(pipelineStageKeys[to])), kept*100))
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWeekStart(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		want string
+	}{
+		{time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC), "2026-10-12"}, // Monday
+		{time.Date(2026, 10, 16, 23, 59, 0, 0, time.UTC), "2026-10-12"},
+		{time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), "2026-10-12"}, // Sunday
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "2026-09-28"},
+	}
+	for _, tt := range tests {
+		if got := weekStart(tt.day).Format("2006-01-02"); got != tt.want {
+			t.Errorf("weekStart(%s) = %s, want %s", tt.day.Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestWeeklyFunnels(t *testing.T) {
+	run := func(day int, fetched, passed int) PipelineRun {
+		return PipelineRun{
+			StartedAt:      time.Date(2026, 10, day, 10, 0, 0, 0, time.UTC),
+			PipelineFunnel: PipelineFunnel{Fetched: fetched, PassedFilters: passed},
+		}
+	}
+	weeks := weeklyFunnels([]PipelineRun{run(6, 100, 10), run(8, 50, 5), run(13, 80, 4)})
+
+	if len(weeks) != 2 {
+		t.Fatalf("got %d weeks, want 2", len(weeks))
+	}
+	if weeks[0].Runs != 2 || weeks[0].Fetched != 150 || weeks[0].PassedFilters != 15 {
+		t.Errorf("first week = %+v", weeks[0])
+	}
+	if weeks[1].Runs != 1 || weeks[1].Fetched != 80 || !weeks[1].Start.Equal(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("second week = %+v", weeks[1])
+	}
+}
+
+func TestBiggestDrop(t *testing.T) {
+	funnel := PipelineFunnel{Fetched: 200, PassedFilters: 40, AboveThreshold: 20, Notified: 20, Tracked: 2, Commented: 1, Assigned: 1, Merged: 1}
+	from, to, kept, ok := funnel.BiggestDrop()
+	if !ok || pipelineStageKeys[from] != "stage.notified" || pipelineStageKeys[to] != "stage.tracked" || kept != 0.1 {
+		t.Errorf("BiggestDrop() = %s → %s (%v, %v)", pipelineStageKeys[from], pipelineStageKeys[to], kept, ok)
+	}
+
+	if _, _, _, ok := (PipelineFunnel{}).BiggestDrop(); ok {
+		t.Error("empty funnel should have no drop")
+	}
+}
+
+func TestPrintPipelineFunnel(t *testing.T) {
+	var buf bytes.Buffer
+	printPipelineFunnel(&buf, nil, 5)
+	if !strings.Contains(buf.String(), T("stats.funnel_none")) {
+		t.Errorf("empty output = %q", buf.String())
+	}
+
+	runs := []PipelineRun{
+		{StartedAt: time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC), Threshold: 0.6, PipelineFunnel: PipelineFunnel{Fetched: 300, PassedFilters: 30, AboveThreshold: 12, Notified: 12}},
+		{StartedAt: time.Date(2026, 10, 13, 8, 0, 0, 0, time.UTC), Threshold: 0.6, PipelineFunnel: PipelineFunnel{Fetched: 200, PassedFilters: 20, AboveThreshold: 8, Notified: 8, Tracked: 1, Commented: 1, Assigned: 1, Merged: 1}},
+	}
+	buf.Reset()
+	printPipelineFunnel(&buf, runs, 1)
+	out := buf.String()
+
+	if strings.Contains(out, "2026-10-12 08:00") {
+		t.Error("only the last run should be listed")
+	}
+	for _, want := range []string{"2026-10-13 08:00", "2026-10-12 (2)", "500", T("stats.funnel_drop", "notified", "tracked", 5.0)} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPipelineRecorderFinish(t *testing.T) {
+	t.Setenv("PIPELINE_SCORE_THRESHOLD", "0.6")
+	fake, db := newFakeSQL(t)
+
+	recorder := startPipelineRun()
+	pipelineFetched.Add(3)
+	pipelinePassed.Add(2)
+
+	fake.expect("INSERT INTO pipeline_runs", fakeSQLAnyArg{}, fakeSQLAnyArg{}, 0.6, 3, 2, 1, 1).affects(1)
+	recorder.Finish(db, []Issue{{Score: 0.7}, {Score: 0.4}}, 1)
+}
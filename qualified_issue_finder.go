@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -51,10 +50,11 @@ type QualifiedIssueScore struct {
 }
 
 type QualifiedIssueFinder struct {
-	client      *github.Client
+	client      GitHubAPI
 	rateLimiter *RateLimiter
 	scorer      *QualifiedScorer
 	filter      *QualifiedIssueFilter
+	titleFilter *TitleFilterConfig
 	projects    []Project
 	seenIssues  map[string]bool
 	mu          sync.RWMutex
@@ -99,12 +99,13 @@ func NewQualifiedScorer(config *QualifiedScoringConfig) *QualifiedScorer {
 	return &QualifiedScorer{config: config}
 }
 
-func NewQualifiedIssueFinder(client *github.Client, rateLimiter *RateLimiter, projects []Project) *QualifiedIssueFinder {
+func NewQualifiedIssueFinder(client GitHubAPI, rateLimiter *RateLimiter, projects []Project) *QualifiedIssueFinder {
 	return &QualifiedIssueFinder{
 		client:      client,
 		rateLimiter: rateLimiter,
 		scorer:      NewQualifiedScorer(nil),
 		filter:      DefaultQualifiedIssueFilter(),
+		titleFilter: loadTitleFilterConfigFromEnv(),
 		projects:    projects,
 		seenIssues:  make(map[string]bool),
 	}
@@ -179,9 +180,7 @@ func (f *QualifiedIssueFinder) FindQualifiedIssues(ctx context.Context, minScore
 	close(issuesChan)
 	<-collectorDone
 
-	sort.Slice(allIssues, func(i, j int) bool {
-		return allIssues[i].QualifiedScore.TotalScore > allIssues[j].QualifiedScore.TotalScore
-	})
+	SortQualifiedIssues(allIssues)
 
 	return allIssues, nil
 }
@@ -203,7 +202,7 @@ func (f *QualifiedIssueFinder) findQualifiedIssuesForProject(ctx context.Context
 
 	err = f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("qualified issues %s/%s", project.Org, project.Name), func() (*github.Response, error) {
 		var apiErr error
-		issues, _, apiErr = f.client.Issues.ListByRepo(ctx, project.Org, project.Name, opts)
+		issues, _, apiErr = f.client.ListRepoIssues(ctx, project.Org, project.Name, opts)
 		return nil, apiErr
 	})
 
@@ -237,19 +236,24 @@ func (f *QualifiedIssueFinder) evaluateIssue(issue *github.Issue, project Projec
 		return nil
 	}
 
+	if ok, _ := f.titleFilter.Check(issue.GetTitle()); !ok {
+		return nil
+	}
+
 	if len(issue.Assignees) > 0 && f.filter.RequireNoAssignee {
 		return nil
 	}
 
 	qualified := &QualifiedIssue{
 		Issue: Issue{
-			Project:   project,
-			Title:     issue.GetTitle(),
-			URL:       issue.GetHTMLURL(),
-			Number:    issue.GetNumber(),
-			CreatedAt: issue.GetCreatedAt().Time,
-			Comments:  issue.GetComments(),
-			Labels:    labels,
+			Project:     project,
+			Title:       issue.GetTitle(),
+			URL:         issue.GetHTMLURL(),
+			Number:      issue.GetNumber(),
+			CreatedAt:   issue.GetCreatedAt().Time,
+			Comments:    issue.GetComments(),
+			Labels:      labels,
+			ReadingTime: EstimateReadingTime(issue),
 		},
 		WhyGood: []string{},
 	}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+)
+
+const (
+	readingWordsPerMinute = 200
+	// Used when only the comment count is known, to avoid an extra API call
+	// per issue just to size the thread.
+	estimatedWordsPerComment = 80
+)
+
+type ReadingEstimate struct {
+	Words    int
+	Comments int
+	Minutes  int
+}
+
+func EstimateReadingTime(issue *github.Issue) ReadingEstimate {
+	comments := issue.GetComments()
+	words := countWords(issue.GetTitle()) + countWords(issue.GetBody()) + comments*estimatedWordsPerComment
+	return newReadingEstimate(words, comments)
+}
+
+func EstimateReadingTimeWithComments(issue *github.Issue, comments []*github.IssueComment) ReadingEstimate {
+	words := countWords(issue.GetTitle()) + countWords(issue.GetBody())
+	for _, comment := range comments {
+		words += countWords(comment.GetBody())
+	}
+	return newReadingEstimate(words, len(comments))
+}
+
+func newReadingEstimate(words, comments int) ReadingEstimate {
+	minutes := (words + readingWordsPerMinute - 1) / readingWordsPerMinute
+	if minutes < 1 {
+		minutes = 1
+	}
+	return ReadingEstimate{Words: words, Comments: comments, Minutes: minutes}
+}
+
+func countWords(text string) int {
+	return len(strings.Fields(text))
+}
+
+func (r ReadingEstimate) String() string {
+	return T("reading.minutes", r.Minutes)
+}
+
+// readingTimePenalty ramps linearly from zero at the threshold to the full
+// penalty at three times the threshold.
+func readingTimePenalty(estimate ReadingEstimate, config *ScoringConfig) float64 {
+	if config == nil || config.LongThreadPenalty <= 0 || config.LongThreadMinutes <= 0 {
+		return 0
+	}
+	if estimate.Minutes <= config.LongThreadMinutes {
+		return 0
+	}
+
+	ratio := float64(estimate.Minutes-config.LongThreadMinutes) / float64(2*config.LongThreadMinutes)
+	if ratio > 1 {
+		ratio = 1
+	}
+	return config.LongThreadPenalty * ratio
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestEstimateReadingTime(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		comments    int
+		minMinutes  int
+		maxMinutes  int
+		expectWords int
+	}{
+		{"empty issue", "", 0, 1, 1, 1},
+		{"short body no comments", "fix the typo in the readme", 0, 1, 1, 7},
+		{"long thread", strings.Repeat("word ", 400), 40, 17, 19, 1 + 400 + 40*estimatedWordsPerComment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := &github.Issue{
+				Title:    github.String("title"),
+				Body:     github.String(tt.body),
+				Comments: github.Int(tt.comments),
+			}
+
+			estimate := EstimateReadingTime(issue)
+			if estimate.Words != tt.expectWords {
+				t.Errorf("Words = %d, want %d", estimate.Words, tt.expectWords)
+			}
+			if estimate.Minutes < tt.minMinutes || estimate.Minutes > tt.maxMinutes {
+				t.Errorf("Minutes = %d, want between %d and %d", estimate.Minutes, tt.minMinutes, tt.maxMinutes)
+			}
+		})
+	}
+}
+
+func TestEstimateReadingTimeWithComments(t *testing.T) {
+	issue := &github.Issue{Title: github.String("a b"), Body: github.String("c d e")}
+	comments := []*github.IssueComment{
+		{Body: github.String("one two three")},
+		{Body: github.String("four")},
+	}
+
+	estimate := EstimateReadingTimeWithComments(issue, comments)
+	if estimate.Words != 9 || estimate.Comments != 2 || estimate.Minutes != 1 {
+		t.Errorf("EstimateReadingTimeWithComments() = %+v, want 9 words, 2 comments, 1 minute", estimate)
+	}
+}
+
+func TestReadingTimePenalty(t *testing.T) {
+	config := &ScoringConfig{LongThreadMinutes: 10, LongThreadPenalty: 0.10}
+
+	tests := []struct {
+		name     string
+		minutes  int
+		config   *ScoringConfig
+		expected float64
+	}{
+		{"under threshold", 5, config, 0},
+		{"at threshold", 10, config, 0},
+		{"halfway ramp", 20, config, 0.05},
+		{"capped", 60, config, 0.10},
+		{"disabled", 60, &ScoringConfig{LongThreadMinutes: 10}, 0},
+		{"nil config", 60, nil, 0},
+		{"off by default", 60, loadScoringConfigFromEnv(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := readingTimePenalty(ReadingEstimate{Minutes: tt.minutes}, tt.config)
+			if result != tt.expected {
+				t.Errorf("readingTimePenalty() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// RegressionConfig controls the regression-after-release mode. Regressions
+// reported right after a release tend to be well-scoped and urgent, and
+// maintainers welcome the help.
+type RegressionConfig struct {
+	WindowDays   int
+	LookbackDays int
+	MaxProjects  int
+	Labels       []string
+}
+
+func loadRegressionConfigFromEnv() *RegressionConfig {
+	config := &RegressionConfig{
+		WindowDays:   getEnvInt("REGRESSION_WINDOW_DAYS", 14),
+		LookbackDays: getEnvInt("REGRESSION_LOOKBACK_DAYS", 60),
+		MaxProjects:  getEnvInt("REGRESSION_MAX_PROJECTS", 100),
+		Labels:       []string{"regression", "kind/regression", "type/regression", "type: regression"},
+	}
+	if v, ok := os.LookupEnv("REGRESSION_LABELS"); ok {
+		config.Labels = nil
+		for _, label := range strings.Split(v, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				config.Labels = append(config.Labels, label)
+			}
+		}
+	}
+	return config
+}
+
+// RegressionIssue is an issue together with the release it followed.
+type RegressionIssue struct {
+	Issue
+	Release    string
+	ReleasedAt time.Time
+	DaysAfter  int
+}
+
+var (
+	regressionTitlePattern = regexp.MustCompile(`(?i)\bregress(ion|ions|ed)?\b`)
+	titleVersionPattern    = regexp.MustCompile(`\bv?(\d+\.\d+(?:\.\d+)?)\b`)
+)
+
+// isRegressionIssue reports whether an issue is labeled or titled as a
+// regression.
+func isRegressionIssue(issue *github.Issue, labels []string) bool {
+	for _, label := range issue.Labels {
+		name := strings.ToLower(label.GetName())
+		for _, want := range labels {
+			if name == strings.ToLower(want) {
+				return true
+			}
+		}
+	}
+	return regressionTitlePattern.MatchString(issue.GetTitle())
+}
+
+// MatchRelease finds the release an issue most likely regressed in: the
+// release named in the title ("regression in v1.30") when there is one,
+// otherwise the latest release published before the issue. It reports false
+// unless the issue was opened within window of that release. Drafts and
+// pre-releases are ignored.
+func MatchRelease(title string, createdAt time.Time, releases []*github.RepositoryRelease, window time.Duration) (*github.RepositoryRelease, bool) {
+	var published []*github.RepositoryRelease
+	for _, r := range releases {
+		if r.GetDraft() || r.GetPrerelease() || r.PublishedAt == nil || r.GetPublishedAt().After(createdAt) {
+			continue
+		}
+		published = append(published, r)
+	}
+	if len(published) == 0 {
+		return nil, false
+	}
+	sort.Slice(published, func(i, j int) bool {
+		return published[i].GetPublishedAt().After(published[j].GetPublishedAt().Time)
+	})
+
+	match := published[0]
+	for _, m := range titleVersionPattern.FindAllStringSubmatch(title, -1) {
+		if named := findReleaseByVersion(published, m[1]); named != nil {
+			match = named
+			break
+		}
+	}
+
+	if createdAt.Sub(match.GetPublishedAt().Time) > window {
+		return nil, false
+	}
+	return match, true
+}
+
+// findReleaseByVersion returns the newest release whose tag is version or a
+// patch of it, so "1.30" matches "v1.30.2" and "kubernetes-1.30.0".
+func findReleaseByVersion(releases []*github.RepositoryRelease, version string) *github.RepositoryRelease {
+	for _, r := range releases {
+		tag := r.GetTagName()
+		if i := strings.IndexFunc(tag, func(c rune) bool { return c >= '0' && c <= '9' }); i >= 0 {
+			tag = tag[i:]
+		}
+		if tag == version || strings.HasPrefix(tag, version+".") {
+			return r
+		}
+	}
+	return nil
+}
+
+// regressionBonus favors issues reported soon after the release, when the
+// change that caused them is still fresh.
+func regressionBonus(daysAfter, windowDays int) float64 {
+	if windowDays <= 0 || daysAfter > windowDays {
+		return 0
+	}
+	return 0.2 * (1 - float64(daysAfter)/float64(windowDays))
+}
+
+// FindRegressionIssues searches watched repositories for open regressions
+// reported within the configured window after a release. Two searches per
+// group of repos find labeled and titled regressions; releases are only
+// fetched for repos with candidates.
+func (f *IssueFinder) FindRegressionIssues(ctx context.Context, config *RegressionConfig) ([]RegressionIssue, error) {
+	projects := f.projects
+	if config.MaxProjects > 0 && len(projects) > config.MaxProjects {
+		projects = projects[:config.MaxProjects]
+	}
+	projectsByRepo := make(map[string]Project, len(projects))
+	for _, p := range projects {
+		projectsByRepo[strings.ToLower(p.Org+"/"+p.Name)] = p
+	}
+
+	base := "is:issue is:open no:assignee archived:false created:>=" + time.Now().AddDate(0, 0, -config.LookbackDays).Format("2006-01-02")
+	var queries []LiteQuery
+	if len(config.Labels) > 0 {
+		quoted := make([]string, len(config.Labels))
+		for i, label := range config.Labels {
+			quoted[i] = strconv.Quote(label)
+		}
+		queries = append(queries, packRepoQueries(base+" label:"+strings.Join(quoted, ","), projects)...)
+	}
+	queries = append(queries, packRepoQueries(base+" regression in:title", projects)...)
+
+	log.Printf("[Regression] Searching %d repositories with %d queries", len(projects), len(queries))
+
+	candidates := make(map[string][]*github.Issue)
+	seenURLs := make(map[string]bool)
+	interval := liteSearchInterval(f.config.GitHubToken != "")
+	for i, q := range queries {
+		if ctx.Err() != nil {
+			break
+		}
+		if i > 0 {
+			time.Sleep(interval)
+		}
+
+		var result *github.IssuesSearchResult
+		err := f.rateLimiter.executeWithRetry(ctx, "regression search", func() (*github.Response, error) {
+			var resp *github.Response
+			var apiErr error
+			result, resp, apiErr = f.client.SearchIssues(ctx, q.Query, &github.SearchOptions{
+				Sort:        "created",
+				Order:       "desc",
+				ListOptions: github.ListOptions{PerPage: 100},
+			})
+			return resp, apiErr
+		})
+		if err != nil {
+			log.Printf("[Regression] Search failed for %d repositories: %v", len(q.Repos), err)
+			continue
+		}
+
+		for _, issue := range result.Issues {
+			if issue.IsPullRequest() || len(issue.Assignees) > 0 || seenURLs[issue.GetHTMLURL()] {
+				continue
+			}
+			if !isRegressionIssue(issue, config.Labels) {
+				continue
+			}
+			owner, repo, _, err := ParseIssueURL(issue.GetHTMLURL())
+			if err != nil {
+				continue
+			}
+			key := strings.ToLower(owner + "/" + repo)
+			if _, ok := projectsByRepo[key]; !ok {
+				continue
+			}
+			seenURLs[issue.GetHTMLURL()] = true
+			candidates[key] = append(candidates[key], issue)
+		}
+	}
+
+	window := time.Duration(config.WindowDays) * 24 * time.Hour
+	var found []RegressionIssue
+	for key, issues := range candidates {
+		p := projectsByRepo[key]
+		var releases []*github.RepositoryRelease
+		err := f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("list releases for %s/%s", p.Org, p.Name), func() (*github.Response, error) {
+			var resp *github.Response
+			var apiErr error
+			releases, resp, apiErr = f.client.ListReleases(ctx, p.Org, p.Name, &github.ListOptions{PerPage: 30})
+			return resp, apiErr
+		})
+		if err != nil {
+			log.Printf("[Regression] Failed to list releases for %s/%s: %v", p.Org, p.Name, err)
+			continue
+		}
+
+		// Seen issues are listed too: a regression the daemon already reported
+		// is still worth showing here, and this mode marks nothing seen.
+		for _, issue := range issues {
+			release, ok := MatchRelease(issue.GetTitle(), issue.GetCreatedAt().Time, releases, window)
+			if !ok {
+				continue
+			}
+			daysAfter := int(issue.GetCreatedAt().Sub(release.GetPublishedAt().Time).Hours() / 24)
+			result, signOff := f.scoreIssue(ctx, p, issue)
+
+			found = append(found, RegressionIssue{
+				Issue: Issue{
+					Project:      p,
+					Title:        issue.GetTitle(),
+					URL:          issue.GetHTMLURL(),
+					Number:       issue.GetNumber(),
+					Score:        result.Score + regressionBonus(daysAfter, config.WindowDays),
+					CreatedAt:    issue.GetCreatedAt().Time,
+					Comments:     issue.GetComments(),
+					Labels:       getLabelNames(issue.Labels),
+					Language:     "Go",
+					ReadingTime:  EstimateReadingTime(issue),
+					SignOff:      signOff,
+					KeywordScore: result.Keywords,
+					Confidence:   result.Confidence,
+				},
+				Release:    release.GetTagName(),
+				ReleasedAt: release.GetPublishedAt().Time,
+				DaysAfter:  daysAfter,
+			})
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Score > found[j].Score })
+	logAPIUsage("Regression")
+	log.Printf("[Regression] Found %d regressions reported within %d days of a release", len(found), config.WindowDays)
+	return found, nil
+}
+
+func PrintRegressionIssues(issues []RegressionIssue, windowDays int) {
+	fmt.Fprintf(stdout, "\n%s\n", T("regression.title", windowDays))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+
+	if len(issues) == 0 {
+		fmt.Fprintln(stdout, T("regression.none"))
+		return
+	}
+
+	for i, issue := range issues {
+		record := &Record{Title: fmt.Sprintf("\n%s [%d] %s", getScoreEmoji(issue.Score), i+1, issue.Title), TitleColor: colorBold}
+		record.AddColored(T("field.score"), fmt.Sprintf("%.2f (%s)", issue.Score, scoreGrade(issue.Score)), scoreColor(issue.Score))
+		if confidence := formatConfidence(issue.Issue); confidence != "" {
+			record.Add(T("field.confidence"), confidence)
+		}
+		if badge := issue.SignOff.Badge(); badge != "" {
+			record.Add(T("field.sign_off"), badge)
+		}
+		record.Add(T("field.project"), fmt.Sprintf("%s/%s (%d★)", issue.Project.Org, issue.Project.Name, issue.Project.Stars))
+		record.Add(T("field.release"), T("regression.after", issue.Release, issue.ReleasedAt.Format("2006-01-02"), issue.DaysAfter))
+		record.Add(T("field.comments"), strconv.Itoa(issue.Comments))
+		record.Add(T("field.url"), issue.URL)
+		if len(issue.Labels) > 0 {
+			record.Add(T("field.labels"), strings.Join(issue.Labels, ", "))
+		}
+		record.Render(stdout, "   ")
+		fmt.Fprintln(stdout, strings.Repeat("-", 80))
+	}
+}
+
+func runRegressionsCommand(args []string) error {
+	config := loadRegressionConfigFromEnv()
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--window" && i+1 < len(args):
+			days, err := strconv.Atoi(args[i+1])
+			if err != nil || days <= 0 {
+				return fmt.Errorf("invalid --window value %q", args[i+1])
+			}
+			config.WindowDays = days
+			i++
+		case args[i] == "--lookback" && i+1 < len(args):
+			days, err := strconv.Atoi(args[i+1])
+			if err != nil || days <= 0 {
+				return fmt.Errorf("invalid --lookback value %q", args[i+1])
+			}
+			config.LookbackDays = days
+			i++
+		}
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	finder, ok := server.finder.(*IssueFinder)
+	if !ok {
+		return fmt.Errorf("regressions needs the database-backed issue finder")
+	}
+
+	issues, err := finder.FindRegressionIssues(context.Background(), config)
+	if err != nil {
+		return err
+	}
+	PrintRegressionIssues(issues, config.WindowDays)
+	return nil
+}
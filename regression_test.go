@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func testRelease(tag string, published time.Time) *github.RepositoryRelease {
+	return &github.RepositoryRelease{TagName: github.String(tag), PublishedAt: &github.Timestamp{Time: published}}
+}
+
+func TestMatchRelease(t *testing.T) {
+	day := 24 * time.Hour
+	base := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	releases := []*github.RepositoryRelease{
+		testRelease("v1.29.0", base),
+		testRelease("v1.30.0", base.Add(20*day)),
+		testRelease("v1.30.1", base.Add(25*day)),
+		{TagName: github.String("v1.31.0-rc.1"), Prerelease: github.Bool(true), PublishedAt: &github.Timestamp{Time: base.Add(26 * day)}},
+		{TagName: github.String("v1.31.0"), Draft: github.Bool(true), PublishedAt: &github.Timestamp{Time: base.Add(27 * day)}},
+		testRelease("v1.32.0", base.Add(60*day)),
+	}
+	window := 14 * day
+
+	tests := []struct {
+		name    string
+		title   string
+		created time.Time
+		wantTag string
+		wantOK  bool
+	}{
+		{"latest preceding release", "panic on startup", base.Add(28 * day), "v1.30.1", true},
+		{"version named in title", "Regression in v1.30: watch hangs", base.Add(28 * day), "v1.30.1", true},
+		{"older version named in title", "regression in 1.29.0", base.Add(10 * day), "v1.29.0", true},
+		{"named release outside window", "regression in v1.29", base.Add(28 * day), "", false},
+		{"prereleases and drafts ignored", "regression", base.Add(26*day + time.Hour), "v1.30.1", true},
+		{"outside window", "regression", base.Add(50 * day), "", false},
+		{"before any release", "regression", base.Add(-day), "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release, ok := MatchRelease(tt.title, tt.created, releases, window)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && release.GetTagName() != tt.wantTag {
+				t.Errorf("release = %s, want %s", release.GetTagName(), tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestFindReleaseByVersion(t *testing.T) {
+	releases := []*github.RepositoryRelease{
+		{TagName: github.String("kubernetes-1.30.2")},
+		{TagName: github.String("v1.3.0")},
+	}
+	if r := findReleaseByVersion(releases, "1.30"); r.GetTagName() != "kubernetes-1.30.2" {
+		t.Errorf("1.30 matched %q", r.GetTagName())
+	}
+	if r := findReleaseByVersion(releases, "1.3"); r.GetTagName() != "v1.3.0" {
+		t.Errorf("1.3 matched %q, want v1.3.0", r.GetTagName())
+	}
+	if r := findReleaseByVersion(releases, "2.0"); r != nil {
+		t.Errorf("2.0 matched %q", r.GetTagName())
+	}
+}
+
+func TestIsRegressionIssue(t *testing.T) {
+	labels := []string{"regression", "kind/regression"}
+	tests := []struct {
+		title  string
+		labels []string
+		want   bool
+	}{
+		{"Regression in v1.30", nil, true},
+		{"Startup regressed after upgrade", nil, true},
+		{"Fix crash", []string{"Kind/Regression"}, true},
+		{"Fix crash", []string{"bug"}, false},
+		{"Add regressor model", nil, false},
+	}
+	for _, tt := range tests {
+		issue := &github.Issue{Title: github.String(tt.title)}
+		for _, name := range tt.labels {
+			issue.Labels = append(issue.Labels, &github.Label{Name: github.String(name)})
+		}
+		if got := isRegressionIssue(issue, labels); got != tt.want {
+			t.Errorf("isRegressionIssue(%q, %v) = %v, want %v", tt.title, tt.labels, got, tt.want)
+		}
+	}
+}
+
+func TestRegressionBonus(t *testing.T) {
+	if got := regressionBonus(0, 14); got != 0.2 {
+		t.Errorf("same-day bonus = %v, want 0.2", got)
+	}
+	if got := regressionBonus(7, 14); got != 0.1 {
+		t.Errorf("mid-window bonus = %v, want 0.1", got)
+	}
+	if got := regressionBonus(15, 14); got != 0 {
+		t.Errorf("out-of-window bonus = %v, want 0", got)
+	}
+}
+
+func TestFindRegressionIssues(t *testing.T) {
+	now := time.Now()
+	issue := &github.Issue{
+		Number:    github.Int(5),
+		NodeID:    github.String("I_5"),
+		Title:     github.String("Regression in v1.2: panic on empty config"),
+		HTMLURL:   github.String("https://github.com/acme/widget/issues/5"),
+		Comments:  github.Int(1),
+		CreatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -2)},
+	}
+	api := &fakeGitHubAPI{
+		searched: []*github.Issue{issue},
+		releases: map[string][]*github.RepositoryRelease{"acme/widget": {testRelease("v1.2", now.AddDate(0, 0, -5))}},
+		files:    map[string]string{"acme/widget/.clabot": ""},
+	}
+	project := Project{Org: "acme", Name: "widget"}
+	f := &IssueFinder{
+		config:      &Config{},
+		client:      api,
+		rateLimiter: NewRateLimiter(api, 0),
+		scorer:      NewIssueScorer(),
+		signOff:     NewSignOffDetector(api, nil, &SignOffConfig{Enabled: true, CLAAdjustment: -0.1, CacheTTL: time.Hour}),
+		projects:    []Project{project},
+		// The daemon surfaced it already; regressions still lists it.
+		seenIssues: map[string]bool{"widget/5": true},
+		seenNodes:  map[string]bool{"I_5": true},
+	}
+
+	found, err := f.FindRegressionIssues(context.Background(), &RegressionConfig{WindowDays: 14, LookbackDays: 60})
+	if err != nil {
+		t.Fatalf("FindRegressionIssues: %v", err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("found %d regressions, want the seen one listed", len(found))
+	}
+
+	got := found[0]
+	want := f.scorer.ScoreIssueWithConfidence(issue, project).Score - 0.1 + regressionBonus(3, 14)
+	if got.SignOff != SignOffCLA || math.Abs(got.Score-want) > 1e-9 {
+		t.Errorf("score %.3f (sign-off %q), want %.3f with the CLA adjustment", got.Score, got.SignOff, want)
+	}
+	if got.Release != "v1.2" || got.DaysAfter != 3 {
+		t.Errorf("release %s, %d days after; want v1.2, 3", got.Release, got.DaysAfter)
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+// RepoMove records a repository that was renamed or transferred to another
+// owner. GitHub keeps redirecting the old name until someone reclaims it, so
+// moves are followed as soon as a redirect or 404 is seen.
+type RepoMove struct {
+	OldOwner string
+	OldName  string
+	NewOwner string
+	NewName  string
+}
+
+func (m RepoMove) OldFullName() string { return m.OldOwner + "/" + m.OldName }
+func (m RepoMove) NewFullName() string { return m.NewOwner + "/" + m.NewName }
+
+func initRepoMovesTable(db *sqlx.DB) error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS repo_moves (
+		old_full_name TEXT PRIMARY KEY,
+		new_full_name TEXT NOT NULL,
+		moved_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+	`
+	_, err := db.Exec(schema)
+	return err
+}
+
+// wasRedirected reports whether a request for owner/name was answered from
+// /repositories/<id>, which is where GitHub sends requests for moved repos.
+func wasRedirected(resp *github.Response) bool {
+	if resp == nil || resp.Response == nil || resp.Request == nil || resp.Request.URL == nil {
+		return false
+	}
+	return strings.Contains(resp.Request.URL.Path, "/repositories/")
+}
+
+// repoMaybeMoved reports whether a listing result points at a move: a
+// redirect to /repositories/<id>, a 301, or a 404. Rate limits, timeouts
+// and server errors say nothing about the repository, so they do not cost
+// a lookup.
+func repoMaybeMoved(resp *github.Response, err error) bool {
+	if wasRedirected(resp) {
+		return true
+	}
+	status := responseStatus(resp, err)
+	return status == http.StatusNotFound || status == http.StatusMovedPermanently
+}
+
+// resolveRepoMove looks up where owner/name lives now. It reports false when
+// the repository is where it was or cannot be found at all.
+func resolveRepoMove(ctx context.Context, client GitHubAPI, owner, name string) (RepoMove, bool) {
+	repo, _, err := client.GetRepository(ctx, owner, name)
+	if err != nil || repo == nil {
+		return RepoMove{}, false
+	}
+
+	move := RepoMove{OldOwner: owner, OldName: name, NewOwner: repo.GetOwner().GetLogin(), NewName: repo.GetName()}
+	if move.NewOwner == "" || move.NewName == "" {
+		return RepoMove{}, false
+	}
+	if strings.EqualFold(move.OldFullName(), move.NewFullName()) {
+		return RepoMove{}, false
+	}
+	return move, true
+}
+
+// recordRepoMove stores the move, points earlier moves of the same repo at
+// its newest name, and rewrites stored issue IDs and URLs so history and
+// dedup carry over. Rows are matched by exact prefix rather than LIKE, since
+// repo names may contain "_". Tables owned by optional components may be
+// missing, so their failures are only logged.
+func recordRepoMove(db *sqlx.DB, move RepoMove) error {
+	if db == nil {
+		return nil
+	}
+
+	if _, err := db.Exec(`
+		INSERT INTO repo_moves (old_full_name, new_full_name, moved_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (old_full_name) DO UPDATE SET new_full_name = $2, moved_at = $3
+	`, move.OldFullName(), move.NewFullName(), time.Now()); err != nil {
+		return err
+	}
+	if _, err := db.Exec(`UPDATE repo_moves SET new_full_name = $2 WHERE new_full_name = $1`, move.OldFullName(), move.NewFullName()); err != nil {
+		return err
+	}
+
+	oldPrefix := "https://github.com/" + move.OldFullName() + "/"
+	newPrefix := "https://github.com/" + move.NewFullName() + "/"
+	statements := []struct {
+		table string
+		query string
+		args  []interface{}
+	}{
+		{"seen_issues", `
+			UPDATE seen_issues SET issue_id = $2 || substring(issue_id from length($1) + 1), project_name = $2
+			WHERE project_name = $1 AND left(issue_id, length($1) + 1) = $1 || '/'
+		`, []interface{}{move.OldName, move.NewName}},
+		{"issue_history", `
+			UPDATE issue_history SET issue_id = $4 || substring(issue_id from length($3) + 1), project_name = $4,
+				issue_url = $2 || substring(issue_url from length($1) + 1)
+			WHERE left(issue_url, length($1)) = $1
+		`, []interface{}{oldPrefix, newPrefix, move.OldName, move.NewName}},
+		{"rejected_issues", `
+			UPDATE rejected_issues SET issue_id = $4 || substring(issue_id from length($3) + 1), project_name = $4,
+				issue_url = $2 || substring(issue_url from length($1) + 1)
+			WHERE left(issue_url, length($1)) = $1
+		`, []interface{}{oldPrefix, newPrefix, move.OldName, move.NewName}},
+		{"tracked_issues", `
+			UPDATE tracked_issues SET project_org = $3, project_name = $4,
+				issue_url = $2 || substring(issue_url from length($1) + 1)
+			WHERE left(issue_url, length($1)) = $1
+		`, []interface{}{oldPrefix, newPrefix, move.NewOwner, move.NewName}},
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt.query, stmt.args...); err != nil {
+			log.Printf("[RepoMoves] Failed to reconcile %s for %s: %v", stmt.table, move.OldFullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// loadRepoMoves returns recorded moves keyed by the lower-cased old full name.
+func loadRepoMoves(db *sqlx.DB) (map[string]RepoMove, error) {
+	var rows []struct {
+		OldFullName string `db:"old_full_name"`
+		NewFullName string `db:"new_full_name"`
+	}
+	if err := db.Select(&rows, `SELECT old_full_name, new_full_name FROM repo_moves`); err != nil {
+		return nil, err
+	}
+
+	moves := make(map[string]RepoMove, len(rows))
+	for _, row := range rows {
+		oldParts := strings.SplitN(row.OldFullName, "/", 2)
+		newParts := strings.SplitN(row.NewFullName, "/", 2)
+		if len(oldParts) != 2 || len(newParts) != 2 {
+			continue
+		}
+		moves[strings.ToLower(row.OldFullName)] = RepoMove{OldOwner: oldParts[0], OldName: oldParts[1], NewOwner: newParts[0], NewName: newParts[1]}
+	}
+	return moves, nil
+}
+
+// applyRepoMoves renames projects in place and returns how many changed.
+func applyRepoMoves(projects []Project, moves map[string]RepoMove) int {
+	renamed := 0
+	for i, p := range projects {
+		if move, ok := moves[strings.ToLower(p.Org+"/"+p.Name)]; ok {
+			projects[i].Org, projects[i].Name = move.NewOwner, move.NewName
+			renamed++
+		}
+	}
+	return renamed
+}
+
+// RenameRepo points a watchlist entry at its new owner/name. If the new name
+// is already on the watchlist the old entry is dropped instead. The list is
+// copied first because managers start out sharing DefaultRepos.
+func (rm *RepoManager) RenameRepo(move RepoMove) bool {
+	for i, repo := range rm.included {
+		if !strings.EqualFold(repo.Owner, move.OldOwner) || !strings.EqualFold(repo.Name, move.OldName) {
+			continue
+		}
+		included := make([]RepoConfig, 0, len(rm.included))
+		included = append(included, rm.included[:i]...)
+		if rm.GetRepo(move.NewOwner, move.NewName) == nil {
+			repo.Owner, repo.Name = move.NewOwner, move.NewName
+			included = append(included, repo)
+		}
+		rm.included = append(included, rm.included[i+1:]...)
+		return true
+	}
+	return false
+}
+
+// ApplyRepoMoves renames every watchlist entry with a recorded move.
+func (rm *RepoManager) ApplyRepoMoves(moves map[string]RepoMove) {
+	for _, repo := range rm.ListRepos() {
+		if move, ok := moves[strings.ToLower(repo.Owner+"/"+repo.Name)]; ok {
+			rm.RenameRepo(move)
+		}
+	}
+}
+
+// followRepoMove is called when listing a project's issues returned a 404
+// or was redirected. It records the move and returns the project under its new
+// name, or false if the repository has not moved.
+func (f *IssueFinder) followRepoMove(ctx context.Context, p Project) (Project, bool) {
+	move, moved := resolveRepoMove(ctx, f.client, p.Org, p.Name)
+	if !moved {
+		return p, false
+	}
+
+	log.Printf("[RepoMoves] %s moved to %s, following", move.OldFullName(), move.NewFullName())
+	if err := recordRepoMove(f.db, move); err != nil {
+		log.Printf("[RepoMoves] Failed to record move of %s: %v", move.OldFullName(), err)
+	}
+
+	f.mu.Lock()
+	f.pendingMoves = append(f.pendingMoves, move)
+	f.mu.Unlock()
+
+	p.Org, p.Name = move.NewOwner, move.NewName
+	return p, true
+}
+
+// applyPendingMoves renames projects and watchlist entries for moves found
+// during the last run. It runs once the project goroutines are done, since
+// they read f.projects.
+func (f *IssueFinder) applyPendingMoves() {
+	f.mu.Lock()
+	pending := f.pendingMoves
+	f.pendingMoves = nil
+	f.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	moves := make(map[string]RepoMove, len(pending))
+	for _, move := range pending {
+		moves[strings.ToLower(move.OldFullName())] = move
+	}
+	applyRepoMoves(f.projects, moves)
+	if f.repoManager != nil {
+		f.repoManager.ApplyRepoMoves(moves)
+	}
+}
+
+// loadRecordedMoves applies moves found on earlier runs so renamed repos
+// are not looked up under their old names again.
+func (f *IssueFinder) loadRecordedMoves() {
+	moves, err := loadRepoMoves(f.db)
+	if err != nil {
+		log.Printf("Warning: failed to load repository moves: %v", err)
+		return
+	}
+	if n := applyRepoMoves(f.projects, moves); n > 0 {
+		log.Printf("[RepoMoves] Using new names for %d moved repositories", n)
+	}
+	if f.repoManager != nil {
+		f.repoManager.ApplyRepoMoves(moves)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestWasRedirected(t *testing.T) {
+	response := func(path string) *github.Response {
+		return &github.Response{Response: &http.Response{Request: &http.Request{URL: &url.URL{Path: path}}}}
+	}
+
+	if wasRedirected(nil) {
+		t.Error("nil response should not count as redirected")
+	}
+	if wasRedirected(response("/repos/old/name/issues")) {
+		t.Error("direct response should not count as redirected")
+	}
+	if !wasRedirected(response("/repositories/1234/issues")) {
+		t.Error("response from /repositories/<id> should count as redirected")
+	}
+}
+
+func TestResolveRepoMove(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/old-org/tool":
+			http.Redirect(w, r, "/repositories/42", http.StatusMovedPermanently)
+		case "/repositories/42":
+			fmt.Fprint(w, `{"name":"tool-ng","owner":{"login":"new-org"}}`)
+		case "/repos/same/repo":
+			fmt.Fprint(w, `{"name":"Repo","owner":{"login":"Same"}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(server.URL + "/")
+	client := NewGitHubAPI(gh)
+
+	move, moved := resolveRepoMove(context.Background(), client, "old-org", "tool")
+	if !moved || move.NewFullName() != "new-org/tool-ng" {
+		t.Errorf("resolveRepoMove() = %+v, %v; want new-org/tool-ng", move, moved)
+	}
+	if _, moved := resolveRepoMove(context.Background(), client, "same", "repo"); moved {
+		t.Error("a case-only difference is not a move")
+	}
+	if _, moved := resolveRepoMove(context.Background(), client, "gone", "repo"); moved {
+		t.Error("a missing repo is not a move")
+	}
+}
+
+func TestApplyRepoMoves(t *testing.T) {
+	moves := map[string]RepoMove{
+		"old-org/tool": {OldOwner: "old-org", OldName: "tool", NewOwner: "new-org", NewName: "tool-ng"},
+	}
+
+	projects := []Project{{Org: "Old-Org", Name: "Tool"}, {Org: "other", Name: "repo"}}
+	if n := applyRepoMoves(projects, moves); n != 1 {
+		t.Errorf("renamed %d projects, want 1", n)
+	}
+	if projects[0].Org != "new-org" || projects[0].Name != "tool-ng" || projects[1].Name != "repo" {
+		t.Errorf("projects = %+v", projects)
+	}
+
+	shared := []RepoConfig{{Owner: "old-org", Name: "tool", Enabled: true}, {Owner: "other", Name: "repo", Enabled: true}}
+	rm := &RepoManager{included: shared}
+	rm.ApplyRepoMoves(moves)
+	if rm.GetRepo("new-org", "tool-ng") == nil || rm.GetRepo("old-org", "tool") != nil {
+		t.Errorf("watchlist = %+v", rm.ListRepos())
+	}
+	if shared[0].Owner != "old-org" {
+		t.Error("renaming should not modify the shared repo list")
+	}
+
+	dup := &RepoManager{included: []RepoConfig{{Owner: "old-org", Name: "tool"}, {Owner: "new-org", Name: "tool-ng"}}}
+	dup.RenameRepo(moves["old-org/tool"])
+	if len(dup.ListRepos()) != 1 {
+		t.Errorf("moving onto an existing entry should drop the old one, got %+v", dup.ListRepos())
+	}
+}
+
+func TestRepoMaybeMoved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/gone/repo/issues":
+			http.NotFound(w, r)
+		case "/repos/busy/repo/issues":
+			http.Error(w, `{"message":"server error"}`, http.StatusBadGateway)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer server.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(server.URL + "/")
+	client := NewGitHubAPI(gh)
+
+	tests := []struct {
+		owner string
+		want  bool
+	}{
+		{"gone", true},
+		{"busy", false},
+		{"fine", false},
+	}
+	for _, tt := range tests {
+		_, resp, err := client.ListRepoIssues(context.Background(), tt.owner, "repo", nil)
+		if got := repoMaybeMoved(resp, err); got != tt.want {
+			t.Errorf("repoMaybeMoved(%s) = %v, want %v (err: %v)", tt.owner, got, tt.want, err)
+		}
+	}
+	if repoMaybeMoved(nil, fmt.Errorf("dial tcp: timeout")) {
+		t.Error("a network error is not a move")
+	}
+}
+
+func TestRecordRepoMove_MatchesExactPrefix(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	move := RepoMove{OldOwner: "old_org", OldName: "my_tool", NewOwner: "new-org", NewName: "tool"}
+
+	fake.expect("INSERT INTO repo_moves", "old_org/my_tool", "new-org/tool", fakeSQLAnyArg{})
+	fake.expect("UPDATE repo_moves", "old_org/my_tool", "new-org/tool")
+	fake.expect("left(issue_id, length($1) + 1) = $1 || '/'", "my_tool", "tool")
+	for range []string{"issue_history", "rejected_issues", "tracked_issues"} {
+		fake.expect("WHERE left(issue_url, length($1)) = $1")
+	}
+
+	if err := recordRepoMove(db, move); err != nil {
+		t.Fatalf("recordRepoMove() error: %v", err)
+	}
+}
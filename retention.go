@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// RetentionConfig bounds the history tables. Rows older than the cutoff are
+// rolled up into monthly aggregates first, so long-term counts survive. It is
+// off until enabled, since trimmed rows drop out of per-issue reports.
+type RetentionConfig struct {
+	Enabled            bool
+	IssueHistoryDays   int
+	CommentHistoryDays int
+	Interval           time.Duration
+}
+
+type RetentionResult struct {
+	IssueRowsRolledUp   int64
+	CommentRowsRolledUp int64
+}
+
+func loadRetentionConfigFromEnv() *RetentionConfig {
+	return &RetentionConfig{
+		Enabled:            getEnvBool("RETENTION_ENABLED", false),
+		IssueHistoryDays:   getEnvInt("RETENTION_ISSUE_HISTORY_DAYS", 180),
+		CommentHistoryDays: getEnvInt("RETENTION_COMMENT_HISTORY_DAYS", 180),
+		Interval:           time.Duration(getEnvInt("RETENTION_INTERVAL_HOURS", 24)) * time.Hour,
+	}
+}
+
+// commentedIssuesSchema holds the issues whose comment_history rows retention
+// has deleted. Dedup checks read it alongside comment_history.
+const commentedIssuesSchema = `
+	CREATE TABLE IF NOT EXISTS commented_issues (
+		issue_url TEXT PRIMARY KEY,
+		last_commented_at TIMESTAMP NOT NULL
+	);
+	`
+
+func initRetentionTables(db *sqlx.DB) error {
+	if _, err := db.Exec(commentedIssuesSchema); err != nil {
+		return err
+	}
+	_, err := db.Exec(`
+	CREATE TABLE IF NOT EXISTS issue_history_monthly (
+		month DATE NOT NULL,
+		project_name TEXT NOT NULL,
+		category TEXT NOT NULL,
+		issues INTEGER NOT NULL DEFAULT 0,
+		score_sum FLOAT NOT NULL DEFAULT 0,
+		score_max FLOAT NOT NULL DEFAULT 0,
+		PRIMARY KEY (month, project_name, category)
+	);
+
+	CREATE TABLE IF NOT EXISTS comment_history_monthly (
+		month DATE NOT NULL,
+		repo TEXT NOT NULL,
+		comments INTEGER NOT NULL DEFAULT 0,
+		PRIMARY KEY (month, repo)
+	);
+	`)
+	return err
+}
+
+// ApplyRetention rolls up and trims both history tables. Each table is
+// handled in its own transaction, so a rollup is never counted twice.
+func ApplyRetention(db *sqlx.DB, config *RetentionConfig, now time.Time) (RetentionResult, error) {
+	var result RetentionResult
+	if err := initRetentionTables(db); err != nil {
+		return result, fmt.Errorf("failed to create rollup tables: %w", err)
+	}
+
+	if config.IssueHistoryDays > 0 {
+		n, err := rollUpIssueHistory(db, now.AddDate(0, 0, -config.IssueHistoryDays))
+		if err != nil {
+			return result, fmt.Errorf("issue_history: %w", err)
+		}
+		result.IssueRowsRolledUp = n
+	}
+
+	if config.CommentHistoryDays > 0 {
+		n, err := rollUpCommentHistory(db, now.AddDate(0, 0, -config.CommentHistoryDays))
+		if err != nil {
+			return result, fmt.Errorf("comment_history: %w", err)
+		}
+		result.CommentRowsRolledUp = n
+	}
+
+	return result, nil
+}
+
+func rollUpIssueHistory(db *sqlx.DB, cutoff time.Time) (int64, error) {
+	if ok, err := tableExists(db, "issue_history"); err != nil || !ok {
+		return 0, err
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO issue_history_monthly (month, project_name, category, issues, score_sum, score_max)
+		SELECT date_trunc('month', discovered_at)::date, project_name, category, COUNT(*), SUM(score), MAX(score)
+		FROM issue_history
+		WHERE discovered_at < $1
+		GROUP BY 1, 2, 3
+		ON CONFLICT (month, project_name, category) DO UPDATE SET
+			issues = issue_history_monthly.issues + EXCLUDED.issues,
+			score_sum = issue_history_monthly.score_sum + EXCLUDED.score_sum,
+			score_max = GREATEST(issue_history_monthly.score_max, EXCLUDED.score_max)
+	`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.Exec(`DELETE FROM issue_history WHERE discovered_at < $1`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return n, tx.Commit()
+}
+
+// rollUpCommentHistory counts old comments into monthly aggregates, folds
+// them into commented_issues and deletes them. The folded record keeps only
+// the issue URL and the last comment time, which is all the dedup check and
+// the pipeline funnel need. Every attempt is folded, posted or not, so the
+// tool never comments twice on an issue it once tried.
+func rollUpCommentHistory(db *sqlx.DB, cutoff time.Time) (int64, error) {
+	if ok, err := tableExists(db, "comment_history"); err != nil || !ok {
+		return 0, err
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO comment_history_monthly (month, repo, comments)
+		SELECT date_trunc('month', commented_at)::date,
+			split_part(issue_url, '/', 4) || '/' || split_part(issue_url, '/', 5),
+			COUNT(*)
+		FROM comment_history
+		WHERE commented_at < $1
+		GROUP BY 1, 2
+		ON CONFLICT (month, repo) DO UPDATE SET
+			comments = comment_history_monthly.comments + EXCLUDED.comments
+	`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO commented_issues (issue_url, last_commented_at)
+		SELECT issue_url, MAX(commented_at)
+		FROM comment_history
+		WHERE commented_at < $1 AND issue_url IS NOT NULL AND issue_url <> ''
+		GROUP BY issue_url
+		ON CONFLICT (issue_url) DO UPDATE SET
+			last_commented_at = GREATEST(commented_issues.last_commented_at, EXCLUDED.last_commented_at)
+	`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.Exec(`DELETE FROM comment_history WHERE commented_at < $1`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return n, tx.Commit()
+}
+
+func tableExists(db *sqlx.DB, table string) (bool, error) {
+	var exists bool
+	err := db.Get(&exists, `SELECT to_regclass($1) IS NOT NULL`, table)
+	return exists, err
+}
+
+func (r RetentionResult) String() string {
+	return fmt.Sprintf("rolled up %d issue_history rows and %d comment_history rows", r.IssueRowsRolledUp, r.CommentRowsRolledUp)
+}
+
+var (
+	retentionMu      sync.Mutex
+	retentionLastRun time.Time
+)
+
+// maybeApplyRetention runs retention from daemon loops at most once per
+// configured interval.
+func maybeApplyRetention(db *sqlx.DB) {
+	config := loadRetentionConfigFromEnv()
+	if !config.Enabled || db == nil {
+		return
+	}
+
+	retentionMu.Lock()
+	if !retentionLastRun.IsZero() && time.Since(retentionLastRun) < config.Interval {
+		retentionMu.Unlock()
+		return
+	}
+	retentionLastRun = time.Now()
+	retentionMu.Unlock()
+
+	result, err := ApplyRetention(db, config, time.Now())
+	if err != nil {
+		log.Printf("[Retention] Failed: %v", err)
+		return
+	}
+	if result.IssueRowsRolledUp > 0 || result.CommentRowsRolledUp > 0 {
+		log.Printf("[Retention] %s", result)
+	}
+}
+
+// describe lists the configured windows for the cleanup output.
+func (c *RetentionConfig) describe() string {
+	var parts []string
+	if c.IssueHistoryDays > 0 {
+		parts = append(parts, fmt.Sprintf("issue_history %dd", c.IssueHistoryDays))
+	}
+	if c.CommentHistoryDays > 0 {
+		parts = append(parts, fmt.Sprintf("comment_history %dd", c.CommentHistoryDays))
+	}
+	if len(parts) == 0 {
+		return "no retention windows set"
+	}
+	return strings.Join(parts, ", ")
+}
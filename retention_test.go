@@ -0,0 +1,52 @@
+package main
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestLoadRetentionConfigFromEnv(t *testing.T) {
+	t.Setenv("RETENTION_ENABLED", "")
+	t.Setenv("RETENTION_ISSUE_HISTORY_DAYS", "90")
+	t.Setenv("RETENTION_INTERVAL_HOURS", "6")
+
+	config := loadRetentionConfigFromEnv()
+	if config.Enabled {
+		t.Error("Enabled = true, want retention off by default")
+	}
+	if config.IssueHistoryDays != 90 || config.CommentHistoryDays != 180 {
+		t.Errorf("days = %d/%d, want 90/180", config.IssueHistoryDays, config.CommentHistoryDays)
+	}
+	if config.Interval != 6*time.Hour {
+		t.Errorf("Interval = %v, want 6h", config.Interval)
+	}
+}
+
+func TestRollUpCommentHistory(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	cutoff := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	fake.expect("to_regclass", "comment_history").returns([]string{"exists"}, []driver.Value{true})
+	fake.expect("INSERT INTO comment_history_monthly", cutoff).affects(2)
+	fake.expect("INSERT INTO commented_issues (issue_url, last_commented_at)", cutoff).affects(3)
+	fake.expect("DELETE FROM comment_history WHERE commented_at < $1", cutoff).affects(3)
+
+	n, err := rollUpCommentHistory(db, cutoff)
+	if err != nil {
+		t.Fatalf("rollUpCommentHistory: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("rolled up %d rows, want 3", n)
+	}
+}
+
+func TestRetentionConfigDescribe(t *testing.T) {
+	config := &RetentionConfig{IssueHistoryDays: 180, CommentHistoryDays: 30}
+	if got, want := config.describe(), "issue_history 180d, comment_history 30d"; got != want {
+		t.Errorf("describe() = %q, want %q", got, want)
+	}
+	if got := (&RetentionConfig{}).describe(); got != "no retention windows set" {
+		t.Errorf("describe() = %q", got)
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// ScoreRequest is the body of POST /score. Either URL or Issue is set; a body
+// that is itself a GitHub issue object is accepted as Issue.
+type ScoreRequest struct {
+	URL      string          `json:"url,omitempty"`
+	Issue    json.RawMessage `json:"issue,omitempty"`
+	Stars    *int            `json:"stars,omitempty"`
+	Category string          `json:"category,omitempty"`
+}
+
+// ScoreComponents splits the pipeline score by source. The weighted factors
+// come first; keywords are the capped text heuristics, bonus the label and
+// project signals, and sign_off the repo's CLA/DCO adjustment.
+type ScoreComponents struct {
+	Stars      float64 `json:"stars"`
+	Comments   float64 `json:"comments"`
+	Recency    float64 `json:"recency"`
+	Labels     float64 `json:"labels"`
+	Difficulty float64 `json:"difficulty"`
+	Keywords   float64 `json:"keywords"`
+	Bonus      float64 `json:"bonus"`
+	Penalty    float64 `json:"penalty"`
+	SignOff    float64 `json:"sign_off"`
+}
+
+type ScoreResponse struct {
+	Source         string             `json:"source"`
+	URL            string             `json:"url,omitempty"`
+	Title          string             `json:"title"`
+	Project        string             `json:"project,omitempty"`
+	Stars          int                `json:"stars"`
+	StarsKnown     bool               `json:"stars_known"`
+	Category       string             `json:"category,omitempty"`
+	TotalScore     float64            `json:"total_score"`
+	Grade          string             `json:"grade"`
+	Confidence     float64            `json:"confidence"`
+	SignOff        string             `json:"sign_off,omitempty"`
+	Breakdown      ScoreComponents    `json:"breakdown"`
+	Weights        map[string]float64 `json:"weights"`
+	MaxScore       float64            `json:"max_score"`
+	ReadingMinutes int                `json:"reading_minutes"`
+}
+
+// RegisterScoreRoutes serves /score. Without SCORE_API_TOKEN the endpoint is
+// only served on a loopback host.
+func (s *MCPServer) RegisterScoreRoutes(mux *http.ServeMux, host string) {
+	token := os.Getenv("SCORE_API_TOKEN")
+	if token == "" && !isLoopbackHost(host) {
+		log.Printf("[Score API] SCORE_API_TOKEN is not set; /score is disabled on non-loopback host %q", host)
+	}
+	mux.HandleFunc("/score", withScoreAPIToken(token, isLoopbackHost(host), s.handleScore))
+}
+
+// withScoreAPIToken requires "Authorization: Bearer <token>", since the
+// endpoint can spend API quota on arbitrary URLs. Without a token, requests
+// are only let through when the server listens on loopback.
+func withScoreAPIToken(token string, loopback bool, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if token == "" {
+			if !loopback {
+				http.Error(w, "SCORE_API_TOKEN is required on non-loopback hosts", http.StatusForbidden)
+				return
+			}
+		} else {
+			got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+func (s *MCPServer) handleScore(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	req, err := parseScoreRequest(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.scoreRequest(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func parseScoreRequest(body []byte) (*ScoreRequest, error) {
+	var req ScoreRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("request body must be JSON: %w", err)
+	}
+	if req.URL != "" || len(req.Issue) > 0 {
+		return &req, nil
+	}
+
+	// No wrapper: treat the whole body as a GitHub issue object.
+	var probe struct {
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(body, &probe); err == nil && probe.Title != "" {
+		req.Issue = bytes.TrimSpace(body)
+		return &req, nil
+	}
+	return nil, fmt.Errorf("request body must contain a url, an issue object, or be a GitHub issue")
+}
+
+func (s *MCPServer) scoreRequest(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+	var issue *github.Issue
+	var owner, repo string
+	source := "json"
+
+	if req.URL != "" {
+		var number int
+		var err error
+		owner, repo, number, err = ParseIssueURL(req.URL)
+		if err != nil {
+			return nil, err
+		}
+		if s.client == nil {
+			return nil, fmt.Errorf("github client not initialized")
+		}
+		issue, _, err = s.client.GetIssue(ctx, owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+		source = "url"
+	} else {
+		issue = &github.Issue{}
+		if err := json.Unmarshal(req.Issue, issue); err != nil {
+			return nil, fmt.Errorf("invalid issue JSON: %w", err)
+		}
+		if issue.GetTitle() == "" {
+			return nil, fmt.Errorf("issue JSON must include a title")
+		}
+		if issueURL := issue.GetHTMLURL(); issueURL != "" {
+			owner, repo, _, _ = ParseIssueURL(issueURL)
+		}
+		if owner == "" {
+			if parts := strings.Split(issue.GetRepositoryURL(), "/"); len(parts) >= 2 {
+				owner, repo = parts[len(parts)-2], parts[len(parts)-1]
+			}
+		}
+	}
+
+	// Fields the scorer dereferences are defaulted so partial issue JSON is
+	// accepted.
+	if issue.Comments == nil {
+		issue.Comments = github.Int(0)
+	}
+	if issue.CreatedAt == nil {
+		issue.CreatedAt = &github.Timestamp{Time: time.Now()}
+	}
+
+	project := Project{Org: owner, Name: repo, Category: req.Category}
+	if project.Category == "" && s.repoManager != nil && owner != "" {
+		if repoConfig := s.repoManager.GetRepo(owner, repo); repoConfig != nil {
+			project.Category = repoConfig.Category
+		}
+	}
+
+	// Stars stay 0 and are reported unknown when they cannot be looked up;
+	// a configured minimum is not the repo's star count.
+	starsKnown := false
+	if req.Stars != nil {
+		project.Stars, starsKnown = *req.Stars, true
+	} else if s.client != nil && owner != "" {
+		if repoInfo, _, err := s.client.GetRepository(ctx, owner, repo); err == nil && repoInfo != nil {
+			project.Stars, starsKnown = repoInfo.GetStargazersCount(), true
+		}
+	}
+
+	result, signOff := s.pipelineScore(ctx, project, issue)
+	resp := scoreIssueResponse(issue, project, result, signOff, source)
+	resp.StarsKnown = starsKnown
+	return resp, nil
+}
+
+// scoreIssueResponse reports a pipeline score with its parts.
+func scoreIssueResponse(issue *github.Issue, project Project, result ScoreResult, signOff SignOffRequirement, source string) *ScoreResponse {
+	resp := &ScoreResponse{
+		Source:     source,
+		URL:        issue.GetHTMLURL(),
+		Title:      issue.GetTitle(),
+		Stars:      project.Stars,
+		Category:   project.Category,
+		TotalScore: result.Score,
+		Grade:      scoreGrade(result.Score),
+		Confidence: result.Confidence,
+		SignOff:    string(signOff),
+		Breakdown: ScoreComponents{
+			Stars:      result.Factors["stars_factor"],
+			Comments:   result.Factors["comments_factor"],
+			Recency:    result.Factors["recency_factor"],
+			Labels:     result.Factors["labels_factor"],
+			Difficulty: result.Factors["difficulty_factor"],
+			Keywords:   result.Keywords,
+			Bonus:      result.Bonus,
+			Penalty:    result.Penalty,
+			SignOff:    result.SignOff,
+		},
+		Weights:        NewIssueScorer().weights,
+		MaxScore:       maxIssueScore,
+		ReadingMinutes: EstimateReadingTime(issue).Minutes,
+	}
+	if project.Org != "" {
+		resp.Project = project.Org + "/" + project.Name
+	}
+	return resp
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestHandleScore(t *testing.T) {
+	created := time.Now().Add(-48 * time.Hour)
+	api := &fakeGitHubAPI{
+		issues: map[string]*github.Issue{
+			"golang/go#77519": {
+				Number:    github.Int(77519),
+				Title:     github.String("Fix panic in config loader"),
+				HTMLURL:   github.String("https://github.com/golang/go/issues/77519"),
+				Comments:  github.Int(1),
+				CreatedAt: &github.Timestamp{Time: created},
+				Labels:    []*github.Label{{Name: github.String("good first issue")}},
+			},
+		},
+		repos: map[string]*github.Repository{"golang/go": {StargazersCount: github.Int(120000)}},
+	}
+	server := &MCPServer{client: api, config: &Config{Scoring: loadScoringConfigFromEnv()}}
+	handler := withScoreAPIToken("", true, server.handleScore)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		wantCode       int
+		wantSource     string
+		wantStars      int
+		wantStarsKnown bool
+	}{
+		{name: "issue url", method: http.MethodPost, body: `{"url": "https://github.com/golang/go/issues/77519"}`, wantCode: http.StatusOK, wantSource: "url", wantStars: 120000, wantStarsKnown: true},
+		{name: "wrapped issue json with stars", method: http.MethodPost, body: `{"issue": {"title": "Add retries", "html_url": "https://github.com/golang/go/issues/1", "comments": 3}, "stars": 50}`, wantCode: http.StatusOK, wantSource: "json", wantStars: 50, wantStarsKnown: true},
+		{name: "unknown repo leaves stars unknown", method: http.MethodPost, body: `{"issue": {"title": "Add retries", "html_url": "https://github.com/acme/tool/issues/1"}}`, wantCode: http.StatusOK, wantSource: "json"},
+		{name: "raw issue json", method: http.MethodPost, body: `{"title": "Docs typo", "labels": [{"name": "documentation"}]}`, wantCode: http.StatusOK, wantSource: "json"},
+		{name: "unknown issue", method: http.MethodPost, body: `{"url": "https://github.com/golang/go/issues/2"}`, wantCode: http.StatusBadRequest},
+		{name: "empty object", method: http.MethodPost, body: `{}`, wantCode: http.StatusBadRequest},
+		{name: "not json", method: http.MethodPost, body: `nope`, wantCode: http.StatusBadRequest},
+		{name: "get", method: http.MethodGet, wantCode: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler(rec, httptest.NewRequest(tt.method, "/score", strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantCode, rec.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var resp ScoreResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("invalid response JSON: %v", err)
+			}
+			if resp.Source != tt.wantSource || resp.Stars != tt.wantStars || resp.StarsKnown != tt.wantStarsKnown {
+				t.Errorf("source/stars/known = %s/%d/%v, want %s/%d/%v", resp.Source, resp.Stars, resp.StarsKnown, tt.wantSource, tt.wantStars, tt.wantStarsKnown)
+			}
+			if resp.TotalScore <= 0 || resp.Grade == "" || resp.Confidence <= 0 || resp.Weights["labels_factor"] == 0 {
+				t.Errorf("incomplete breakdown: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestHandleScore_MatchesPipeline(t *testing.T) {
+	issue := &github.Issue{
+		Number:    github.Int(1),
+		Title:     github.String("Easy quick simple trivial docs fix for tls"),
+		Body:      github.String("Steps to reproduce: see the documentation in package http"),
+		HTMLURL:   github.String("https://github.com/golang/go/issues/1"),
+		Comments:  github.Int(0),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+		Labels:    []*github.Label{{Name: github.String("confirmed")}},
+	}
+	api := &fakeGitHubAPI{
+		issues: map[string]*github.Issue{"golang/go#1": issue},
+		repos:  map[string]*github.Repository{"golang/go": {StargazersCount: github.Int(120000)}},
+	}
+	server := &MCPServer{client: api}
+
+	resp, err := server.scoreRequest(context.Background(), &ScoreRequest{URL: issue.GetHTMLURL()})
+	if err != nil {
+		t.Fatalf("scoreRequest: %v", err)
+	}
+
+	want := NewIssueScorer().ScoreIssueWithConfidence(issue, Project{Org: "golang", Name: "go", Stars: 120000})
+	// Recency moves with the clock between the two calls
+	if math.Abs(resp.TotalScore-want.Score) > 1e-6 || math.Abs(resp.Confidence-want.Confidence) > 1e-6 {
+		t.Errorf("score/confidence = %.3f/%.3f, want pipeline %.3f/%.3f", resp.TotalScore, resp.Confidence, want.Score, want.Confidence)
+	}
+	if math.Abs(resp.Breakdown.Keywords-want.Keywords) > 1e-9 || resp.Confidence >= 1 {
+		t.Errorf("keywords = %.3f (confidence %.2f), want %.3f from the capped keyword score", resp.Breakdown.Keywords, resp.Confidence, want.Keywords)
+	}
+}
+
+func TestWithScoreAPIToken(t *testing.T) {
+	next := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		loopback bool
+		want     int
+	}{
+		{name: "loopback without token", loopback: true, want: http.StatusNoContent},
+		{name: "public without token", loopback: false, want: http.StatusForbidden},
+	} {
+		rec := httptest.NewRecorder()
+		withScoreAPIToken("", tt.loopback, next)(rec, httptest.NewRequest(http.MethodPost, "/score", nil))
+		if rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.want)
+		}
+	}
+
+	handler := withScoreAPIToken("secret", false, next)
+
+	tests := []struct {
+		auth string
+		want int
+	}{
+		{auth: "", want: http.StatusUnauthorized},
+		{auth: "Bearer wrong", want: http.StatusUnauthorized},
+		{auth: "Bearer secret", want: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/score", nil)
+		if tt.auth != "" {
+			req.Header.Set("Authorization", tt.auth)
+		}
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("Authorization %q: status = %d, want %d", tt.auth, rec.Code, tt.want)
+		}
+	}
+}
+
+func TestIsLoopbackHost(t *testing.T) {
+	for host, want := range map[string]bool{
+		"127.0.0.1": true,
+		"::1":       true,
+		"localhost": true,
+		"":          false,
+		"0.0.0.0":   false,
+		"10.0.0.5":  false,
+	} {
+		if got := isLoopbackHost(host); got != want {
+			t.Errorf("isLoopbackHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Keyword categories group the text heuristics in ScoreIssue. Each category
+// is capped, so piling related words into a title or body cannot add more
+// than its cap.
+const (
+	KeywordTopic        = "topic"        // Go version, upgrade, TLS mentions
+	KeywordFriendliness = "friendliness" // "good first issue", "docs", "easy" in text
+	KeywordClarity      = "clarity"      // scope hints and reproduction steps
+)
+
+var defaultKeywordCaps = map[string]float64{
+	KeywordTopic:        0.25,
+	KeywordFriendliness: 0.20,
+	KeywordClarity:      0.15,
+}
+
+const defaultKeywordDecay = 0.5
+
+// parseKeywordCaps reads "topic=0.25,clarity=0.1" on top of the defaults.
+func parseKeywordCaps(spec string) (map[string]float64, error) {
+	caps := make(map[string]float64, len(defaultKeywordCaps))
+	for category, limit := range defaultKeywordCaps {
+		caps[category] = limit
+	}
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		category, value, ok := strings.Cut(field, "=")
+		category = strings.ToLower(strings.TrimSpace(category))
+		if _, known := defaultKeywordCaps[category]; !ok || !known {
+			return nil, fmt.Errorf("invalid keyword cap %q (categories: topic, friendliness, clarity)", field)
+		}
+		limit, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid keyword cap %q", field)
+		}
+		caps[category] = limit
+	}
+	return caps, nil
+}
+
+func loadKeywordCapsFromEnv(config *ScoringConfig) {
+	config.KeywordCaps = defaultKeywordCaps
+	config.KeywordDecay = defaultKeywordDecay
+
+	if spec := os.Getenv("SCORING_KEYWORD_CAPS"); spec != "" {
+		if caps, err := parseKeywordCaps(spec); err == nil {
+			config.KeywordCaps = caps
+		}
+	}
+
+	if decay := os.Getenv("SCORING_KEYWORD_DECAY"); decay != "" {
+		if val, err := strconv.ParseFloat(decay, 64); err == nil && val >= 0 && val <= 1 {
+			config.KeywordDecay = val
+		}
+	}
+}
+
+// scoreSignals collects the positive parts of a score by kind. Base and
+// strong signals come from metadata and labels, which only maintainers
+// control; keyword hits come from text anyone can write.
+type scoreSignals struct {
+	base     float64
+	strong   float64
+	keywords map[string][]float64
+}
+
+func (s *scoreSignals) addStrong(v float64) {
+	s.strong += v
+}
+
+func (s *scoreSignals) addKeyword(category string, v float64) {
+	if s.keywords == nil {
+		s.keywords = make(map[string][]float64)
+	}
+	s.keywords[category] = append(s.keywords[category], v)
+}
+
+// keywordScore sums each category with diminishing returns, largest hit
+// first at full value and each further hit multiplied by decay again, then
+// caps the category. A missing config uses the defaults.
+func (s *scoreSignals) keywordScore(config *ScoringConfig) float64 {
+	caps, decay := defaultKeywordCaps, defaultKeywordDecay
+	if config != nil && config.KeywordCaps != nil {
+		caps, decay = config.KeywordCaps, config.KeywordDecay
+	}
+
+	var total float64
+	for category, hits := range s.keywords {
+		sorted := append([]float64(nil), hits...)
+		sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+
+		var sum float64
+		factor := 1.0
+		for _, hit := range sorted {
+			sum += hit * factor
+			factor *= decay
+		}
+		if limit, ok := caps[category]; ok && sum > limit {
+			sum = limit
+		}
+		total += sum
+	}
+	return total
+}
+
+// confidence is the share of the positive score that does not come from
+// keyword hits, 1 when nothing positive was found.
+func (s *scoreSignals) confidence(keywords float64) float64 {
+	positive := s.base + s.strong + keywords
+	if positive <= 0 {
+		return 1
+	}
+	return 1 - keywords/positive
+}
+
+// ScoreResult is a score with the share that rests on text heuristics. The
+// score would fall to Low if every keyword hit turned out to be misleading.
+type ScoreResult struct {
+	Score      float64
+	Keywords   float64
+	Confidence float64
+
+	// Factors are the weighted base factors by weight name, Bonus the label
+	// and project bonuses, and Penalty what was subtracted before clamping.
+	// SignOff is the pipeline's sign-off adjustment, already in Score.
+	Factors map[string]float64
+	Bonus   float64
+	Penalty float64
+	SignOff float64
+}
+
+func (r ScoreResult) Low() float64 {
+	if low := r.Score - r.Keywords; low > 0 {
+		return low
+	}
+	return 0
+}
+
+// confidenceLabel buckets a confidence for display.
+func confidenceLabel(confidence float64) string {
+	switch {
+	case confidence >= 0.8:
+		return T("confidence.high")
+	case confidence >= 0.6:
+		return T("confidence.medium")
+	}
+	return T("confidence.low")
+}
+
+// formatConfidence renders "82% high, 0.70–0.85" for issues whose score
+// includes keyword hits, and "" for the rest.
+func formatConfidence(issue Issue) string {
+	if issue.KeywordScore <= 0 {
+		return ""
+	}
+	r := ScoreResult{Score: issue.Score, Keywords: issue.KeywordScore, Confidence: issue.Confidence}
+	return fmt.Sprintf("%.0f%% %s, %.2f–%.2f", r.Confidence*100, confidenceLabel(r.Confidence), r.Low(), r.Score)
+}
+
+// scoreConfidence is the issue's confidence, 1 for issues scored without
+// keyword hits or outside the main scorer.
+func (i Issue) scoreConfidence() float64 {
+	if i.KeywordScore <= 0 {
+		return 1
+	}
+	return i.Confidence
+}
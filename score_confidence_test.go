@@ -0,0 +1,128 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func TestKeywordScore(t *testing.T) {
+	config := &ScoringConfig{
+		KeywordCaps:  map[string]float64{KeywordTopic: 0.25, KeywordClarity: 0.15},
+		KeywordDecay: 0.5,
+	}
+
+	tests := []struct {
+		name string
+		hits map[string][]float64
+		want float64
+	}{
+		{"no hits", nil, 0},
+		{"single hit under cap", map[string][]float64{KeywordClarity: {0.10}}, 0.10},
+		{"repeated hits diminish", map[string][]float64{KeywordClarity: {0.05, 0.10}}, 0.125},
+		{"single hit capped", map[string][]float64{KeywordTopic: {0.30}}, 0.25},
+		{"categories capped separately", map[string][]float64{KeywordTopic: {0.30, 0.15}, KeywordClarity: {0.10, 0.10, 0.10}}, 0.40},
+		{"uncapped category", map[string][]float64{"other": {0.20, 0.20}}, 0.30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signals := scoreSignals{keywords: tt.hits}
+			if got := signals.keywordScore(config); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("keywordScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKeywordCaps(t *testing.T) {
+	caps, err := parseKeywordCaps("topic=0.1, clarity=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caps[KeywordTopic] != 0.1 || caps[KeywordClarity] != 0 || caps[KeywordFriendliness] != defaultKeywordCaps[KeywordFriendliness] {
+		t.Errorf("caps = %v", caps)
+	}
+	if defaultKeywordCaps[KeywordTopic] != 0.25 {
+		t.Error("parsing must not modify the defaults")
+	}
+
+	for _, spec := range []string{"topic", "colour=0.1", "topic=-1", "topic=x"} {
+		if _, err := parseKeywordCaps(spec); err == nil {
+			t.Errorf("parseKeywordCaps(%q) should fail", spec)
+		}
+	}
+}
+
+func TestScoreIssueKeywordStuffing(t *testing.T) {
+	scorer := NewIssueScorer()
+	project := Project{Org: "example", Name: "tool", Category: "Go Tools", Stars: 500}
+	newIssue := func(title, body string, labels ...string) *github.Issue {
+		return &github.Issue{
+			Title:     github.String(title),
+			Body:      github.String(body),
+			Comments:  github.Int(5),
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-10 * 24 * time.Hour)},
+			Labels:    convertLabels(labels),
+		}
+	}
+
+	plain := scorer.ScoreIssueWithConfidence(newIssue("Crash on start", "It crashes."), project)
+	stuffed := scorer.ScoreIssueWithConfidence(newIssue(
+		"Go 1.26 upgrade: good first issue, help wanted, easy docs fix",
+		"Simple TLS certificate typo in the documentation. Steps to reproduce: in the package, method Foo."), project)
+
+	if plain.Keywords != 0 || plain.Confidence != 1 {
+		t.Errorf("plain issue: keywords %v, confidence %v", plain.Keywords, plain.Confidence)
+	}
+	maxKeywords := defaultKeywordCaps[KeywordTopic] + defaultKeywordCaps[KeywordFriendliness] + defaultKeywordCaps[KeywordClarity]
+	if stuffed.Keywords > maxKeywords+1e-9 {
+		t.Errorf("keywords contributed %v, above the sum of caps %v", stuffed.Keywords, maxKeywords)
+	}
+	if stuffed.Confidence >= 0.8 {
+		t.Errorf("text-only score should have low confidence, got %v", stuffed.Confidence)
+	}
+
+	labeled := scorer.ScoreIssueWithConfidence(newIssue("Crash on start", "It crashes.", "good first issue", "help wanted", "triage/accepted"), project)
+	if labeled.Keywords != 0 || labeled.Confidence != 1 {
+		t.Errorf("labeled issue: keywords %v, confidence %v", labeled.Keywords, labeled.Confidence)
+	}
+	if labeled.Score <= stuffed.Score {
+		t.Errorf("labels (%v) should outscore keywords (%v)", labeled.Score, stuffed.Score)
+	}
+}
+
+func TestEnhancedScorerKeywordStuffing(t *testing.T) {
+	scorer := NewEnhancedScorer()
+	project := Project{Org: "example", Name: "tool", Category: "Go Tools", Stars: 500}
+	issue := &github.Issue{
+		Title: github.String("Easy beginner docs fix: TLS certificate typo, good first issue"),
+		Body: github.String("Steps to reproduce: run it. Expected behavior: no crash. " +
+			"Acceptance criteria: simple, easy, newcomer friendly. Only in this file, small change."),
+		Comments:  github.Int(2),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-5 * 24 * time.Hour)},
+	}
+
+	breakdown := scorer.ScoreIssueWithBreakdown(issue, project, nil)
+	maxKeywords := defaultKeywordCaps[KeywordTopic] + defaultKeywordCaps[KeywordFriendliness] + defaultKeywordCaps[KeywordClarity]
+	if breakdown.KeywordScore <= 0 || breakdown.KeywordScore > maxKeywords+1e-9 {
+		t.Errorf("keywords contributed %v, want within (0, %v]", breakdown.KeywordScore, maxKeywords)
+	}
+	if breakdown.Confidence >= 1 {
+		t.Errorf("text-only hits should lower confidence, got %v", breakdown.Confidence)
+	}
+}
+
+func TestFormatConfidence(t *testing.T) {
+	if got := formatConfidence(Issue{Score: 0.8}); got != "" {
+		t.Errorf("issue without keywords = %q, want empty", got)
+	}
+	issue := Issue{Score: 0.8, KeywordScore: 0.2, Confidence: 0.75}
+	if got, want := formatConfidence(issue), "75% medium, 0.60–0.80"; got != want {
+		t.Errorf("formatConfidence() = %q, want %q", got, want)
+	}
+	if got := (Issue{Score: 0.5}).scoreConfidence(); got != 1 {
+		t.Errorf("scoreConfidence() without keywords = %v, want 1", got)
+	}
+}
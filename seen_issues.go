@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+// Issues used to be deduplicated on "<repo>/<number>", which changes when an
+// issue is transferred or its repository renamed. The GitHub node ID does not,
+// so seen_issues now carries it and lookups match on either key.
+
+func (f *IssueFinder) migrateSeenIssues() error {
+	_, err := f.db.Exec(`
+	ALTER TABLE seen_issues ADD COLUMN IF NOT EXISTS node_id TEXT;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_seen_issues_node_id ON seen_issues(node_id);
+	`)
+	return err
+}
+
+// isIssueSeen reports whether an issue was seen under its node ID or its
+// legacy name/number key. It only reads the in-memory sets; rekeySeenIssue
+// brings the stored row up to date.
+func (f *IssueFinder) isIssueSeen(issueID, nodeID string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.seenIssues[issueID] || (nodeID != "" && f.seenNodes[nodeID])
+}
+
+// rekeySeenIssue is called for an issue isIssueSeen matched. When only one
+// of its keys matched, the row predates node IDs or the issue was
+// transferred, and markIssueSeen merges it onto both keys.
+func (f *IssueFinder) rekeySeenIssue(issueID, nodeID, projectName string) {
+	if nodeID == "" {
+		return
+	}
+	f.mu.RLock()
+	byKey, byNode := f.seenIssues[issueID], f.seenNodes[nodeID]
+	f.mu.RUnlock()
+	if byKey && byNode {
+		return
+	}
+	if err := f.markIssueSeen(issueID, nodeID, projectName); err != nil {
+		log.Printf("Warning: failed to update seen issue %s (%s): %v", issueID, nodeID, err)
+	}
+}
+
+// markIssueSeen upserts the seen row for an issue. With a node ID, the row
+// holding that node is moved to the current key; a row already under that
+// key is a legacy row for the same issue, or a stale one left by an issue
+// that moved away, and is folded in so the unique issue_id does not clash.
+func (f *IssueFinder) markIssueSeen(issueID, nodeID, projectName string) error {
+	f.mu.Lock()
+	f.seenIssues[issueID] = true
+	if nodeID != "" {
+		f.seenNodes[nodeID] = true
+	}
+	f.mu.Unlock()
+
+	now := time.Now()
+	if nodeID == "" {
+		_, err := f.db.Exec(`
+			INSERT INTO seen_issues (issue_id, project_name, first_seen, last_notified)
+			VALUES ($1, $2, $3, $3)
+			ON CONFLICT (issue_id) DO UPDATE SET last_notified = $3
+		`, issueID, projectName, now)
+		return err
+	}
+
+	tx, err := f.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var rows []struct {
+		IssueID string `db:"issue_id"`
+		NodeID  string `db:"node_id"`
+	}
+	if err := tx.Select(&rows, `
+		SELECT issue_id, COALESCE(node_id, '') AS node_id FROM seen_issues WHERE issue_id = $1 OR node_id = $2 FOR UPDATE
+	`, issueID, nodeID); err != nil {
+		return err
+	}
+
+	var hasKey, hasNode bool
+	for _, row := range rows {
+		hasKey = hasKey || row.IssueID == issueID
+		hasNode = hasNode || row.NodeID == nodeID
+	}
+
+	switch {
+	case hasNode:
+		if hasKey {
+			_, err = tx.Exec(`DELETE FROM seen_issues WHERE issue_id = $1 AND node_id IS DISTINCT FROM $2`, issueID, nodeID)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Exec(`
+			UPDATE seen_issues SET issue_id = $1, project_name = $2, last_notified = $3 WHERE node_id = $4
+		`, issueID, projectName, now, nodeID)
+	case hasKey:
+		_, err = tx.Exec(`
+			UPDATE seen_issues SET node_id = $2, project_name = $3, last_notified = $4 WHERE issue_id = $1
+		`, issueID, nodeID, projectName, now)
+	default:
+		_, err = tx.Exec(`
+			INSERT INTO seen_issues (issue_id, node_id, project_name, first_seen, last_notified)
+			VALUES ($1, $2, $3, $4, $4)
+		`, issueID, nodeID, projectName, now)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// lookupSeenIssue returns when an issue was first seen, matching its node ID
+// or its legacy key. sql.ErrNoRows means it was never seen.
+func lookupSeenIssue(db *sqlx.DB, issueID, nodeID string) (time.Time, error) {
+	var firstSeen time.Time
+	err := db.Get(&firstSeen, `
+		SELECT first_seen FROM seen_issues WHERE issue_id = $1 OR node_id = NULLIF($2, '') ORDER BY first_seen LIMIT 1
+	`, issueID, nodeID)
+	return firstSeen, err
+}
+
+// backfillSeenNodeIDs looks up node IDs for seen_issues rows that predate
+// them. Rows whose project is no longer known, or whose issue cannot be
+// fetched, are left alone and reported as skipped. GitHub redirects
+// transferred issues, so those rows also pick up their new key.
+func (f *IssueFinder) backfillSeenNodeIDs(ctx context.Context, limit int) (updated, skipped int, err error) {
+	var rows []struct {
+		IssueID     string `db:"issue_id"`
+		ProjectName string `db:"project_name"`
+	}
+	query := `SELECT issue_id, project_name FROM seen_issues WHERE node_id IS NULL ORDER BY first_seen DESC`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if err := f.db.Select(&rows, query); err != nil {
+		return 0, 0, err
+	}
+
+	orgs := make(map[string]string)
+	for _, p := range f.projects {
+		if _, ok := orgs[p.Name]; !ok {
+			orgs[p.Name] = p.Org
+		}
+	}
+
+	for _, row := range rows {
+		org, ok := orgs[row.ProjectName]
+		number, convErr := strconv.Atoi(row.IssueID[strings.LastIndex(row.IssueID, "/")+1:])
+		if !ok || convErr != nil {
+			skipped++
+			continue
+		}
+
+		var issue *github.Issue
+		fetchErr := f.rateLimiter.executeWithRetry(ctx, fmt.Sprintf("backfill node ID for %s/%s#%d", org, row.ProjectName, number), func() (*github.Response, error) {
+			var apiErr error
+			var resp *github.Response
+			issue, resp, apiErr = f.client.GetIssue(ctx, org, row.ProjectName, number)
+			return resp, apiErr
+		})
+		if fetchErr != nil || issue.GetNodeID() == "" {
+			skipped++
+			continue
+		}
+
+		issueID, projectName := row.IssueID, row.ProjectName
+		if _, repo, n, parseErr := ParseIssueURL(issue.GetHTMLURL()); parseErr == nil {
+			issueID, projectName = fmt.Sprintf("%s/%d", repo, n), repo
+		}
+
+		if _, err := f.db.Exec(`
+			UPDATE seen_issues SET node_id = $2, issue_id = $3, project_name = $4 WHERE issue_id = $1
+		`, row.IssueID, issue.GetNodeID(), issueID, projectName); err != nil {
+			log.Printf("Warning: failed to backfill node ID for %s: %v", row.IssueID, err)
+			skipped++
+			continue
+		}
+		updated++
+	}
+
+	return updated, skipped, nil
+}
+
+func runMigrateSeenCommand(args []string) error {
+	limit := 0
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--limit" && i+1 < len(args) {
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --limit value %q", args[i+1])
+			}
+			limit = n
+			i++
+		}
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	finder, ok := server.finder.(*IssueFinder)
+	if !ok {
+		return fmt.Errorf("migrate-seen needs the database-backed issue finder")
+	}
+
+	fmt.Fprintln(stdout, T("migrate.seen_running"))
+	updated, skipped, err := finder.backfillSeenNodeIDs(context.Background(), limit)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, T("migrate.seen_done", updated, skipped))
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestIsIssueSeen(t *testing.T) {
+	f := &IssueFinder{
+		seenIssues: map[string]bool{"kubernetes/100": true},
+		seenNodes:  map[string]bool{"I_kwDOAAAA": true},
+	}
+
+	if f.isIssueSeen("kubernetes/200", "I_kwDOBBBB") {
+		t.Error("unknown key and node should not be seen")
+	}
+	if !f.isIssueSeen("kubernetes/100", "") {
+		t.Error("legacy key without a node ID should still be seen")
+	}
+
+	f.seenIssues["kubectl/7"] = true
+	f.seenNodes["I_kwDOCCCC"] = true
+	if !f.isIssueSeen("kubectl/7", "I_kwDOCCCC") {
+		t.Error("issue known under both keys should be seen")
+	}
+}
+
+func newSeenTestFinder(t *testing.T) (*IssueFinder, *fakeSQL) {
+	fake, db := newFakeSQL(t)
+	return &IssueFinder{db: db, seenIssues: map[string]bool{}, seenNodes: map[string]bool{}}, fake
+}
+
+func TestIsIssueSeen_ReadOnly(t *testing.T) {
+	f, _ := newSeenTestFinder(t)
+	f.seenIssues["kubernetes/100"] = true
+
+	// The fake fails the test on any statement.
+	if !f.isIssueSeen("kubernetes/100", "I_kwDONEW") {
+		t.Error("legacy key hit should be seen")
+	}
+	if f.seenNodes["I_kwDONEW"] {
+		t.Error("isIssueSeen should not fill in the node ID")
+	}
+}
+
+func TestMarkIssueSeen_Transfer(t *testing.T) {
+	f, fake := newSeenTestFinder(t)
+	f.seenNodes["I_kwDOMOVED"] = true
+
+	// The issue moved from kubectl#7 to kubernetes#12, and kubernetes/12 also
+	// has a row from before node IDs.
+	fake.expect("SELECT issue_id", "kubernetes/12", "I_kwDOMOVED").returns(
+		[]string{"issue_id", "node_id"},
+		[]driver.Value{"kubectl/7", "I_kwDOMOVED"},
+		[]driver.Value{"kubernetes/12", ""},
+	)
+	fake.expect("DELETE FROM seen_issues WHERE issue_id = $1 AND node_id IS DISTINCT FROM $2", "kubernetes/12", "I_kwDOMOVED").affects(1)
+	fake.expect("UPDATE seen_issues SET issue_id = $1", "kubernetes/12", "kubernetes", fakeSQLAnyArg{}, "I_kwDOMOVED").affects(1)
+
+	f.rekeySeenIssue("kubernetes/12", "I_kwDOMOVED", "kubernetes")
+
+	if !f.seenIssues["kubernetes/12"] {
+		t.Error("new key should be marked seen")
+	}
+}
+
+func TestMarkIssueSeen_LegacyRow(t *testing.T) {
+	f, fake := newSeenTestFinder(t)
+
+	fake.expect("SELECT issue_id", "kubernetes/100", "I_kwDOLEGACY").returns(
+		[]string{"issue_id", "node_id"},
+		[]driver.Value{"kubernetes/100", ""},
+	)
+	fake.expect("UPDATE seen_issues SET node_id = $2", "kubernetes/100", "I_kwDOLEGACY", "kubernetes", fakeSQLAnyArg{}).affects(1)
+
+	if err := f.markIssueSeen("kubernetes/100", "I_kwDOLEGACY", "kubernetes"); err != nil {
+		t.Fatalf("markIssueSeen() error: %v", err)
+	}
+}
+
+func TestMarkIssueSeen_New(t *testing.T) {
+	f, fake := newSeenTestFinder(t)
+
+	fake.expect("SELECT issue_id", "kubernetes/300", "I_kwDONEW").returns([]string{"issue_id", "node_id"})
+	fake.expect("INSERT INTO seen_issues (issue_id, node_id", "kubernetes/300", "I_kwDONEW", "kubernetes", fakeSQLAnyArg{}).affects(1)
+
+	if err := f.markIssueSeen("kubernetes/300", "I_kwDONEW", "kubernetes"); err != nil {
+		t.Fatalf("markIssueSeen() error: %v", err)
+	}
+
+	// Known under both keys now, so there is nothing to re-key.
+	f.rekeySeenIssue("kubernetes/300", "I_kwDONEW", "kubernetes")
+}
+
+func TestLookupSeenIssue_MatchesNodeID(t *testing.T) {
+	fake, db := newFakeSQL(t)
+	firstSeen := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	fake.expect("SELECT first_seen FROM seen_issues WHERE issue_id = $1 OR node_id", "kubernetes/12", "I_kwDOMOVED").returns(
+		[]string{"first_seen"}, []driver.Value{firstSeen},
+	)
+
+	got, err := lookupSeenIssue(db, "kubernetes/12", "I_kwDOMOVED")
+	if err != nil || !got.Equal(firstSeen) {
+		t.Errorf("lookupSeenIssue() = %v, %v; want %v", got, err, firstSeen)
+	}
+}
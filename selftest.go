@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/jmoiron/sqlx"
+)
+
+type SelfTestStatus string
+
+const (
+	SelfTestPass SelfTestStatus = "pass"
+	SelfTestFail SelfTestStatus = "fail"
+	SelfTestSkip SelfTestStatus = "skip"
+)
+
+type SelfTestStep struct {
+	Name   string
+	Status SelfTestStatus
+	Detail string
+}
+
+// SelfTest exercises the pipeline end to end against a sandbox repository the
+// token owner controls, so nothing is ever written to upstream projects.
+type SelfTest struct {
+	api         GitHubAPI
+	db          *sqlx.DB
+	dbErr       error
+	owner       string
+	repo        string
+	issueNumber int
+	postComment bool
+}
+
+const selfTestCommentMarker = "<!-- github-issue-finder selftest -->"
+
+func (t *SelfTest) Run(ctx context.Context) []SelfTestStep {
+	var steps []SelfTestStep
+	add := func(name string, status SelfTestStatus, format string, args ...any) {
+		steps = append(steps, SelfTestStep{Name: name, Status: status, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	user, _, err := t.api.GetUser(ctx, "")
+	if err != nil {
+		add("credentials", SelfTestFail, "%v", err)
+		return steps
+	}
+	login := user.GetLogin()
+	add("credentials", SelfTestPass, "authenticated as %s", login)
+
+	if limits, _, err := t.api.RateLimits(ctx); err != nil {
+		add("rate limit", SelfTestFail, "%v", err)
+	} else {
+		core := limits.GetCore()
+		add("rate limit", SelfTestPass, "%d/%d core requests remaining", core.Remaining, core.Limit)
+	}
+
+	switch {
+	case t.dbErr != nil:
+		add("database", SelfTestFail, "%v", t.dbErr)
+	case t.db == nil:
+		add("database", SelfTestSkip, "DB_CONNECTION_STRING not set")
+	default:
+		if err := t.db.PingContext(ctx); err != nil {
+			add("database", SelfTestFail, "%v", err)
+		} else {
+			add("database", SelfTestPass, "connected")
+		}
+	}
+
+	fullName := t.owner + "/" + t.repo
+	sandboxOK := false
+	repoInfo, _, err := t.api.GetRepository(ctx, t.owner, t.repo)
+	switch {
+	case err != nil:
+		add("sandbox repo", SelfTestFail, "%s: %v", fullName, err)
+	case !strings.EqualFold(repoInfo.GetOwner().GetLogin(), login) && !repoInfo.GetPermissions()["admin"]:
+		add("sandbox repo", SelfTestFail, "%s is not owned or administered by %s; refusing to use it as a sandbox", fullName, login)
+	default:
+		sandboxOK = true
+		add("sandbox repo", SelfTestPass, "%s", fullName)
+	}
+
+	var issue *github.Issue
+	if t.issueNumber > 0 {
+		issue, _, err = t.api.GetIssue(ctx, t.owner, t.repo, t.issueNumber)
+		if err != nil {
+			add("fetch", SelfTestFail, "issue #%d: %v", t.issueNumber, err)
+		}
+	} else {
+		issues, _, listErr := t.api.ListRepoIssues(ctx, t.owner, t.repo, &github.IssueListByRepoOptions{
+			State:       "open",
+			ListOptions: github.ListOptions{PerPage: 10},
+		})
+		err = listErr
+		if err != nil {
+			add("fetch", SelfTestFail, "%v", err)
+		}
+		for _, candidate := range issues {
+			if !candidate.IsPullRequest() {
+				issue = candidate
+				break
+			}
+		}
+		if err == nil && issue == nil {
+			add("fetch", SelfTestFail, "no open issues in %s; open one to test against", fullName)
+		}
+	}
+	if issue == nil {
+		add("score", SelfTestSkip, "no issue fetched")
+		add("comment preview", SelfTestSkip, "no issue fetched")
+		add("comment write", SelfTestSkip, "no issue fetched")
+		return steps
+	}
+	add("fetch", SelfTestPass, "#%d %s", issue.GetNumber(), truncateString(issue.GetTitle(), 50))
+
+	project := Project{Org: t.owner, Name: t.repo, Stars: repoInfo.GetStargazersCount()}
+	scored := NewIssueScorer().ScoreIssueWithConfidence(issue, project)
+	add("score", SelfTestPass, "%.2f (%s)", scored.Score, scoreGrade(scored.Score))
+
+	comment, err := NewSmartCommentGenerator().GenerateSmartComment(IssueDetails{
+		Title:        issue.GetTitle(),
+		Body:         issue.GetBody(),
+		Labels:       getLabelNames(issue.Labels),
+		Number:       issue.GetNumber(),
+		URL:          issue.GetHTMLURL(),
+		ProjectOwner: t.owner,
+		ProjectName:  t.repo,
+		Author:       issue.GetUser().GetLogin(),
+		CreatedAt:    issue.GetCreatedAt().Time,
+		Comments:     issue.GetComments(),
+		HasAssignee:  len(issue.Assignees) > 0,
+	})
+	if err != nil {
+		add("comment preview", SelfTestFail, "%v", err)
+	} else {
+		add("comment preview", SelfTestPass, "%d chars, quality %.2f", len(comment.Body), comment.Score)
+	}
+
+	switch {
+	case !t.postComment:
+		add("comment write", SelfTestSkip, "pass --comment to post and delete a real comment")
+	case !sandboxOK:
+		add("comment write", SelfTestSkip, "sandbox repo check failed")
+	default:
+		status, detail := t.writeAndDeleteComment(ctx, issue.GetNumber())
+		add("comment write", status, "%s", detail)
+	}
+
+	return steps
+}
+
+// writeAndDeleteComment posts a marked comment, deletes it and confirms it is
+// gone, which covers the write scope of the token.
+func (t *SelfTest) writeAndDeleteComment(ctx context.Context, number int) (SelfTestStatus, string) {
+	body := selfTestCommentMarker + "\nSelf-test comment from github-issue-finder (run " + runID + "); it is deleted right away."
+	created, _, err := t.api.CreateIssueComment(ctx, t.owner, t.repo, number, &github.IssueComment{Body: github.String(body)})
+	if err != nil {
+		return SelfTestFail, fmt.Sprintf("create: %v", err)
+	}
+
+	if _, err := t.api.DeleteIssueComment(ctx, t.owner, t.repo, created.GetID()); err != nil {
+		return SelfTestFail, fmt.Sprintf("created comment %d but failed to delete it, remove it by hand: %v", created.GetID(), err)
+	}
+
+	// Only a 404 shows the delete went through.
+	_, resp, err := t.api.GetIssueComment(ctx, t.owner, t.repo, created.GetID())
+	switch {
+	case responseStatus(resp, err) == http.StatusNotFound:
+		return SelfTestPass, fmt.Sprintf("created and deleted comment %d", created.GetID())
+	case err != nil:
+		return SelfTestFail, fmt.Sprintf("verify: %v", err)
+	}
+	return SelfTestFail, fmt.Sprintf("comment %d still present after delete", created.GetID())
+}
+
+func printSelfTestReport(steps []SelfTestStep) (failed int) {
+	fmt.Fprintf(stdout, "\n%s\n", T("selftest.title"))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+
+	table := NewTable(
+		TableColumn{Header: T("col.step")},
+		TableColumn{Header: T("col.status")},
+		TableColumn{Header: T("col.detail"), Flex: true},
+	)
+	passed, skipped := 0, 0
+	for _, step := range steps {
+		color := colorGreen
+		switch step.Status {
+		case SelfTestPass:
+			passed++
+		case SelfTestFail:
+			failed++
+			color = colorRed
+		case SelfTestSkip:
+			skipped++
+			color = colorYellow
+		}
+		table.AddCells(
+			TableCell{Text: step.Name},
+			TableCell{Text: string(step.Status), Color: color},
+			TableCell{Text: step.Detail},
+		)
+	}
+	table.Render(stdout)
+	fmt.Fprintln(stdout, T("selftest.summary", passed, failed, skipped))
+	return failed
+}
+
+func runSelfTestCommand(args []string) error {
+	test := &SelfTest{}
+	repo := os.Getenv("SELFTEST_REPO")
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--repo" && i+1 < len(args):
+			repo = args[i+1]
+			i++
+		case args[i] == "--issue" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid --issue value %q", args[i+1])
+			}
+			test.issueNumber = n
+			i++
+		case args[i] == "--comment":
+			test.postComment = true
+		}
+	}
+
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("selftest needs a sandbox repository: --repo owner/name or SELFTEST_REPO")
+	}
+	test.owner, test.repo = parts[0], parts[1]
+
+	config, err := LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if config.GitHubToken == "" {
+		return fmt.Errorf("GITHUB_TOKEN environment variable is required")
+	}
+
+	ctx := context.Background()
+	// No response cache: every request must really reach GitHub.
+	test.api = newGitHubClient(ctx, config.GitHubToken, nil)
+
+	if config.DBConnectionString != "" {
+		test.db, test.dbErr = sqlx.Connect("postgres", config.DBConnectionString)
+		if test.db != nil {
+			defer test.db.Close()
+		}
+	}
+
+	if failed := printSelfTestReport(test.Run(ctx)); failed > 0 {
+		return fmt.Errorf("self-test failed: %d step(s) failed", failed)
+	}
+	return nil
+}
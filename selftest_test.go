@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func selfTestAPI(owner string) *fakeGitHubAPI {
+	return &fakeGitHubAPI{
+		lists: map[string][]*github.Issue{
+			"tester/sandbox": {
+				{Number: github.Int(2), PullRequestLinks: &github.PullRequestLinks{}},
+				{
+					Number:    github.Int(1),
+					Title:     github.String("Panic when config file is missing"),
+					Body:      github.String("Running `app --config missing.yaml` panics in loader.go:42 with a nil pointer dereference."),
+					HTMLURL:   github.String("https://github.com/tester/sandbox/issues/1"),
+					Comments:  github.Int(0),
+					CreatedAt: &github.Timestamp{Time: time.Now()},
+					Labels:    []*github.Label{{Name: github.String("bug")}},
+				},
+			},
+		},
+		repos: map[string]*github.Repository{
+			"tester/sandbox": {Owner: &github.User{Login: github.String(owner)}},
+		},
+	}
+}
+
+func selfTestStatuses(steps []SelfTestStep) map[string]SelfTestStatus {
+	statuses := make(map[string]SelfTestStatus, len(steps))
+	for _, step := range steps {
+		statuses[step.Name] = step.Status
+	}
+	return statuses
+}
+
+func TestSelfTest_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		repoOwner   string
+		postComment bool
+		keepComment bool
+		wantSandbox SelfTestStatus
+		wantWrite   SelfTestStatus
+		wantCreated int
+	}{
+		{name: "preview only", repoOwner: "tester", wantSandbox: SelfTestPass, wantWrite: SelfTestSkip},
+		{name: "comment and delete", repoOwner: "tester", postComment: true, wantSandbox: SelfTestPass, wantWrite: SelfTestPass, wantCreated: 1},
+		{name: "delete did not apply", repoOwner: "tester", postComment: true, keepComment: true, wantSandbox: SelfTestPass, wantWrite: SelfTestFail, wantCreated: 1},
+		{name: "not my repo", repoOwner: "someone-else", postComment: true, wantSandbox: SelfTestFail, wantWrite: SelfTestSkip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := selfTestAPI(tt.repoOwner)
+			api.ignoreDeletes = tt.keepComment
+			test := &SelfTest{api: api, owner: "tester", repo: "sandbox", postComment: tt.postComment}
+
+			statuses := selfTestStatuses(test.Run(context.Background()))
+
+			for _, name := range []string{"credentials", "rate limit", "fetch", "score"} {
+				if statuses[name] != SelfTestPass {
+					t.Errorf("%s = %s, want pass", name, statuses[name])
+				}
+			}
+			if statuses["database"] != SelfTestSkip {
+				t.Errorf("database = %s, want skip without a connection", statuses["database"])
+			}
+			if statuses["sandbox repo"] != tt.wantSandbox {
+				t.Errorf("sandbox repo = %s, want %s", statuses["sandbox repo"], tt.wantSandbox)
+			}
+			if statuses["comment write"] != tt.wantWrite {
+				t.Errorf("comment write = %s, want %s", statuses["comment write"], tt.wantWrite)
+			}
+			if len(api.created) != tt.wantCreated {
+				t.Errorf("created %d comments, want %d", len(api.created), tt.wantCreated)
+			}
+			if wantDeleted := tt.wantCreated; !tt.keepComment && len(api.deleted) != wantDeleted {
+				t.Errorf("deleted %d comments, want %d", len(api.deleted), wantDeleted)
+			}
+		})
+	}
+}
+
+func TestSelfTest_NoIssues(t *testing.T) {
+	api := selfTestAPI("tester")
+	api.lists["tester/sandbox"] = nil
+	test := &SelfTest{api: api, owner: "tester", repo: "sandbox"}
+
+	statuses := selfTestStatuses(test.Run(context.Background()))
+	if statuses["fetch"] != SelfTestFail {
+		t.Errorf("fetch = %s, want fail for an empty sandbox", statuses["fetch"])
+	}
+	if statuses["score"] != SelfTestSkip || statuses["comment write"] != SelfTestSkip {
+		t.Errorf("dependent steps should be skipped: %v", statuses)
+	}
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SignOffRequirement is what a repository asks of contributors before a PR
+// can be merged. A CLA usually means a legal review, often by an employer; a
+// DCO only needs "git commit -s".
+type SignOffRequirement string
+
+const (
+	SignOffUnknown SignOffRequirement = ""
+	SignOffNone    SignOffRequirement = "none"
+	SignOffDCO     SignOffRequirement = "dco"
+	SignOffCLA     SignOffRequirement = "cla"
+)
+
+// Badge is shown next to issues in output; repos without a requirement get
+// none.
+func (r SignOffRequirement) Badge() string {
+	switch r {
+	case SignOffCLA:
+		return "✍️ CLA"
+	case SignOffDCO:
+		return "DCO"
+	}
+	return ""
+}
+
+type SignOffConfig struct {
+	Enabled       bool
+	CLAAdjustment float64
+	DCOAdjustment float64
+	CacheTTL      time.Duration
+}
+
+func loadSignOffConfigFromEnv() *SignOffConfig {
+	config := &SignOffConfig{
+		Enabled:       getEnvBool("SIGN_OFF_DETECTION_ENABLED", true),
+		CLAAdjustment: -0.1,
+		DCOAdjustment: 0,
+		CacheTTL:      time.Duration(getEnvInt("SIGN_OFF_CACHE_DAYS", 7)) * 24 * time.Hour,
+	}
+	// Parsed directly so that 0 can switch an adjustment off.
+	if v, err := strconv.ParseFloat(os.Getenv("SIGN_OFF_CLA_ADJUSTMENT"), 64); err == nil {
+		config.CLAAdjustment = v
+	}
+	if v, err := strconv.ParseFloat(os.Getenv("SIGN_OFF_DCO_ADJUSTMENT"), 64); err == nil {
+		config.DCOAdjustment = v
+	}
+	return config
+}
+
+func (c *SignOffConfig) Adjustment(r SignOffRequirement) float64 {
+	switch r {
+	case SignOffCLA:
+		return c.CLAAdjustment
+	case SignOffDCO:
+		return c.DCOAdjustment
+	}
+	return 0
+}
+
+// Files whose presence alone settles the question: CLA bots and the DCO app
+// are configured through them.
+var signOffMarkerFiles = map[string]SignOffRequirement{
+	".clabot":                   SignOffCLA,
+	".github/cla.yml":           SignOffCLA,
+	".github/workflows/cla.yml": SignOffCLA,
+	".github/dco.yml":           SignOffDCO,
+}
+
+var (
+	claTextPattern = regexp.MustCompile(`(?i)contributor license agreement|\bcla\b|cla-assistant|easycla|cla\.developers\.google\.com|cla\.linuxfoundation\.org`)
+	dcoTextPattern = regexp.MustCompile(`(?i)developer certificate of origin|\bdco\b|signed-off-by|git commit -s\b`)
+)
+
+// DetectSignOff decides the requirement from repository files, keyed by
+// path. Marker files only need to be present; CONTRIBUTING files are read.
+// A CLA wins over a DCO when both are mentioned, since it is the friction
+// that matters. The second result names the evidence.
+func DetectSignOff(files map[string]string) (SignOffRequirement, string) {
+	found, evidence := SignOffNone, ""
+	for path, requirement := range signOffMarkerFiles {
+		if _, ok := files[path]; !ok {
+			continue
+		}
+		if requirement == SignOffCLA {
+			return SignOffCLA, path
+		}
+		found, evidence = requirement, path
+	}
+
+	for path, content := range files {
+		if !isContributingFile(path) {
+			continue
+		}
+		if claTextPattern.MatchString(content) {
+			return SignOffCLA, path
+		}
+		if found == SignOffNone && dcoTextPattern.MatchString(content) {
+			found, evidence = SignOffDCO, path
+		}
+	}
+	return found, evidence
+}
+
+func isContributingFile(path string) bool {
+	name := strings.ToLower(path[strings.LastIndex(path, "/")+1:])
+	return strings.HasPrefix(name, "contributing")
+}
+
+// SignOffDetector looks up and caches each repository's requirement. It only
+// runs for repos that produced an accepted issue, costing a few content
+// requests per repo per CacheTTL.
+type SignOffDetector struct {
+	client GitHubAPI
+	db     *sqlx.DB
+	config *SignOffConfig
+	mu     sync.Mutex
+	cache  map[string]cachedSignOff
+}
+
+type cachedSignOff struct {
+	requirement SignOffRequirement
+	evidence    string
+	checkedAt   time.Time
+}
+
+func NewSignOffDetector(client GitHubAPI, db *sqlx.DB, config *SignOffConfig) *SignOffDetector {
+	if config == nil {
+		config = loadSignOffConfigFromEnv()
+	}
+
+	d := &SignOffDetector{
+		client: client,
+		db:     db,
+		config: config,
+		cache:  make(map[string]cachedSignOff),
+	}
+
+	if db != nil {
+		if err := d.initDB(); err != nil {
+			log.Printf("Warning: failed to initialize repo sign-off table: %v", err)
+		}
+	}
+
+	return d
+}
+
+func (d *SignOffDetector) initDB() error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS repo_sign_off (
+		repo VARCHAR(255) PRIMARY KEY,
+		requirement TEXT NOT NULL,
+		evidence TEXT NOT NULL DEFAULT '',
+		checked_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+	`
+	_, err := d.db.Exec(schema)
+	return err
+}
+
+// Requirement returns the repository's sign-off requirement, or
+// SignOffUnknown when detection is off or the lookup failed.
+func (d *SignOffDetector) Requirement(ctx context.Context, owner, repo string) SignOffRequirement {
+	if d == nil || !d.config.Enabled || d.client == nil {
+		return SignOffUnknown
+	}
+	key := owner + "/" + repo
+
+	d.mu.Lock()
+	cached, ok := d.cache[key]
+	d.mu.Unlock()
+	if ok && time.Since(cached.checkedAt) < d.config.CacheTTL {
+		return cached.requirement
+	}
+
+	if stored, ok := d.load(key); ok {
+		d.store(key, stored)
+		return stored.requirement
+	}
+
+	files, err := d.fetchFiles(ctx, owner, repo)
+	if err != nil {
+		log.Printf("[SignOff] Failed to check %s: %v", key, err)
+		// Cache the miss in memory only, so a failing repo is not retried
+		// for every issue in this run.
+		d.store(key, cachedSignOff{requirement: SignOffUnknown, checkedAt: time.Now()})
+		return SignOffUnknown
+	}
+
+	requirement, evidence := DetectSignOff(files)
+	entry := cachedSignOff{requirement: requirement, evidence: evidence, checkedAt: time.Now()}
+	d.store(key, entry)
+	d.save(key, entry)
+	if requirement != SignOffNone {
+		log.Printf("[SignOff] %s requires %s (%s)", key, strings.ToUpper(string(requirement)), evidence)
+	}
+	return requirement
+}
+
+// Adjustment is the configured score change for a requirement.
+func (d *SignOffDetector) Adjustment(r SignOffRequirement) float64 {
+	if d == nil {
+		return 0
+	}
+	return d.config.Adjustment(r)
+}
+
+// fetchFiles lists the root and .github directories, then reads any
+// CONTRIBUTING file found there. Marker files are recorded by presence only.
+func (d *SignOffDetector) fetchFiles(ctx context.Context, owner, repo string) (map[string]string, error) {
+	files := make(map[string]string)
+	var contributing []string
+
+	for _, dir := range []string{"", ".github", ".github/workflows"} {
+		_, entries, _, err := d.client.GetContents(ctx, owner, repo, dir, nil)
+		if err != nil {
+			if dir == "" {
+				return nil, err
+			}
+			continue // optional directories are often missing
+		}
+		for _, entry := range entries {
+			path := entry.GetPath()
+			if _, ok := signOffMarkerFiles[path]; ok {
+				files[path] = ""
+			}
+			if entry.GetType() == "file" && isContributingFile(path) {
+				contributing = append(contributing, path)
+			}
+		}
+	}
+
+	for _, path := range contributing {
+		file, _, _, err := d.client.GetContents(ctx, owner, repo, path, nil)
+		if err != nil {
+			continue
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			continue
+		}
+		files[path] = content
+	}
+	return files, nil
+}
+
+func (d *SignOffDetector) store(key string, entry cachedSignOff) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cache[key] = entry
+}
+
+func (d *SignOffDetector) load(key string) (cachedSignOff, bool) {
+	if d.db == nil {
+		return cachedSignOff{}, false
+	}
+
+	var row struct {
+		Requirement string    `db:"requirement"`
+		Evidence    string    `db:"evidence"`
+		CheckedAt   time.Time `db:"checked_at"`
+	}
+	err := d.db.Get(&row, `SELECT requirement, evidence, checked_at FROM repo_sign_off WHERE repo = $1`, key)
+	if err != nil || time.Since(row.CheckedAt) >= d.config.CacheTTL {
+		return cachedSignOff{}, false
+	}
+	return cachedSignOff{requirement: SignOffRequirement(row.Requirement), evidence: row.Evidence, checkedAt: row.CheckedAt}, true
+}
+
+func (d *SignOffDetector) save(key string, entry cachedSignOff) {
+	if d.db == nil {
+		return
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO repo_sign_off (repo, requirement, evidence, checked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (repo) DO UPDATE SET requirement = $2, evidence = $3, checked_at = $4
+	`, key, string(entry.requirement), entry.evidence, entry.checkedAt)
+	if err != nil {
+		log.Printf("[SignOff] Failed to save requirement for %s: %v", key, err)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectSignOff(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		want         SignOffRequirement
+		wantEvidence string
+	}{
+		{name: "nothing", files: map[string]string{}, want: SignOffNone},
+		{name: "cla bot config", files: map[string]string{".clabot": ""}, want: SignOffCLA, wantEvidence: ".clabot"},
+		{name: "dco app config", files: map[string]string{".github/dco.yml": ""}, want: SignOffDCO, wantEvidence: ".github/dco.yml"},
+		{
+			name:         "contributing mentions google cla",
+			files:        map[string]string{"CONTRIBUTING.md": "Contributions must be accompanied by a Contributor License Agreement. Visit https://cla.developers.google.com/."},
+			want:         SignOffCLA,
+			wantEvidence: "CONTRIBUTING.md",
+		},
+		{
+			name:         "contributing asks for signed-off-by",
+			files:        map[string]string{".github/CONTRIBUTING.md": "Please sign your commits with `git commit -s` so they carry a Signed-off-by line."},
+			want:         SignOffDCO,
+			wantEvidence: ".github/CONTRIBUTING.md",
+		},
+		{
+			name:         "cla beats dco",
+			files:        map[string]string{".github/dco.yml": "", "CONTRIBUTING.md": "You must sign the CLA via EasyCLA before we can merge."},
+			want:         SignOffCLA,
+			wantEvidence: "CONTRIBUTING.md",
+		},
+		{
+			name:  "unrelated words",
+			files: map[string]string{"CONTRIBUTING.md": "Run make test. Declare variables clearly; no circular imports."},
+			want:  SignOffNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, evidence := DetectSignOff(tt.files)
+			if got != tt.want || evidence != tt.wantEvidence {
+				t.Errorf("DetectSignOff() = %q, %q; want %q, %q", got, evidence, tt.want, tt.wantEvidence)
+			}
+		})
+	}
+}
+
+func TestSignOffDetectorRequirement(t *testing.T) {
+	api := &fakeGitHubAPI{files: map[string]string{
+		"kubernetes/kubectl/CONTRIBUTING.md":     "Sign the CNCF CLA before sending a pull request.",
+		"kubernetes/kubectl/go.mod":              "module k8s.io/kubectl",
+		"prometheus/prometheus/.github/dco.yml":  "require:\n  members: false",
+		"prometheus/prometheus/README.md":        "Prometheus",
+		"spf13/cobra/README.md":                  "Cobra",
+		"spf13/cobra/.github/workflows/test.yml": "on: push",
+	}}
+	config := &SignOffConfig{Enabled: true, CLAAdjustment: -0.1, DCOAdjustment: -0.02, CacheTTL: time.Hour}
+	detector := NewSignOffDetector(api, nil, config)
+
+	tests := []struct {
+		repo string
+		want SignOffRequirement
+		adj  float64
+	}{
+		{repo: "kubernetes/kubectl", want: SignOffCLA, adj: -0.1},
+		{repo: "prometheus/prometheus", want: SignOffDCO, adj: -0.02},
+		{repo: "spf13/cobra", want: SignOffNone, adj: 0},
+		{repo: "missing/repo", want: SignOffUnknown, adj: 0},
+	}
+	for _, tt := range tests {
+		owner, name, _ := strings.Cut(tt.repo, "/")
+		got := detector.Requirement(context.Background(), owner, name)
+		if got != tt.want || detector.Adjustment(got) != tt.adj {
+			t.Errorf("%s: requirement %q (adjustment %v), want %q (%v)", tt.repo, got, detector.Adjustment(got), tt.want, tt.adj)
+		}
+	}
+
+	var disabled *SignOffDetector
+	if got := disabled.Requirement(context.Background(), "kubernetes", "kubectl"); got != SignOffUnknown {
+		t.Errorf("nil detector = %q, want unknown", got)
+	}
+}
+
+func TestLoadSignOffConfigFromEnv(t *testing.T) {
+	t.Setenv("SIGN_OFF_CLA_ADJUSTMENT", "0")
+	t.Setenv("SIGN_OFF_DCO_ADJUSTMENT", "-0.05")
+
+	config := loadSignOffConfigFromEnv()
+	if config.CLAAdjustment != 0 || config.DCOAdjustment != -0.05 {
+		t.Errorf("adjustments = %v/%v, want 0/-0.05", config.CLAAdjustment, config.DCOAdjustment)
+	}
+	if SignOffCLA.Badge() == "" || SignOffNone.Badge() != "" {
+		t.Error("only CLA and DCO repos should get a badge")
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+)
+
+const (
+	defaultMoreLikeLimit = 10
+	maxFingerprintTerms  = 40
+
+	similarityLabelWeight   = 0.3
+	similarityPackageWeight = 0.35
+	similaritySymbolWeight  = 0.35
+)
+
+var (
+	packagePathPattern = regexp.MustCompile(`\b(?:pkg|cmd|internal|api|apis|staging|plugin|plugins|controllers?|server|client|lib|src)/[A-Za-z0-9_./-]+`)
+	codeSpanPattern    = regexp.MustCompile("`([^`\n]{2,80})`")
+	identifierPattern  = regexp.MustCompile(`\b(?:[A-Za-z_][A-Za-z0-9_]*\.)?[A-Za-z_][A-Za-z0-9_]*\b`)
+	camelCasePattern   = regexp.MustCompile(`\b[a-z]+[A-Z][A-Za-z0-9]*\b|\b[A-Z][a-z0-9]+[A-Z][A-Za-z0-9]*\b`)
+)
+
+// Labels that say how an issue is triaged rather than what it is about.
+var genericLabelPrefixes = []string{
+	"good first issue", "help wanted", "lifecycle/", "triage/", "needs-", "priority/", "do-not-merge", "stale",
+}
+
+// IssueFingerprint is what two issues are compared on: the area labels, the
+// code locations they mention and the identifiers they talk about.
+type IssueFingerprint struct {
+	Labels   map[string]bool
+	Packages map[string]bool
+	Symbols  map[string]bool
+}
+
+type SimilarIssue struct {
+	Issue      Issue
+	Similarity float64
+	Shared     IssueFingerprint
+}
+
+func BuildIssueFingerprint(title, body string, labels []string) IssueFingerprint {
+	fp := IssueFingerprint{
+		Labels:   make(map[string]bool),
+		Packages: make(map[string]bool),
+		Symbols:  make(map[string]bool),
+	}
+
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label != "" && !isGenericLabel(label) {
+			fp.Labels[label] = true
+		}
+	}
+
+	text := title + "\n" + body
+	for _, match := range packagePathPattern.FindAllString(text, -1) {
+		if len(fp.Packages) >= maxFingerprintTerms {
+			break
+		}
+		fp.Packages[normalizePackagePath(match)] = true
+	}
+
+	addSymbol := func(symbol string) {
+		if len(fp.Symbols) < maxFingerprintTerms && len(symbol) >= 4 && !strings.Contains(symbol, "/") {
+			fp.Symbols[symbol] = true
+		}
+	}
+	for _, match := range codeSpanPattern.FindAllStringSubmatch(text, -1) {
+		for _, ident := range identifierPattern.FindAllString(match[1], -1) {
+			if strings.ContainsAny(ident, "._") || ident != strings.ToLower(ident) {
+				addSymbol(strings.TrimSuffix(ident, "."))
+			}
+		}
+	}
+	for _, match := range camelCasePattern.FindAllString(text, -1) {
+		addSymbol(match)
+	}
+
+	return fp
+}
+
+func isGenericLabel(label string) bool {
+	for _, prefix := range genericLabelPrefixes {
+		if strings.HasPrefix(label, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePackagePath drops file names and keeps at most three segments so
+// "pkg/kubelet/cm/cpumanager/policy.go" and "pkg/kubelet/cm/memory" meet at
+// "pkg/kubelet/cm".
+func normalizePackagePath(p string) string {
+	p = strings.TrimRight(p, "./-")
+	if ext := path.Ext(p); ext != "" && len(ext) <= 5 {
+		p = path.Dir(p)
+	}
+	parts := strings.Split(p, "/")
+	if len(parts) > 3 {
+		parts = parts[:3]
+	}
+	return strings.Join(parts, "/")
+}
+
+func (fp IssueFingerprint) IsEmpty() bool {
+	return len(fp.Labels) == 0 && len(fp.Packages) == 0 && len(fp.Symbols) == 0
+}
+
+// Similarity is a weighted overlap of the three term sets. Each set scores
+// |shared| / |source| so candidates are not punished for being more verbose
+// than the issue they are compared against; sets the source lacks drop out and
+// the remaining weights are rescaled.
+func (fp IssueFingerprint) Similarity(other IssueFingerprint) (float64, IssueFingerprint) {
+	shared := IssueFingerprint{
+		Labels:   intersectTerms(fp.Labels, other.Labels),
+		Packages: intersectTerms(fp.Packages, other.Packages),
+		Symbols:  intersectTerms(fp.Symbols, other.Symbols),
+	}
+
+	score, weight := 0.0, 0.0
+	for _, part := range []struct {
+		source, shared map[string]bool
+		weight         float64
+	}{
+		{fp.Labels, shared.Labels, similarityLabelWeight},
+		{fp.Packages, shared.Packages, similarityPackageWeight},
+		{fp.Symbols, shared.Symbols, similaritySymbolWeight},
+	} {
+		if len(part.source) == 0 {
+			continue
+		}
+		weight += part.weight
+		score += part.weight * float64(len(part.shared)) / float64(len(part.source))
+	}
+
+	if weight == 0 {
+		return 0, shared
+	}
+	return score / weight, shared
+}
+
+func intersectTerms(a, b map[string]bool) map[string]bool {
+	shared := make(map[string]bool)
+	for term := range a {
+		if b[term] {
+			shared[term] = true
+		}
+	}
+	return shared
+}
+
+func sortedTerms(terms map[string]bool) []string {
+	list := make([]string, 0, len(terms))
+	for term := range terms {
+		list = append(list, term)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (fp IssueFingerprint) Summary() string {
+	var parts []string
+	if len(fp.Labels) > 0 {
+		parts = append(parts, "labels: "+strings.Join(sortedTerms(fp.Labels), ", "))
+	}
+	if len(fp.Packages) > 0 {
+		parts = append(parts, "packages: "+strings.Join(sortedTerms(fp.Packages), ", "))
+	}
+	if len(fp.Symbols) > 0 {
+		parts = append(parts, "symbols: "+strings.Join(sortedTerms(fp.Symbols), ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// RankSimilarIssues scores candidates against the source fingerprint and
+// returns the best matches above minSimilarity, most similar first.
+func RankSimilarIssues(source IssueFingerprint, candidates []Issue, fingerprints []IssueFingerprint, minSimilarity float64, limit int) []SimilarIssue {
+	var ranked []SimilarIssue
+	for i, candidate := range candidates {
+		similarity, shared := source.Similarity(fingerprints[i])
+		if similarity < minSimilarity || similarity == 0 {
+			continue
+		}
+		candidate.Score = similarity
+		ranked = append(ranked, SimilarIssue{Issue: candidate, Similarity: similarity, Shared: shared})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Similarity != ranked[j].Similarity {
+			return ranked[i].Similarity > ranked[j].Similarity
+		}
+		return issueRanksBefore(ranked[i].Issue, ranked[j].Issue)
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// moreLikeRepos returns the repository the source issue came from followed by
+// the watchlist repositories to search: the same category by default, or all
+// enabled repositories.
+func moreLikeRepos(repoManager *RepoManager, owner, repo string, all bool) []RepoConfig {
+	repos := []RepoConfig{{Owner: owner, Name: repo}}
+	if repoManager == nil {
+		return repos
+	}
+
+	category := ""
+	if source := repoManager.GetRepo(owner, repo); source != nil {
+		category = source.Category
+	}
+
+	for _, candidate := range repoManager.GetEnabledRepos() {
+		if strings.EqualFold(candidate.Owner, owner) && strings.EqualFold(candidate.Name, repo) {
+			continue
+		}
+		if all || (category != "" && candidate.Category == category) {
+			repos = append(repos, candidate)
+		}
+	}
+	return repos
+}
+
+func fetchMoreLikeCandidates(ctx context.Context, client GitHubAPI, repos []RepoConfig, skipURL string) ([]Issue, []IssueFingerprint) {
+	var candidates []Issue
+	var fingerprints []IssueFingerprint
+
+	for _, repo := range repos {
+		opts := &github.IssueListByRepoOptions{
+			State:       "open",
+			Sort:        "updated",
+			Direction:   "desc",
+			ListOptions: github.ListOptions{PerPage: 50},
+		}
+
+		issues, _, err := client.ListRepoIssues(ctx, repo.Owner, repo.Name, opts)
+		if err != nil {
+			log.Printf("[MoreLike] Error fetching issues for %s/%s: %v", repo.Owner, repo.Name, err)
+			continue
+		}
+
+		for _, issue := range issues {
+			if issue.IsPullRequest() || issue.GetHTMLURL() == skipURL {
+				continue
+			}
+			labels := getLabelNames(issue.Labels)
+			candidates = append(candidates, Issue{
+				Project:     Project{Org: repo.Owner, Name: repo.Name, Category: repo.Category},
+				Title:       issue.GetTitle(),
+				URL:         issue.GetHTMLURL(),
+				Number:      issue.GetNumber(),
+				CreatedAt:   issue.GetCreatedAt().Time,
+				Comments:    issue.GetComments(),
+				Labels:      labels,
+				IsGoodFirst: hasGoodFirstIssueLabel(issue.Labels),
+				ReadingTime: EstimateReadingTime(issue),
+			})
+			fingerprints = append(fingerprints, BuildIssueFingerprint(issue.GetTitle(), issue.GetBody(), labels))
+		}
+	}
+
+	return candidates, fingerprints
+}
+
+func runMoreLikeCommand(args []string) error {
+	issueURL := ""
+	limit := defaultMoreLikeLimit
+	minSimilarity := 0.15
+	all := false
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--limit" && i+1 < len(args):
+			if n, err := strconv.Atoi(args[i+1]); err == nil && n > 0 {
+				limit = n
+			}
+			i++
+		case args[i] == "--min" && i+1 < len(args):
+			if v, err := strconv.ParseFloat(args[i+1], 64); err == nil && v >= 0 {
+				minSimilarity = v
+			}
+			i++
+		case args[i] == "--all":
+			all = true
+		case issueURL == "":
+			issueURL = args[i]
+		}
+	}
+	if issueURL == "" {
+		return fmt.Errorf("usage: more-like <issue-url> [--limit N] [--min 0.15] [--all]")
+	}
+
+	owner, repo, number, err := ParseIssueURL(issueURL)
+	if err != nil {
+		return err
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	ctx := context.Background()
+	source, _, err := server.client.GetIssue(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	fingerprint := BuildIssueFingerprint(source.GetTitle(), source.GetBody(), getLabelNames(source.Labels))
+	if fingerprint.IsEmpty() {
+		return fmt.Errorf("%s has no area labels, package paths or symbols to match on", issueURL)
+	}
+
+	fmt.Fprintf(stdout, "\n%s\n", T("morelike.title", owner, repo, number))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+	fmt.Fprintln(stdout, T("morelike.fingerprint", fingerprint.Summary()))
+
+	repos := moreLikeRepos(server.repoManager, owner, repo, all)
+	fmt.Fprintln(stdout, T("morelike.searching", len(repos)))
+
+	candidates, fingerprints := fetchMoreLikeCandidates(ctx, server.client, repos, source.GetHTMLURL())
+	similar := RankSimilarIssues(fingerprint, candidates, fingerprints, minSimilarity, limit)
+	if len(similar) == 0 {
+		fmt.Fprintln(stdout, T("morelike.none", len(candidates)))
+		return nil
+	}
+
+	table := NewTable(
+		TableColumn{Header: T("col.match"), Align: AlignRight},
+		TableColumn{Header: T("col.project")},
+		TableColumn{Header: T("col.title"), Flex: true},
+		TableColumn{Header: T("col.shared"), Flex: true},
+		TableColumn{Header: T("col.url")},
+	)
+	for _, match := range similar {
+		table.AddCells(
+			TableCell{Text: fmt.Sprintf("%.0f%%", match.Similarity*100), Color: scoreColor(match.Similarity)},
+			TableCell{Text: match.Issue.Project.Org + "/" + match.Issue.Project.Name},
+			TableCell{Text: match.Issue.Title},
+			TableCell{Text: match.Shared.Summary()},
+			TableCell{Text: match.Issue.URL},
+		)
+	}
+	fmt.Fprintln(stdout)
+	table.Render(stdout)
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestBuildIssueFingerprint(t *testing.T) {
+	body := "The panic happens in pkg/kubelet/cm/cpumanager/policy_static.go when " +
+		"`staticPolicy.Allocate` is called; see also `podStatusProvider` and the NewManager constructor."
+	fp := BuildIssueFingerprint("kubelet: CPU manager panics", body, []string{"sig/node", "good first issue", "Area/Kubelet"})
+
+	for _, label := range []string{"sig/node", "area/kubelet"} {
+		if !fp.Labels[label] {
+			t.Errorf("expected label %q in %v", label, fp.Labels)
+		}
+	}
+	if fp.Labels["good first issue"] {
+		t.Error("generic labels should be ignored")
+	}
+	if !fp.Packages["pkg/kubelet/cm"] {
+		t.Errorf("expected package pkg/kubelet/cm in %v", fp.Packages)
+	}
+	for _, symbol := range []string{"staticPolicy.Allocate", "podStatusProvider", "NewManager"} {
+		if !fp.Symbols[symbol] {
+			t.Errorf("expected symbol %q in %v", symbol, fp.Symbols)
+		}
+	}
+}
+
+func TestNormalizePackagePath(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"pkg/kubelet/cm/cpumanager/policy.go", "pkg/kubelet/cm"},
+		{"pkg/scheduler", "pkg/scheduler"},
+		{"cmd/kubeadm/app.", "cmd/kubeadm/app"},
+		{"internal/cache/lru.go", "internal/cache"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizePackagePath(tt.input); got != tt.expected {
+			t.Errorf("normalizePackagePath(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestFingerprintSimilarity(t *testing.T) {
+	source := BuildIssueFingerprint("Fix pkg/scheduler/framework retries", "`RetryQueue` is flaky", []string{"sig/scheduling"})
+
+	identical, _ := source.Similarity(source)
+	if identical < 0.99 {
+		t.Errorf("similarity with itself = %.2f, want 1", identical)
+	}
+
+	related := BuildIssueFingerprint("Scheduler framework leak", "pkg/scheduler/framework/runtime keeps `RetryQueue` alive", []string{"sig/scheduling", "kind/bug"})
+	unrelated := BuildIssueFingerprint("Docs typo", "README has a typo", []string{"kind/documentation"})
+
+	relatedScore, shared := source.Similarity(related)
+	unrelatedScore, _ := source.Similarity(unrelated)
+	if relatedScore <= unrelatedScore {
+		t.Errorf("related similarity %.2f should exceed unrelated %.2f", relatedScore, unrelatedScore)
+	}
+	if !shared.Packages["pkg/scheduler/framework"] || !shared.Symbols["RetryQueue"] {
+		t.Errorf("shared terms = %s", shared.Summary())
+	}
+	if unrelatedScore != 0 {
+		t.Errorf("unrelated similarity = %.2f, want 0", unrelatedScore)
+	}
+}
+
+func TestRankSimilarIssues(t *testing.T) {
+	source := BuildIssueFingerprint("", "pkg/proxy/ipvs `SyncProxyRules`", []string{"area/ipvs"})
+	candidates := []Issue{
+		{URL: "weak", Number: 1, Project: Project{Org: "k", Name: "k"}},
+		{URL: "strong", Number: 2, Project: Project{Org: "k", Name: "k"}},
+		{URL: "none", Number: 3, Project: Project{Org: "k", Name: "k"}},
+	}
+	fingerprints := []IssueFingerprint{
+		BuildIssueFingerprint("", "touches pkg/proxy/ipvs", nil),
+		BuildIssueFingerprint("", "pkg/proxy/ipvs `SyncProxyRules` again", []string{"area/ipvs"}),
+		BuildIssueFingerprint("", "unrelated", nil),
+	}
+
+	ranked := RankSimilarIssues(source, candidates, fingerprints, 0.1, 10)
+	if len(ranked) != 2 {
+		t.Fatalf("len(ranked) = %d, want 2", len(ranked))
+	}
+	if ranked[0].Issue.URL != "strong" || ranked[1].Issue.URL != "weak" {
+		t.Errorf("ranking = [%s %s], want [strong weak]", ranked[0].Issue.URL, ranked[1].Issue.URL)
+	}
+
+	if limited := RankSimilarIssues(source, candidates, fingerprints, 0.1, 1); len(limited) != 1 {
+		t.Errorf("limit not applied: got %d results", len(limited))
+	}
+}
+
+func TestMoreLikeRepos(t *testing.T) {
+	rm := &RepoManager{included: []RepoConfig{
+		{Owner: "cilium", Name: "cilium", Category: "networking", Enabled: true},
+		{Owner: "projectcalico", Name: "calico", Category: "networking", Enabled: true},
+		{Owner: "prometheus", Name: "prometheus", Category: "monitoring", Enabled: true},
+	}}
+
+	repos := moreLikeRepos(rm, "cilium", "cilium", false)
+	if len(repos) != 2 || repos[0].Name != "cilium" || repos[1].Name != "calico" {
+		t.Errorf("same-category repos = %v", repos)
+	}
+
+	if all := moreLikeRepos(rm, "cilium", "cilium", true); len(all) != 3 {
+		t.Errorf("--all repos = %d, want 3", len(all))
+	}
+
+	if unmanaged := moreLikeRepos(rm, "someone", "else", false); len(unmanaged) != 1 {
+		t.Errorf("unmanaged source repos = %v, want only the source", unmanaged)
+	}
+}
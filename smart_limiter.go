@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"sync"
 	"time"
 )
@@ -200,9 +199,7 @@ func NewCommentStrategy(limiter *SmartLimiter) *CommentStrategy {
 func (cs *CommentStrategy) SelectIssuesToComment(issues []ScoredIssue) []ScoredIssue {
 	var selected []ScoredIssue
 
-	sort.Slice(issues, func(i, j int) bool {
-		return issues[i].Score.Total > issues[j].Score.Total
-	})
+	SortScoredIssues(issues)
 
 	reposUsed := make(map[string]bool)
 
@@ -238,4 +235,8 @@ type CommentPreview struct {
 	Comment     string
 	URL         string
 	Reason      string
+	// PostAt is when maintainers are next likely to be around; zero when
+	// their hours could not be estimated.
+	PostAt       time.Time
+	ActiveWindow string
 }
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// FieldPolicy says how a per-issue field appears in a stats export.
+type FieldPolicy string
+
+const (
+	FieldKeep   FieldPolicy = "keep"
+	FieldHash   FieldPolicy = "hash"
+	FieldRedact FieldPolicy = "redact"
+)
+
+var statsExportFields = []string{"title", "url", "repo", "labels", "notes", "score"}
+
+// StatsExportPolicy controls what a stats export reveals. Aggregate counts are
+// always included; Epsilon > 0 adds Laplace noise to them.
+type StatsExportPolicy struct {
+	Fields  map[string]FieldPolicy
+	Epsilon float64
+	Salt    string
+}
+
+// privateStatsExportPolicy keeps every field, for exports that stay local.
+func privateStatsExportPolicy() StatsExportPolicy {
+	fields := make(map[string]FieldPolicy, len(statsExportFields))
+	for _, field := range statsExportFields {
+		fields[field] = FieldKeep
+	}
+	return StatsExportPolicy{Fields: fields}
+}
+
+// publicStatsExportPolicy hides what identifies individual issues and keeps
+// what a dashboard needs: repos, labels and scores.
+func publicStatsExportPolicy() StatsExportPolicy {
+	policy := privateStatsExportPolicy()
+	policy.Fields["title"] = FieldRedact
+	policy.Fields["url"] = FieldHash
+	policy.Fields["notes"] = FieldRedact
+	return policy
+}
+
+// ApplyFieldSpec applies "field=policy" pairs, comma-separated, on top of the
+// current policy.
+func (p *StatsExportPolicy) ApplyFieldSpec(spec string) error {
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		field, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("invalid field policy %q, want field=keep|hash|redact", pair)
+		}
+		field = strings.ToLower(strings.TrimSpace(field))
+		if _, known := p.Fields[field]; !known {
+			return fmt.Errorf("unknown export field %q (fields: %s)", field, strings.Join(statsExportFields, ", "))
+		}
+		policy := FieldPolicy(strings.ToLower(strings.TrimSpace(value)))
+		switch policy {
+		case FieldKeep, FieldHash, FieldRedact:
+			p.Fields[field] = policy
+		default:
+			return fmt.Errorf("invalid policy %q for %s, want keep, hash or redact", value, field)
+		}
+	}
+	return nil
+}
+
+type StatsExport struct {
+	GeneratedAt  string                 `json:"generated_at"`
+	Fields       map[string]FieldPolicy `json:"fields"`
+	NoiseEpsilon float64                `json:"noise_epsilon,omitempty"`
+	Totals       StatsExportTotals      `json:"totals"`
+	ByStatus     map[string]int         `json:"by_status"`
+	ByCategory   []StatsExportCategory  `json:"by_category"`
+	Issues       []StatsExportIssue     `json:"issues"`
+}
+
+type StatsExportTotals struct {
+	Found        int     `json:"found"`
+	Tracked      int     `json:"tracked"`
+	Completed    int     `json:"completed"`
+	AverageScore float64 `json:"average_score,omitempty"`
+}
+
+type StatsExportCategory struct {
+	Category     string  `json:"category"`
+	Found        int     `json:"found"`
+	AverageScore float64 `json:"average_score,omitempty"`
+}
+
+type StatsExportIssue struct {
+	Title   string   `json:"title,omitempty"`
+	URL     string   `json:"url,omitempty"`
+	Repo    string   `json:"repo,omitempty"`
+	Status  string   `json:"status"`
+	Score   *float64 `json:"score,omitempty"`
+	Labels  []string `json:"labels,omitempty"`
+	Notes   string   `json:"notes,omitempty"`
+	Tracked string   `json:"tracked"`
+}
+
+// BuildStatsExport assembles an export from tracked issues and per-category
+// discovery counts, applying the field policies and count noise. Noise is drawn
+// from the given reader, crypto/rand.Reader outside tests, so it cannot be
+// replayed from the run seed.
+func BuildStatsExport(tracked []TrackedIssue, categories []StatsExportCategory, policy StatsExportPolicy, noise io.Reader, now time.Time) (*StatsExport, error) {
+	noisy := func(n int) (int, error) {
+		if policy.Epsilon <= 0 {
+			return n, nil
+		}
+		delta, err := laplaceNoise(noise, 1/policy.Epsilon)
+		if err != nil {
+			return 0, fmt.Errorf("failed to draw noise: %w", err)
+		}
+		return int(math.Max(0, math.Round(float64(n)+delta))), nil
+	}
+	apply := func(field, value string) string {
+		if value == "" {
+			return ""
+		}
+		switch policy.Fields[field] {
+		case FieldRedact:
+			return ""
+		case FieldHash:
+			return hashExportField(value, policy.Salt)
+		default:
+			return value
+		}
+	}
+
+	export := &StatsExport{
+		GeneratedAt:  now.UTC().Format(time.RFC3339),
+		Fields:       policy.Fields,
+		NoiseEpsilon: policy.Epsilon,
+		ByStatus:     make(map[string]int),
+		ByCategory:   []StatsExportCategory{},
+		Issues:       []StatsExportIssue{},
+	}
+
+	var scoreSum float64
+	byStatus := make(map[string]int)
+	if policy.Epsilon > 0 {
+		// Every status is listed so which ones are empty stays hidden too.
+		for _, status := range workStatuses {
+			byStatus[string(status)] = 0
+		}
+	}
+	for _, issue := range tracked {
+		byStatus[string(issue.Status)]++
+		scoreSum += issue.Score
+
+		entry := StatsExportIssue{
+			Title:   apply("title", issue.IssueTitle),
+			URL:     apply("url", issue.IssueURL),
+			Repo:    apply("repo", issue.ProjectOrg+"/"+issue.ProjectName),
+			Status:  string(issue.Status),
+			Notes:   apply("notes", issue.Notes),
+			Tracked: issue.CreatedAt.Format("2006-01"),
+		}
+		if policy.Fields["score"] != FieldRedact {
+			score := math.Round(issue.Score*100) / 100
+			entry.Score = &score
+		}
+		if policy.Fields["labels"] != FieldRedact && issue.Labels != "" {
+			for _, label := range strings.Split(issue.Labels, ",") {
+				if label = strings.TrimSpace(label); label != "" {
+					entry.Labels = append(entry.Labels, apply("labels", label))
+				}
+			}
+		}
+		export.Issues = append(export.Issues, entry)
+	}
+
+	// Each count is released once, noised if asked; totals are sums of the
+	// released parts, so they spend no extra privacy budget.
+	statuses := make([]string, 0, len(byStatus))
+	for status := range byStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		count, err := noisy(byStatus[status])
+		if err != nil {
+			return nil, err
+		}
+		export.ByStatus[status] = count
+		export.Totals.Tracked += count
+	}
+	export.Totals.Completed = export.ByStatus[string(StatusCompleted)]
+
+	for _, category := range categories {
+		count, err := noisy(category.Found)
+		if err != nil {
+			return nil, err
+		}
+		category.Found = count
+		category.AverageScore = math.Round(category.AverageScore*100) / 100
+		export.Totals.Found += count
+		export.ByCategory = append(export.ByCategory, category)
+	}
+
+	if len(tracked) > 0 {
+		export.Totals.AverageScore = math.Round(scoreSum/float64(len(tracked))*100) / 100
+	}
+
+	// With noise on, the per-issue list would reveal the true counts, and the
+	// exact averages would give away sums the noise is meant to hide.
+	if policy.Epsilon > 0 {
+		export.Issues = []StatsExportIssue{}
+		export.Totals.AverageScore = 0
+		for i := range export.ByCategory {
+			export.ByCategory[i].AverageScore = 0
+		}
+	}
+	return export, nil
+}
+
+// laplaceNoise samples Laplace(0, scale) by inverse transform, with the
+// uniform draw read from r.
+func laplaceNoise(r io.Reader, scale float64) (float64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	// 53 random bits give a uniform value strictly inside (0, 1).
+	u := (float64(binary.BigEndian.Uint64(buf[:])>>11)+0.5)/(1<<53) - 0.5
+	sign := 1.0
+	if u < 0 {
+		sign = -1.0
+	}
+	return -scale * sign * math.Log(1-2*math.Abs(u)), nil
+}
+
+func hashExportField(value, salt string) string {
+	sum := sha256.Sum256([]byte(salt + value))
+	return "h:" + hex.EncodeToString(sum[:6])
+}
+
+// loadCategoryStats counts discoveries per category, including months that
+// retention has already rolled up.
+func loadCategoryStats(db *sqlx.DB) ([]StatsExportCategory, error) {
+	if err := initRetentionTables(db); err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Category     string  `db:"category"`
+		Found        int     `db:"found"`
+		AverageScore float64 `db:"average_score"`
+	}
+	err := db.Select(&rows, `
+		SELECT category, SUM(issues) AS found, COALESCE(SUM(score_sum) / NULLIF(SUM(issues), 0), 0) AS average_score
+		FROM (
+			SELECT category, COUNT(*) AS issues, COALESCE(SUM(score), 0) AS score_sum FROM issue_history GROUP BY category
+			UNION ALL
+			SELECT category, SUM(issues), SUM(score_sum) FROM issue_history_monthly GROUP BY category
+		) combined
+		GROUP BY category
+		ORDER BY category`)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]StatsExportCategory, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, StatsExportCategory{Category: row.Category, Found: row.Found, AverageScore: row.AverageScore})
+	}
+	return categories, nil
+}
+
+func runStatsExportCommand(args []string) error {
+	policy := privateStatsExportPolicy()
+	public := getEnvBool("STATS_EXPORT_PUBLIC", false)
+	fieldSpec := os.Getenv("STATS_EXPORT_FIELDS")
+	epsilon := getEnvFloat("STATS_EXPORT_EPSILON", 0)
+	outPath := ""
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--public":
+			public = true
+		case args[i] == "--fields" && i+1 < len(args):
+			fieldSpec += "," + args[i+1]
+			i++
+		case args[i] == "--epsilon" && i+1 < len(args):
+			val, err := strconv.ParseFloat(args[i+1], 64)
+			if err != nil || val < 0 {
+				return fmt.Errorf("invalid --epsilon value %q", args[i+1])
+			}
+			epsilon = val
+			i++
+		case args[i] == "--out" && i+1 < len(args):
+			outPath = args[i+1]
+			i++
+		}
+	}
+
+	if public {
+		policy = publicStatsExportPolicy()
+	}
+	if err := policy.ApplyFieldSpec(fieldSpec); err != nil {
+		return err
+	}
+	policy.Epsilon = epsilon
+
+	// Without a fixed salt, hashes differ between exports so they cannot be
+	// joined; set STATS_EXPORT_SALT to keep them stable.
+	policy.Salt = os.Getenv("STATS_EXPORT_SALT")
+	if policy.Salt == "" {
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err != nil {
+			return fmt.Errorf("failed to generate salt: %w", err)
+		}
+		policy.Salt = hex.EncodeToString(buf)
+	}
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	tracked, err := server.tracker.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load tracked issues: %w", err)
+	}
+	categories, err := loadCategoryStats(server.db)
+	if err != nil {
+		return fmt.Errorf("failed to load discovery stats: %w", err)
+	}
+
+	export, err := BuildStatsExport(tracked, categories, policy, rand.Reader, time.Now())
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if outPath == "" {
+		_, err = stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(outPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outPath, err)
+	}
+	fmt.Fprintln(stdout, T("export.written", len(export.Issues), outPath))
+	return nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func statsExportFixture() ([]TrackedIssue, []StatsExportCategory) {
+	tracked := []TrackedIssue{
+		{IssueURL: "https://github.com/golang/go/issues/1", IssueTitle: "Research: scheduler latency", ProjectOrg: "golang", ProjectName: "go", Status: StatusInProgress, Score: 0.812, Labels: "bug,help wanted", Notes: "ask maintainer privately", CreatedAt: time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)},
+		{IssueURL: "https://github.com/cli/cli/issues/2", IssueTitle: "Flag parsing", ProjectOrg: "cli", ProjectName: "cli", Status: StatusCompleted, Score: 0.6},
+	}
+	categories := []StatsExportCategory{{Category: "Kubernetes", Found: 40, AverageScore: 0.7123}, {Category: "Monitoring", Found: 10, AverageScore: 0.5}}
+	return tracked, categories
+}
+
+func TestBuildStatsExport_Public(t *testing.T) {
+	tracked, categories := statsExportFixture()
+	policy := publicStatsExportPolicy()
+	policy.Salt = "salt"
+
+	export, err := BuildStatsExport(tracked, categories, policy, rand.New(rand.NewSource(1)), time.Now())
+	if err != nil {
+		t.Fatalf("BuildStatsExport: %v", err)
+	}
+
+	if export.Totals.Found != 50 || export.Totals.Tracked != 2 || export.Totals.Completed != 1 {
+		t.Errorf("Totals = %+v", export.Totals)
+	}
+	if export.Totals.AverageScore != 0.71 {
+		t.Errorf("AverageScore = %v, want 0.71", export.Totals.AverageScore)
+	}
+
+	issue := export.Issues[0]
+	if issue.Title != "" || issue.Notes != "" {
+		t.Errorf("title/notes should be redacted: %+v", issue)
+	}
+	if !strings.HasPrefix(issue.URL, "h:") || strings.Contains(issue.URL, "github.com") {
+		t.Errorf("URL should be hashed, got %q", issue.URL)
+	}
+	if issue.Repo != "golang/go" || issue.Score == nil || *issue.Score != 0.81 || len(issue.Labels) != 2 {
+		t.Errorf("repo/score/labels should be kept: %+v", issue)
+	}
+
+	data, _ := json.Marshal(export)
+	for _, secret := range []string{"Research", "privately", "issues/1"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("export leaks %q", secret)
+		}
+	}
+}
+
+func TestStatsExportPolicy_ApplyFieldSpec(t *testing.T) {
+	tests := []struct {
+		spec    string
+		field   string
+		want    FieldPolicy
+		wantErr bool
+	}{
+		{spec: "title=keep", field: "title", want: FieldKeep},
+		{spec: " repo = hash , score=redact", field: "repo", want: FieldHash},
+		{spec: "", field: "url", want: FieldHash},
+		{spec: "body=keep", wantErr: true},
+		{spec: "title=blur", wantErr: true},
+		{spec: "title", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			policy := publicStatsExportPolicy()
+			err := policy.ApplyFieldSpec(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyFieldSpec(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if !tt.wantErr && policy.Fields[tt.field] != tt.want {
+				t.Errorf("%s = %s, want %s", tt.field, policy.Fields[tt.field], tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildStatsExport_Noise(t *testing.T) {
+	tracked, categories := statsExportFixture()
+	policy := privateStatsExportPolicy()
+	policy.Epsilon = 0.5
+
+	export, err := BuildStatsExport(tracked, categories, policy, rand.New(rand.NewSource(7)), time.Now())
+	if err != nil {
+		t.Fatalf("BuildStatsExport: %v", err)
+	}
+	if len(export.Issues) != 0 {
+		t.Errorf("per-issue rows should be dropped when noise is on, got %d", len(export.Issues))
+	}
+	if len(export.ByStatus) != len(workStatuses) {
+		t.Errorf("ByStatus should list every status, got %v", export.ByStatus)
+	}
+
+	// Totals are derived from the noised parts rather than noised again.
+	total, found := 0, 0
+	for _, count := range export.ByStatus {
+		total += count
+	}
+	for _, category := range export.ByCategory {
+		if category.Found < 0 {
+			t.Errorf("noisy count went negative: %+v", category)
+		}
+		if category.AverageScore != 0 {
+			t.Errorf("category average should be left out under noise: %+v", category)
+		}
+		found += category.Found
+	}
+	if export.Totals.Tracked != total || export.Totals.Found != found || export.Totals.Completed != export.ByStatus[string(StatusCompleted)] {
+		t.Errorf("Totals = %+v, want sums of ByStatus %v and ByCategory", export.Totals, export.ByStatus)
+	}
+	if export.Totals.AverageScore != 0 {
+		t.Errorf("AverageScore = %v, want left out under noise", export.Totals.AverageScore)
+	}
+
+	if _, err := BuildStatsExport(nil, categories, policy, strings.NewReader(""), time.Now()); err == nil {
+		t.Error("expected an error when the noise source fails")
+	}
+
+	// Over many draws the noise should average out near the true count.
+	rng := rand.New(rand.NewSource(3))
+	var sum float64
+	for i := 0; i < 5000; i++ {
+		delta, err := laplaceNoise(rng, 2)
+		if err != nil {
+			t.Fatalf("laplaceNoise: %v", err)
+		}
+		sum += delta
+	}
+	if mean := sum / 5000; mean < -0.2 || mean > 0.2 {
+		t.Errorf("Laplace noise mean = %.3f, want close to 0", mean)
+	}
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+)
+
+const (
+	defaultTerminalWidth = 100
+	columnGap            = "  "
+	minFlexWidth         = 12
+)
+
+type TableColumn struct {
+	Header string
+	Align  Align
+	// Flex columns absorb the space left after fixed columns and are
+	// truncated first when the terminal is too narrow.
+	Flex bool
+}
+
+type TableCell struct {
+	Text  string
+	Color string
+}
+
+type Table struct {
+	Columns []TableColumn
+	Width   int
+	rows    [][]TableCell
+}
+
+type RecordField struct {
+	Label string
+	Value string
+	Color string
+}
+
+type Record struct {
+	Title      string
+	TitleColor string
+	Fields     []RecordField
+}
+
+func NewTable(columns ...TableColumn) *Table {
+	return &Table{Columns: columns}
+}
+
+func (t *Table) AddRow(cells ...string) {
+	row := make([]TableCell, len(cells))
+	for i, text := range cells {
+		row[i] = TableCell{Text: text}
+	}
+	t.AddCells(row...)
+}
+
+func (t *Table) AddCells(cells ...TableCell) {
+	row := make([]TableCell, len(t.Columns))
+	for i := range row {
+		if i < len(cells) {
+			row[i] = TableCell{Text: filterOutput(cells[i].Text, outputMode), Color: cells[i].Color}
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+func (t *Table) Len() int {
+	return len(t.rows)
+}
+
+func (t *Table) Render(w io.Writer) {
+	widths := t.columnWidths()
+
+	headers := make([]string, len(t.Columns))
+	separators := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		headers[i] = colorize(colorBold, pad(truncateDisplay(col.Header, widths[i]), widths[i], col.Align))
+		separators[i] = strings.Repeat("-", widths[i])
+	}
+	fmt.Fprintln(w, strings.TrimRight(strings.Join(headers, columnGap), " "))
+	fmt.Fprintln(w, strings.Join(separators, columnGap))
+
+	for _, row := range t.rows {
+		cells := make([]string, len(t.Columns))
+		for i, col := range t.Columns {
+			cells[i] = colorize(row[i].Color, pad(truncateDisplay(row[i].Text, widths[i]), widths[i], col.Align))
+		}
+		fmt.Fprintln(w, strings.TrimRight(strings.Join(cells, columnGap), " "))
+	}
+}
+
+func (t *Table) columnWidths() []int {
+	widths := make([]int, len(t.Columns))
+	for i, col := range t.Columns {
+		widths[i] = displayWidth(col.Header)
+		for _, row := range t.rows {
+			if cw := displayWidth(row[i].Text); cw > widths[i] {
+				widths[i] = cw
+			}
+		}
+	}
+
+	total := t.Width
+	if total <= 0 {
+		total = terminalWidth()
+	}
+
+	fixed := displayWidth(columnGap) * (len(t.Columns) - 1)
+	flexCount := 0
+	for i, col := range t.Columns {
+		if col.Flex {
+			flexCount++
+		} else {
+			fixed += widths[i]
+		}
+	}
+	if flexCount == 0 {
+		return widths
+	}
+
+	available := (total - fixed) / flexCount
+	if available < minFlexWidth {
+		available = minFlexWidth
+	}
+	for i, col := range t.Columns {
+		if col.Flex && widths[i] > available {
+			widths[i] = available
+		}
+	}
+
+	return widths
+}
+
+func NewRecord(title string) *Record {
+	return &Record{Title: title}
+}
+
+func (r *Record) Add(label, value string) *Record {
+	return r.AddColored(label, value, "")
+}
+
+func (r *Record) AddColored(label, value, color string) *Record {
+	r.Fields = append(r.Fields, RecordField{Label: label, Value: value, Color: color})
+	return r
+}
+
+func (r *Record) Render(w io.Writer, indent string) {
+	if r.Title != "" {
+		fmt.Fprintln(w, colorize(r.TitleColor, r.Title))
+	}
+
+	labelWidth := 0
+	for _, field := range r.Fields {
+		if lw := displayWidth(field.Label); lw > labelWidth {
+			labelWidth = lw
+		}
+	}
+
+	for _, field := range r.Fields {
+		label := pad(field.Label+":", labelWidth+1, AlignLeft)
+		fmt.Fprintf(w, "%s%s %s\n", indent, colorize(colorDim, label), colorize(field.Color, field.Value))
+	}
+}
+
+// displayWidth approximates terminal cell width: emoji take two cells and
+// variation selectors/joiners take none.
+func displayWidth(text string) int {
+	width := 0
+	for _, r := range text {
+		switch {
+		case r == 0xFE0F || r == 0x200D:
+		case r >= 0x1F000 && r <= 0x1FAFF:
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+func truncateDisplay(text string, width int) string {
+	if displayWidth(text) <= width {
+		return text
+	}
+	if width <= 3 {
+		return strings.Repeat(".", width)
+	}
+
+	var sb strings.Builder
+	used := 0
+	for _, r := range text {
+		rw := displayWidth(string(r))
+		if used+rw > width-3 {
+			break
+		}
+		sb.WriteRune(r)
+		used += rw
+	}
+	return sb.String() + "..."
+}
+
+func pad(text string, width int, align Align) string {
+	gap := width - displayWidth(text)
+	if gap <= 0 {
+		return text
+	}
+	if align == AlignRight {
+		return strings.Repeat(" ", gap) + text
+	}
+	return text + strings.Repeat(" ", gap)
+}
+
+func terminalWidth() int {
+	if columns := os.Getenv("COLUMNS"); columns != "" {
+		if val, err := strconv.Atoi(columns); err == nil && val > 0 {
+			return val
+		}
+	}
+	if width := terminalWidthFromFd(os.Stdout.Fd()); width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
+
+func scoreGrade(score float64) string {
+	s := IssueScore{Total: score}
+	return s.GetGrade()
+}
+
+func gradeColor(grade string) string {
+	switch grade {
+	case "A+", "A":
+		return colorGreen
+	case "B+", "B":
+		return colorCyan
+	case "C":
+		return colorYellow
+	default:
+		return colorRed
+	}
+}
+
+func scoreColor(score float64) string {
+	return gradeColor(scoreGrade(score))
+}
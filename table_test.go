@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTable_Render(t *testing.T) {
+	table := NewTable(
+		TableColumn{Header: "Score", Align: AlignRight},
+		TableColumn{Header: "Project"},
+		TableColumn{Header: "Title", Flex: true},
+	)
+	table.Width = 40
+	table.AddRow("0.85", "golang/go", "A very long issue title that will not fit in the table")
+	table.AddRow("1.20", "k8s/k8s", "Short")
+
+	var buf bytes.Buffer
+	table.Render(&buf)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Render() produced %d lines, want 4:\n%s", len(lines), buf.String())
+	}
+
+	for i, line := range lines {
+		if displayWidth(line) > 40 {
+			t.Errorf("line %d exceeds table width: %q", i, line)
+		}
+	}
+	if !strings.HasPrefix(lines[2], " 0.85  golang/go") {
+		t.Errorf("row not aligned: %q", lines[2])
+	}
+	if !strings.HasSuffix(lines[2], "...") {
+		t.Errorf("flex column should be truncated: %q", lines[2])
+	}
+	if !strings.HasSuffix(lines[3], "Short") {
+		t.Errorf("short row should not be padded at the end: %q", lines[3])
+	}
+}
+
+func TestRecord_Render(t *testing.T) {
+	record := NewRecord("Issue").Add("Score", "0.85").Add("URL", "https://github.com/golang/go/issues/1")
+
+	var buf bytes.Buffer
+	record.Render(&buf, "  ")
+
+	expected := "Issue\n  Score: 0.85\n  URL:   https://github.com/golang/go/issues/1\n"
+	if buf.String() != expected {
+		t.Errorf("Render() = %q, want %q", buf.String(), expected)
+	}
+}
+
+func TestTruncateDisplay(t *testing.T) {
+	tests := []struct {
+		text     string
+		width    int
+		expected string
+	}{
+		{"hello", 10, "hello"},
+		{"hello world", 8, "hello..."},
+		{"🔥 hot issue", 6, "🔥 ..."},
+		{"abc", 2, ".."},
+	}
+
+	for _, tt := range tests {
+		result := truncateDisplay(tt.text, tt.width)
+		if result != tt.expected {
+			t.Errorf("truncateDisplay(%q, %d) = %q, want %q", tt.text, tt.width, result, tt.expected)
+		}
+		if displayWidth(result) > tt.width {
+			t.Errorf("truncateDisplay(%q, %d) = %q exceeds width", tt.text, tt.width, result)
+		}
+	}
+}
+
+func TestScoreGrade(t *testing.T) {
+	tests := []struct {
+		score float64
+		grade string
+		color string
+	}{
+		{1.2, "A+", colorGreen},
+		{0.80, "B+", colorCyan},
+		{0.70, "C", colorYellow},
+		{0.30, "F", colorRed},
+	}
+
+	for _, tt := range tests {
+		if grade := scoreGrade(tt.score); grade != tt.grade {
+			t.Errorf("scoreGrade(%v) = %v, want %v", tt.score, grade, tt.grade)
+		}
+		if color := scoreColor(tt.score); color != tt.color {
+			t.Errorf("scoreColor(%v) = %q, want %q", tt.score, color, tt.color)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// defaultTitleExcludePatterns match umbrella and meta issues, which tend to
+// score well but are not a single piece of work anyone can pick up.
+var defaultTitleExcludePatterns = []string{
+	`^\[epic\]`,
+	`\bumbrella\b`,
+	`\btracking issue\b`,
+}
+
+// TitleFilterConfig rejects issues whose title matches any of its patterns.
+// Patterns are case-insensitive regular expressions.
+type TitleFilterConfig struct {
+	Enabled  bool
+	Patterns []*regexp.Regexp
+}
+
+func loadTitleFilterConfigFromEnv() *TitleFilterConfig {
+	config := &TitleFilterConfig{Enabled: true}
+
+	if v := os.Getenv("TITLE_FILTER_ENABLED"); v != "" {
+		config.Enabled = v == "true"
+	}
+
+	patterns := defaultTitleExcludePatterns
+	if v := os.Getenv("TITLE_EXCLUDE_PATTERNS"); v != "" {
+		patterns = splitTitlePatterns(v)
+	}
+	config.Patterns = compileTitlePatterns(patterns)
+
+	return config
+}
+
+// splitTitlePatterns splits on ";;" or newlines. Commas are left alone since
+// they are common inside regexes, as in `x{1,3}`.
+func splitTitlePatterns(v string) []string {
+	return strings.FieldsFunc(strings.ReplaceAll(v, ";;", "\n"), func(r rune) bool {
+		return r == '\n' || r == '\r'
+	})
+}
+
+// compileTitlePatterns skips blank and invalid patterns so one typo in the
+// environment does not disable the rest of the list.
+func compileTitlePatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			log.Printf("Warning: ignoring invalid title exclude pattern %q: %v", pattern, err)
+			continue
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled
+}
+
+// Check reports whether a title passes the filter, and if not, which pattern
+// it matched.
+func (c *TitleFilterConfig) Check(title string) (bool, string) {
+	if c == nil || !c.Enabled {
+		return true, ""
+	}
+
+	for _, re := range c.Patterns {
+		if re.MatchString(title) {
+			return false, "title matches excluded pattern " + strings.TrimPrefix(re.String(), "(?i)")
+		}
+	}
+	return true, ""
+}
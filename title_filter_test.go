@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTitleFilterDefaults(t *testing.T) {
+	config := &TitleFilterConfig{Enabled: true, Patterns: compileTitlePatterns(defaultTitleExcludePatterns)}
+
+	tests := []struct {
+		title   string
+		allowed bool
+	}{
+		{"[Epic] Rework the scheduler queue", false},
+		{"[EPIC] Gateway API conformance", false},
+		{"Umbrella: migrate to structured logging", false},
+		{"Tracking issue for v2 config removal", false},
+		{"kubectl panics on empty kubeconfig", true},
+		{"Epic failure when reading config", true},
+		{"Add trackingIssue field to status", true},
+	}
+
+	for _, tt := range tests {
+		allowed, reason := config.Check(tt.title)
+		if allowed != tt.allowed {
+			t.Errorf("Check(%q) = %v (%q), want %v", tt.title, allowed, reason, tt.allowed)
+		}
+		if !allowed && !strings.HasPrefix(reason, "title matches excluded pattern ") {
+			t.Errorf("unexpected reason %q", reason)
+		}
+	}
+}
+
+func TestLoadTitleFilterConfigFromEnv(t *testing.T) {
+	t.Setenv("TITLE_EXCLUDE_PATTERNS", `^meta:;; (invalid ;;roadmap;;^v\d{1,3} tasks`)
+
+	config := loadTitleFilterConfigFromEnv()
+	if len(config.Patterns) != 3 {
+		t.Fatalf("expected invalid pattern to be skipped, got %d patterns", len(config.Patterns))
+	}
+	if ok, _ := config.Check("v12 tasks"); ok {
+		t.Error("expected a pattern with a comma quantifier to stay whole")
+	}
+	if ok, _ := config.Check("META: release checklist"); ok {
+		t.Error("expected custom pattern to match case-insensitively")
+	}
+	if ok, _ := config.Check("[Epic] defaults replaced"); !ok {
+		t.Error("custom patterns should replace the defaults")
+	}
+
+	t.Setenv("TITLE_FILTER_ENABLED", "false")
+	if ok, _ := loadTitleFilterConfigFromEnv().Check("Roadmap 2027"); !ok {
+		t.Error("disabled filter should allow everything")
+	}
+}
+
+func TestSplitTitlePatterns(t *testing.T) {
+	got := splitTitlePatterns("^\\[epic\\];;x{1,3}\n\\bumbrella\\b\r\n;;")
+	want := []string{`^\[epic\]`, "x{1,3}", `\bumbrella\b`}
+	if len(got) != len(want) {
+		t.Fatalf("splitTitlePatterns() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitTitlePatterns()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TrackerFilter selects tracked issues for list and bulk update. Zero fields
+// match everything.
+type TrackerFilter struct {
+	Statuses  []WorkStatus
+	OlderThan time.Duration
+	Project   string
+	Label     string
+	MaxScore  float64
+}
+
+func (f TrackerFilter) IsEmpty() bool {
+	return len(f.Statuses) == 0 && f.OlderThan == 0 && f.Project == "" && f.Label == "" && f.MaxScore == 0
+}
+
+// Matches reports whether issue passes the filter. Age is measured from the
+// last update, so an issue you touched recently is not considered stale.
+func (f TrackerFilter) Matches(issue TrackedIssue, now time.Time) bool {
+	if len(f.Statuses) > 0 {
+		found := false
+		for _, status := range f.Statuses {
+			if issue.Status == status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.OlderThan > 0 && now.Sub(issue.UpdatedAt) < f.OlderThan {
+		return false
+	}
+	if f.Project != "" {
+		project := issue.ProjectOrg + "/" + issue.ProjectName
+		if !strings.EqualFold(project, f.Project) && !strings.EqualFold(issue.ProjectOrg, f.Project) {
+			return false
+		}
+	}
+	if f.Label != "" && !containsLabel(issue.Labels, f.Label) {
+		return false
+	}
+	if f.MaxScore > 0 && issue.Score > f.MaxScore {
+		return false
+	}
+	return true
+}
+
+func containsLabel(labels, want string) bool {
+	for _, label := range strings.Split(labels, ",") {
+		if strings.EqualFold(strings.TrimSpace(label), want) {
+			return true
+		}
+	}
+	return false
+}
+
+func FilterTracked(issues []TrackedIssue, filter TrackerFilter, now time.Time) []TrackedIssue {
+	var matched []TrackedIssue
+	for _, issue := range issues {
+		if filter.Matches(issue, now) {
+			matched = append(matched, issue)
+		}
+	}
+	return matched
+}
+
+// ParseTrackerFilter parses "key=value" pairs separated by commas or spaces,
+// e.g. "status=interested older-than=30d". Keys: status (a|b for several),
+// older-than, project (org or org/name), label, max-score.
+func ParseTrackerFilter(spec string) (TrackerFilter, error) {
+	var filter TrackerFilter
+	fields := strings.FieldsFunc(spec, func(r rune) bool { return r == ',' || r == ' ' })
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || value == "" {
+			return filter, fmt.Errorf("invalid filter %q, want key=value", field)
+		}
+		if err := filter.Set(key, value); err != nil {
+			return filter, err
+		}
+	}
+	return filter, nil
+}
+
+// Set applies one filter key; the list flags go through it too.
+func (f *TrackerFilter) Set(key, value string) error {
+	switch strings.ToLower(key) {
+	case "status":
+		for _, s := range strings.Split(value, "|") {
+			status, err := parseWorkStatus(s)
+			if err != nil {
+				return err
+			}
+			f.Statuses = append(f.Statuses, status)
+		}
+	case "older-than":
+		age, err := parseAge(value)
+		if err != nil {
+			return err
+		}
+		f.OlderThan = age
+	case "project":
+		f.Project = value
+	case "label":
+		f.Label = value
+	case "max-score":
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid max-score %q", value)
+		}
+		f.MaxScore = score
+	default:
+		return fmt.Errorf("unknown filter key %q (keys: status, older-than, project, label, max-score)", key)
+	}
+	return nil
+}
+
+var workStatuses = []WorkStatus{
+	StatusNew, StatusNotified, StatusAskedAssignment, StatusAssigned, StatusInProgress,
+	StatusPRSubmitted, StatusCompleted, StatusAbandoned, StatusInterested,
+}
+
+func parseWorkStatus(value string) (WorkStatus, error) {
+	for _, status := range workStatuses {
+		if string(status) == strings.ToLower(strings.TrimSpace(value)) {
+			return status, nil
+		}
+	}
+	names := make([]string, len(workStatuses))
+	for i, status := range workStatuses {
+		names[i] = string(status)
+	}
+	return "", fmt.Errorf("unknown status %q (statuses: %s)", value, strings.Join(names, ", "))
+}
+
+// parseAge accepts days ("30d") and weeks ("2w") on top of Go durations.
+func parseAge(value string) (time.Duration, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("invalid age %q", value)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q, want e.g. 30d, 2w or 12h", value)
+	}
+	return age, nil
+}
+
+var issueURLPattern = regexp.MustCompile(`https://github\.com/[^/\s]+/[^/\s]+/issues/\d+`)
+
+// readIssueURLs collects issue URLs from piped input. Any text is accepted,
+// so both "list --urls" and the regular list table can be piped in.
+func readIssueURLs(r io.Reader) ([]string, error) {
+	seen := make(map[string]bool)
+	var urls []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, url := range issueURLPattern.FindAllString(scanner.Text(), -1) {
+			if !seen[url] {
+				seen[url] = true
+				urls = append(urls, url)
+			}
+		}
+	}
+	return urls, scanner.Err()
+}
+
+// UpdateStatusMany applies one status to many issues in a single
+// transaction. URLs that are not tracked are returned rather than failing
+// the whole batch.
+func (t *IssueTracker) UpdateStatusMany(urls []string, status WorkStatus) (updated int, missing []string, err error) {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	query := updateStatusQuery(status)
+	now := time.Now()
+	for _, url := range urls {
+		result, err := tx.Exec(query, status, now, url)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%s: %w", url, err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			missing = append(missing, url)
+			continue
+		}
+		updated++
+	}
+	return updated, missing, tx.Commit()
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTrackerFilter(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    TrackerFilter
+		wantErr bool
+	}{
+		{spec: "status=interested older-than=30d", want: TrackerFilter{Statuses: []WorkStatus{StatusInterested}, OlderThan: 30 * 24 * time.Hour}},
+		{spec: "status=interested|notified,project=kubernetes", want: TrackerFilter{Statuses: []WorkStatus{StatusInterested, StatusNotified}, Project: "kubernetes"}},
+		{spec: "older-than=2w label=bug max-score=0.5", want: TrackerFilter{OlderThan: 14 * 24 * time.Hour, Label: "bug", MaxScore: 0.5}},
+		{spec: "older-than=12h", want: TrackerFilter{OlderThan: 12 * time.Hour}},
+		{spec: "", want: TrackerFilter{}},
+		{spec: "status=done", wantErr: true},
+		{spec: "older-than=soon", wantErr: true},
+		{spec: "owner=me", wantErr: true},
+		{spec: "interested", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseTrackerFilter(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if strings.Join(statusNames(got.Statuses), "|") != strings.Join(statusNames(tt.want.Statuses), "|") ||
+				got.OlderThan != tt.want.OlderThan || got.Project != tt.want.Project ||
+				got.Label != tt.want.Label || got.MaxScore != tt.want.MaxScore {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func statusNames(statuses []WorkStatus) []string {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+	return names
+}
+
+func TestFilterTracked(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	issues := []TrackedIssue{
+		{IssueURL: "a", Status: StatusInterested, ProjectOrg: "kubernetes", ProjectName: "kubectl", UpdatedAt: now.AddDate(0, 0, -45), Labels: "good first issue,bug", Score: 0.4},
+		{IssueURL: "b", Status: StatusInterested, ProjectOrg: "golang", ProjectName: "go", UpdatedAt: now.AddDate(0, 0, -5), Score: 0.9},
+		{IssueURL: "c", Status: StatusInProgress, ProjectOrg: "kubernetes", ProjectName: "kubernetes", UpdatedAt: now.AddDate(0, 0, -90)},
+	}
+
+	tests := []struct {
+		name   string
+		filter TrackerFilter
+		want   string
+	}{
+		{name: "empty", filter: TrackerFilter{}, want: "a,b,c"},
+		{name: "stale interested", filter: TrackerFilter{Statuses: []WorkStatus{StatusInterested}, OlderThan: 30 * 24 * time.Hour}, want: "a"},
+		{name: "org", filter: TrackerFilter{Project: "kubernetes"}, want: "a,c"},
+		{name: "org/name", filter: TrackerFilter{Project: "Kubernetes/Kubectl"}, want: "a"},
+		{name: "label", filter: TrackerFilter{Label: "bug"}, want: "a"},
+		{name: "max score", filter: TrackerFilter{MaxScore: 0.5}, want: "a,c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range FilterTracked(issues, tt.filter, now) {
+				got = append(got, issue.IssueURL)
+			}
+			if strings.Join(got, ",") != tt.want {
+				t.Errorf("matched %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadIssueURLs(t *testing.T) {
+	input := `https://github.com/golang/go/issues/1
+ interested   0.80  kubernetes/kubectl  Fix flag parsing  https://github.com/kubernetes/kubectl/issues/42
+https://github.com/golang/go/issues/1
+https://github.com/golang/go/pull/7
+`
+	urls, err := readIssueURLs(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "https://github.com/golang/go/issues/1,https://github.com/kubernetes/kubectl/issues/42"
+	if got := strings.Join(urls, ","); got != want {
+		t.Errorf("urls = %s, want %s", got, want)
+	}
+}
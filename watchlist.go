@@ -0,0 +1,311 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+)
+
+// WatchlistRepo is a project imported by init. The finder searches it in
+// addition to the built-in project list.
+type WatchlistRepo struct {
+	Owner    string `json:"owner"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Stars    int    `json:"stars"`
+	Source   string `json:"source"`
+}
+
+type Watchlist struct {
+	UpdatedAt time.Time       `json:"updated_at"`
+	Repos     []WatchlistRepo `json:"repos"`
+}
+
+func watchlistPath() string {
+	if path := os.Getenv("WATCHLIST_FILE"); path != "" {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "watchlist.json"
+	}
+	return filepath.Join(homeDir, ".github-issue-finder", "watchlist.json")
+}
+
+// LoadWatchlist reads the watchlist; a missing file is an empty watchlist.
+func LoadWatchlist(path string) (*Watchlist, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Watchlist{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var watchlist Watchlist
+	if err := json.Unmarshal(data, &watchlist); err != nil {
+		return nil, fmt.Errorf("invalid watchlist %s: %w", path, err)
+	}
+	return &watchlist, nil
+}
+
+func (w *Watchlist) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(w, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Merge adds repos that are not listed yet and returns the ones added.
+// Existing entries keep their category, which may have been edited by hand.
+func (w *Watchlist) Merge(repos []WatchlistRepo) []WatchlistRepo {
+	listed := make(map[string]bool, len(w.Repos))
+	for _, repo := range w.Repos {
+		listed[strings.ToLower(repo.Owner+"/"+repo.Name)] = true
+	}
+
+	var added []WatchlistRepo
+	for _, repo := range repos {
+		key := strings.ToLower(repo.Owner + "/" + repo.Name)
+		if listed[key] {
+			continue
+		}
+		listed[key] = true
+		w.Repos = append(w.Repos, repo)
+		added = append(added, repo)
+	}
+	sort.SliceStable(w.Repos, func(i, j int) bool { return w.Repos[i].Stars > w.Repos[j].Stars })
+	return added
+}
+
+// mergeWatchlistProjects appends watchlist repos that are not already in
+// projects.
+func mergeWatchlistProjects(projects []Project, watchlist *Watchlist) []Project {
+	known := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		known[strings.ToLower(p.Org+"/"+p.Name)] = true
+	}
+	for _, repo := range watchlist.Repos {
+		if known[strings.ToLower(repo.Owner+"/"+repo.Name)] {
+			continue
+		}
+		projects = append(projects, Project{Org: repo.Owner, Name: repo.Name, Category: repo.Category, Stars: repo.Stars})
+	}
+	return projects
+}
+
+// WatchlistImporter pulls candidate projects from the token owner's stars and
+// watched repositories (GitHub's notification subscriptions).
+type WatchlistImporter struct {
+	api      GitHubAPI
+	language string
+	minStars int
+	maxPages int
+	excluded func(owner, name string) bool
+}
+
+// WatchlistImportResult counts why repos were left out, so the summary can
+// explain a short list.
+type WatchlistImportResult struct {
+	Repos       []WatchlistRepo
+	Scanned     int
+	WrongLang   int
+	TooFewStars int
+	Skipped     int
+}
+
+func (imp *WatchlistImporter) FromStars(ctx context.Context, result *WatchlistImportResult) error {
+	opts := &github.ActivityListStarredOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 0; page < imp.maxPages; page++ {
+		starred, resp, err := imp.api.ListStarred(ctx, "", opts)
+		if err != nil {
+			return fmt.Errorf("failed to list starred repositories: %w", err)
+		}
+		for _, s := range starred {
+			imp.consider(s.GetRepository(), "stars", result)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil
+}
+
+func (imp *WatchlistImporter) FromSubscriptions(ctx context.Context, result *WatchlistImportResult) error {
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < imp.maxPages; page++ {
+		watched, resp, err := imp.api.ListWatched(ctx, "", opts)
+		if err != nil {
+			return fmt.Errorf("failed to list watched repositories: %w", err)
+		}
+		for _, repo := range watched {
+			imp.consider(repo, "notifications", result)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil
+}
+
+func (imp *WatchlistImporter) consider(repo *github.Repository, source string, result *WatchlistImportResult) {
+	if repo == nil {
+		return
+	}
+	result.Scanned++
+	owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+	switch {
+	case repo.GetArchived() || repo.GetFork() || (imp.excluded != nil && imp.excluded(owner, name)):
+		result.Skipped++
+	case imp.language != "" && !strings.EqualFold(repo.GetLanguage(), imp.language):
+		result.WrongLang++
+	case repo.GetStargazersCount() < imp.minStars:
+		result.TooFewStars++
+	default:
+		result.Repos = append(result.Repos, WatchlistRepo{
+			Owner:    owner,
+			Name:     name,
+			Category: watchlistCategory(repo.Topics),
+			Stars:    repo.GetStargazersCount(),
+			Source:   source,
+		})
+	}
+}
+
+// watchlistTopicCategories maps repository topics to the finder's built-in
+// categories, most specific first.
+var watchlistTopicCategories = []struct {
+	category string
+	topics   []string
+}{
+	{"Kubernetes", []string{"kubernetes", "k8s", "kubectl", "helm", "operator"}},
+	{"Monitoring", []string{"monitoring", "observability", "prometheus", "metrics", "tracing", "logging"}},
+	{"CI/CD", []string{"ci", "cd", "ci-cd", "continuous-integration", "continuous-delivery", "gitops"}},
+	{"ML/AI", []string{"machine-learning", "deep-learning", "ai", "llm", "ml"}},
+	{"TLS/Security", []string{"security", "tls", "cryptography", "crypto", "authentication"}},
+	{"Networking", []string{"networking", "network", "service-mesh", "proxy", "dns"}},
+	{"Storage", []string{"storage", "database", "backup"}},
+	{"Go Web", []string{"web", "http", "web-framework", "router"}},
+	{"Go Tools", []string{"cli", "developer-tools", "linter", "tooling"}},
+}
+
+func watchlistCategory(topics []string) string {
+	for _, entry := range watchlistTopicCategories {
+		for _, want := range entry.topics {
+			for _, topic := range topics {
+				if strings.EqualFold(topic, want) {
+					return entry.category
+				}
+			}
+		}
+	}
+	return "Watchlist"
+}
+
+func runInitCommand(args []string) error {
+	fromStars, fromSubscriptions, dryRun := false, false, false
+	importer := &WatchlistImporter{language: "Go", minStars: 50, maxPages: 10}
+	path := watchlistPath()
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--from-stars":
+			fromStars = true
+		case args[i] == "--from-notifications":
+			fromSubscriptions = true
+		case args[i] == "--dry-run":
+			dryRun = true
+		case args[i] == "--language" && i+1 < len(args):
+			importer.language = args[i+1]
+			i++
+		case args[i] == "--min-stars" && i+1 < len(args):
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid --min-stars value %q", args[i+1])
+			}
+			importer.minStars = n
+			i++
+		case args[i] == "--out" && i+1 < len(args):
+			path = args[i+1]
+			i++
+		}
+	}
+	if !fromStars && !fromSubscriptions {
+		return fmt.Errorf("init needs --from-stars, --from-notifications or both")
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if config.GitHubToken == "" {
+		return fmt.Errorf("GITHUB_TOKEN environment variable is required")
+	}
+
+	ctx := context.Background()
+	importer.api = newGitHubClient(ctx, config.GitHubToken, nil)
+	importer.excluded = NewRepoManager().IsExcluded
+
+	var result WatchlistImportResult
+	if fromStars {
+		if err := importer.FromStars(ctx, &result); err != nil {
+			return err
+		}
+	}
+	if fromSubscriptions {
+		if err := importer.FromSubscriptions(ctx, &result); err != nil {
+			return err
+		}
+	}
+
+	watchlist, err := LoadWatchlist(path)
+	if err != nil {
+		return err
+	}
+	added := watchlist.Merge(result.Repos)
+
+	if len(added) > 0 {
+		table := NewTable(
+			TableColumn{Header: T("col.project"), Flex: true},
+			TableColumn{Header: T("col.category")},
+			TableColumn{Header: T("col.stars"), Align: AlignRight},
+			TableColumn{Header: T("col.source")},
+		)
+		for _, repo := range added {
+			table.AddCells(
+				TableCell{Text: repo.Owner + "/" + repo.Name},
+				TableCell{Text: repo.Category},
+				TableCell{Text: strconv.Itoa(repo.Stars)},
+				TableCell{Text: repo.Source},
+			)
+		}
+		fmt.Fprintln(stdout)
+		table.Render(stdout)
+	}
+	fmt.Fprintln(stdout, T("init.scanned", result.Scanned, result.WrongLang, importer.language, result.TooFewStars, importer.minStars, result.Skipped))
+
+	if dryRun {
+		fmt.Fprintln(stdout, T("init.dry_run", len(added), path))
+		return nil
+	}
+	watchlist.UpdatedAt = time.Now().UTC()
+	if err := watchlist.Save(path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	fmt.Fprintln(stdout, T("init.written", len(added), path, len(watchlist.Repos)))
+	return nil
+}
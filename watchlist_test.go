@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v58/github"
+)
+
+func testRepo(owner, name, language string, stars int, topics ...string) *github.Repository {
+	return &github.Repository{
+		Owner:           &github.User{Login: github.String(owner)},
+		Name:            github.String(name),
+		Language:        github.String(language),
+		StargazersCount: github.Int(stars),
+		Topics:          topics,
+	}
+}
+
+func TestWatchlistImporter(t *testing.T) {
+	archived := testRepo("old", "tool", "Go", 900)
+	archived.Archived = github.Bool(true)
+	api := &fakeGitHubAPI{
+		starred: []*github.Repository{
+			testRepo("prometheus", "node_exporter", "Go", 10000, "prometheus", "metrics"),
+			testRepo("rust-lang", "cargo", "Rust", 12000),
+			testRepo("me", "dotfiles", "Go", 3),
+			archived,
+			testRepo("aws", "karpenter", "Go", 6000),
+		},
+		watched: []*github.Repository{
+			testRepo("spf13", "cobra", "Go", 38000, "cli"),
+		},
+	}
+	importer := &WatchlistImporter{api: api, language: "go", minStars: 50, maxPages: 1, excluded: NewRepoManager().IsExcluded}
+
+	var result WatchlistImportResult
+	if err := importer.FromStars(context.Background(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if err := importer.FromSubscriptions(context.Background(), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, repo := range result.Repos {
+		got = append(got, repo.Owner+"/"+repo.Name+":"+repo.Category+":"+repo.Source)
+	}
+	want := "prometheus/node_exporter:Monitoring:stars,spf13/cobra:Go Tools:notifications"
+	if strings.Join(got, ",") != want {
+		t.Errorf("repos = %v, want %s", got, want)
+	}
+	if result.Scanned != 6 || result.WrongLang != 1 || result.TooFewStars != 1 || result.Skipped != 2 {
+		t.Errorf("counts = %+v", result)
+	}
+}
+
+func TestWatchlistMergeAndSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "watchlist.json")
+
+	watchlist, err := LoadWatchlist(path)
+	if err != nil || len(watchlist.Repos) != 0 {
+		t.Fatalf("LoadWatchlist(missing) = %+v, %v", watchlist, err)
+	}
+
+	watchlist.Repos = []WatchlistRepo{{Owner: "spf13", Name: "cobra", Category: "Custom", Stars: 38000}}
+	added := watchlist.Merge([]WatchlistRepo{
+		{Owner: "SPF13", Name: "Cobra", Category: "Go Tools", Stars: 38000},
+		{Owner: "charmbracelet", Name: "bubbletea", Category: "Go Tools", Stars: 30000},
+		{Owner: "charmbracelet", Name: "bubbletea", Category: "Go Tools", Stars: 30000},
+	})
+	if len(added) != 1 || added[0].Name != "bubbletea" {
+		t.Fatalf("Merge() added %+v, want only bubbletea", added)
+	}
+
+	if err := watchlist.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadWatchlist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Repos) != 2 || loaded.Repos[0].Category != "Custom" {
+		t.Errorf("loaded %+v, want 2 repos with cobra's category kept", loaded.Repos)
+	}
+}
+
+func TestMergeWatchlistProjects(t *testing.T) {
+	projects := []Project{{Org: "golang", Name: "go", Category: "Go Core", Stars: 125000}}
+	watchlist := &Watchlist{Repos: []WatchlistRepo{
+		{Owner: "Golang", Name: "Go", Category: "Watchlist"},
+		{Owner: "spf13", Name: "cobra", Category: "Go Tools", Stars: 38000},
+	}}
+
+	merged := mergeWatchlistProjects(projects, watchlist)
+	if len(merged) != 2 || merged[1].Name != "cobra" || merged[0].Category != "Go Core" {
+		t.Errorf("mergeWatchlistProjects() = %+v", merged)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Rejection stages recorded for why-not.
+const (
+	RejectionStageEpic  = "epic"
+	RejectionStageTitle = "title"
+	RejectionStageBody  = "body"
+)
+
+type IssueRejection struct {
+	IssueID     string    `db:"issue_id"`
+	IssueURL    string    `db:"issue_url"`
+	ProjectName string    `db:"project_name"`
+	Stage       string    `db:"stage"`
+	Reason      string    `db:"reason"`
+	RejectedAt  time.Time `db:"rejected_at"`
+}
+
+func initRejectionsTable(db *sqlx.DB) error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS rejected_issues (
+		issue_id TEXT PRIMARY KEY,
+		issue_url TEXT NOT NULL,
+		project_name TEXT NOT NULL,
+		stage TEXT NOT NULL,
+		reason TEXT NOT NULL,
+		rejected_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_rejected_issues_url ON rejected_issues(issue_url);
+	`
+	_, err := db.Exec(schema)
+	return err
+}
+
+// recordRejection keeps the latest reason an issue was filtered out so that
+// why-not can explain it later.
+func recordRejection(db *sqlx.DB, rejection IssueRejection) error {
+	if db == nil {
+		return nil
+	}
+	_, err := db.Exec(`
+		INSERT INTO rejected_issues (issue_id, issue_url, project_name, stage, reason, rejected_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (issue_id) DO UPDATE SET stage = $4, reason = $5, rejected_at = $6
+	`, rejection.IssueID, rejection.IssueURL, rejection.ProjectName, rejection.Stage, rejection.Reason, time.Now())
+	return err
+}
+
+// clearRejection forgets an earlier rejection once the issue passes.
+func clearRejection(db *sqlx.DB, issueID string) error {
+	if db == nil {
+		return nil
+	}
+	_, err := db.Exec(`DELETE FROM rejected_issues WHERE issue_id = $1`, issueID)
+	return err
+}
+
+func lookupRejection(db *sqlx.DB, issueURL string) (*IssueRejection, error) {
+	var rejection IssueRejection
+	err := db.Get(&rejection, `
+		SELECT issue_id, issue_url, project_name, stage, reason, rejected_at
+		FROM rejected_issues WHERE issue_url = $1
+	`, issueURL)
+	if err != nil {
+		return nil, err
+	}
+	return &rejection, nil
+}
+
+// runWhyNotCommand explains why an issue was never surfaced: a recorded
+// rejection if there is one, otherwise the current filters applied live.
+func runWhyNotCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: why-not <issue-url>")
+	}
+
+	owner, repo, number, err := ParseIssueURL(args[0])
+	if err != nil {
+		return err
+	}
+	issueURL := fmt.Sprintf("https://github.com/%s/%s/issues/%d", owner, repo, number)
+
+	server, err := NewMCPServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	defer server.db.Close()
+
+	if err := initRejectionsTable(server.db); err != nil {
+		return fmt.Errorf("failed to initialize rejections table: %w", err)
+	}
+
+	fmt.Fprintf(stdout, "\n%s\n", T("whynot.title", owner, repo, number))
+	fmt.Fprintln(stdout, strings.Repeat("=", 80))
+
+	rejection, err := lookupRejection(server.db, issueURL)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to look up rejection: %w", err)
+	}
+	if rejection != nil {
+		fmt.Fprintln(stdout, T("whynot.rejected", rejection.Stage, rejection.RejectedAt.Format("2006-01-02 15:04")))
+		fmt.Fprintln(stdout, "  "+rejection.Reason)
+		return nil
+	}
+
+	// Past the API cache: the answer should reflect the issue as it is now.
+	issue, _, err := server.client.GetIssue(withoutAPICache(context.Background()), owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	if _, err := lookupSeenIssue(server.db, fmt.Sprintf("%s/%d", repo, number), issue.GetNodeID()); err == nil {
+		fmt.Fprintln(stdout, T("whynot.seen"))
+		return nil
+	}
+
+	if isEpic, numbers := loadEpicExpansionConfigFromEnv().SubIssues(issue.GetBody(), owner, repo); isEpic {
+		fmt.Fprintln(stdout, T("whynot.would_reject", RejectionStageEpic))
+		fmt.Fprintf(stdout, "  umbrella issue; %d open sub-issues scored individually\n", len(numbers))
+		return nil
+	}
+
+	if ok, reason := loadTitleFilterConfigFromEnv().Check(issue.GetTitle()); !ok {
+		fmt.Fprintln(stdout, T("whynot.would_reject", RejectionStageTitle))
+		fmt.Fprintln(stdout, "  "+reason)
+		return nil
+	}
+
+	if ok, reason := loadBodyFilterConfigFromEnv().Check(issue.GetBody()); !ok {
+		fmt.Fprintln(stdout, T("whynot.would_reject", RejectionStageBody))
+		fmt.Fprintln(stdout, "  "+reason)
+		return nil
+	}
+
+	fmt.Fprintln(stdout, T("whynot.passes"))
+	return nil
+}